@@ -5,9 +5,10 @@ package v1alpha1
 // AccessLogApplyConfiguration represents a declarative configuration of the AccessLog type for use
 // with apply.
 type AccessLogApplyConfiguration struct {
-	FileSink    *FileSinkApplyConfiguration        `json:"fileSink,omitempty"`
-	GrpcService *GrpcServiceApplyConfiguration     `json:"grpcService,omitempty"`
-	Filter      *AccessLogFilterApplyConfiguration `json:"filter,omitempty"`
+	FileSink      *FileSinkApplyConfiguration                      `json:"fileSink,omitempty"`
+	GrpcService   *GrpcServiceApplyConfiguration                   `json:"grpcService,omitempty"`
+	OpenTelemetry *OpenTelemetryAccessLogServiceApplyConfiguration `json:"openTelemetry,omitempty"`
+	Filter        *AccessLogFilterApplyConfiguration               `json:"filter,omitempty"`
 }
 
 // AccessLogApplyConfiguration constructs a declarative configuration of the AccessLog type for use with
@@ -32,6 +33,14 @@ func (b *AccessLogApplyConfiguration) WithGrpcService(value *GrpcServiceApplyCon
 	return b
 }
 
+// WithOpenTelemetry sets the OpenTelemetry field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenTelemetry field is set to the value of the last call.
+func (b *AccessLogApplyConfiguration) WithOpenTelemetry(value *OpenTelemetryAccessLogServiceApplyConfiguration) *AccessLogApplyConfiguration {
+	b.OpenTelemetry = value
+	return b
+}
+
 // WithFilter sets the Filter field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Filter field is set to the value of the last call.
@@ -2,12 +2,36 @@
 
 package v1alpha1
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
 // HTTPListenerPolicySpecApplyConfiguration represents a declarative configuration of the HTTPListenerPolicySpec type for use
 // with apply.
 type HTTPListenerPolicySpecApplyConfiguration struct {
-	TargetRef *LocalPolicyTargetReferenceApplyConfiguration `json:"targetRef,omitempty"`
-	Compress  *bool                                         `json:"compress,omitempty"`
-	AccessLog []AccessLogApplyConfiguration                 `json:"accessLog,omitempty"`
+	TargetRef                  *LocalPolicyTargetReferenceApplyConfiguration `json:"targetRef,omitempty"`
+	Compress                   *bool                                         `json:"compress,omitempty"`
+	Compression                *CompressionApplyConfiguration                `json:"compression,omitempty"`
+	AccessLog                  []AccessLogApplyConfiguration                 `json:"accessLog,omitempty"`
+	TcpAccessLog               []AccessLogApplyConfiguration                 `json:"tcpAccessLog,omitempty"`
+	UpstreamAccessLog          []AccessLogApplyConfiguration                 `json:"upstreamAccessLog,omitempty"`
+	AccessLogFlushInterval     *v1.Duration                                  `json:"accessLogFlushInterval,omitempty"`
+	XffNumTrustedHops          *uint32                                       `json:"xffNumTrustedHops,omitempty"`
+	UseRemoteAddress           *bool                                         `json:"useRemoteAddress,omitempty"`
+	ServerHeaderTransformation *apiv1alpha1.ServerHeaderTransformation       `json:"serverHeaderTransformation,omitempty"`
+	ServerName                 *string                                       `json:"serverName,omitempty"`
+	MaxRequestHeadersKb        *uint32                                       `json:"maxRequestHeadersKb,omitempty"`
+	StreamIdleTimeout          *v1.Duration                                  `json:"streamIdleTimeout,omitempty"`
+	IdleTimeout                *v1.Duration                                  `json:"idleTimeout,omitempty"`
+	RequestTimeout             *v1.Duration                                  `json:"requestTimeout,omitempty"`
+	NormalizePath              *bool                                         `json:"normalizePath,omitempty"`
+	MergeSlashes               *bool                                         `json:"mergeSlashes,omitempty"`
+	Http1ProtocolOptions       *Http1ProtocolOptionsApplyConfiguration       `json:"http1ProtocolOptions,omitempty"`
+	Http2ProtocolOptions       *Http2ProtocolOptionsApplyConfiguration       `json:"http2ProtocolOptions,omitempty"`
+	PreserveExternalRequestId  *bool                                         `json:"preserveExternalRequestId,omitempty"`
+	Tracing                    *TracingApplyConfiguration                    `json:"tracing,omitempty"`
 }
 
 // HTTPListenerPolicySpecApplyConfiguration constructs a declarative configuration of the HTTPListenerPolicySpec type for use with
@@ -32,6 +56,14 @@ func (b *HTTPListenerPolicySpecApplyConfiguration) WithCompress(value bool) *HTT
 	return b
 }
 
+// WithCompression sets the Compression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Compression field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithCompression(value *CompressionApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.Compression = value
+	return b
+}
+
 // WithAccessLog adds the given value to the AccessLog field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the AccessLog field.
@@ -44,3 +76,149 @@ func (b *HTTPListenerPolicySpecApplyConfiguration) WithAccessLog(values ...*Acce
 	}
 	return b
 }
+
+// WithTcpAccessLog adds the given value to the TcpAccessLog field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TcpAccessLog field.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithTcpAccessLog(values ...*AccessLogApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTcpAccessLog")
+		}
+		b.TcpAccessLog = append(b.TcpAccessLog, *values[i])
+	}
+	return b
+}
+
+// WithUpstreamAccessLog adds the given value to the UpstreamAccessLog field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UpstreamAccessLog field.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithUpstreamAccessLog(values ...*AccessLogApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUpstreamAccessLog")
+		}
+		b.UpstreamAccessLog = append(b.UpstreamAccessLog, *values[i])
+	}
+	return b
+}
+
+// WithAccessLogFlushInterval sets the AccessLogFlushInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccessLogFlushInterval field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithAccessLogFlushInterval(value v1.Duration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.AccessLogFlushInterval = &value
+	return b
+}
+
+// WithXffNumTrustedHops sets the XffNumTrustedHops field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the XffNumTrustedHops field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithXffNumTrustedHops(value uint32) *HTTPListenerPolicySpecApplyConfiguration {
+	b.XffNumTrustedHops = &value
+	return b
+}
+
+// WithUseRemoteAddress sets the UseRemoteAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UseRemoteAddress field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithUseRemoteAddress(value bool) *HTTPListenerPolicySpecApplyConfiguration {
+	b.UseRemoteAddress = &value
+	return b
+}
+
+// WithServerHeaderTransformation sets the ServerHeaderTransformation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServerHeaderTransformation field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithServerHeaderTransformation(value apiv1alpha1.ServerHeaderTransformation) *HTTPListenerPolicySpecApplyConfiguration {
+	b.ServerHeaderTransformation = &value
+	return b
+}
+
+// WithServerName sets the ServerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServerName field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithServerName(value string) *HTTPListenerPolicySpecApplyConfiguration {
+	b.ServerName = &value
+	return b
+}
+
+// WithMaxRequestHeadersKb sets the MaxRequestHeadersKb field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRequestHeadersKb field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithMaxRequestHeadersKb(value uint32) *HTTPListenerPolicySpecApplyConfiguration {
+	b.MaxRequestHeadersKb = &value
+	return b
+}
+
+// WithStreamIdleTimeout sets the StreamIdleTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StreamIdleTimeout field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithStreamIdleTimeout(value v1.Duration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.StreamIdleTimeout = &value
+	return b
+}
+
+// WithIdleTimeout sets the IdleTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IdleTimeout field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithIdleTimeout(value v1.Duration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.IdleTimeout = &value
+	return b
+}
+
+// WithRequestTimeout sets the RequestTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestTimeout field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithRequestTimeout(value v1.Duration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.RequestTimeout = &value
+	return b
+}
+
+// WithNormalizePath sets the NormalizePath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NormalizePath field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithNormalizePath(value bool) *HTTPListenerPolicySpecApplyConfiguration {
+	b.NormalizePath = &value
+	return b
+}
+
+// WithMergeSlashes sets the MergeSlashes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MergeSlashes field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithMergeSlashes(value bool) *HTTPListenerPolicySpecApplyConfiguration {
+	b.MergeSlashes = &value
+	return b
+}
+
+// WithHttp1ProtocolOptions sets the Http1ProtocolOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Http1ProtocolOptions field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithHttp1ProtocolOptions(value *Http1ProtocolOptionsApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.Http1ProtocolOptions = value
+	return b
+}
+
+// WithHttp2ProtocolOptions sets the Http2ProtocolOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Http2ProtocolOptions field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithHttp2ProtocolOptions(value *Http2ProtocolOptionsApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.Http2ProtocolOptions = value
+	return b
+}
+
+// WithPreserveExternalRequestId sets the PreserveExternalRequestId field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreserveExternalRequestId field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithPreserveExternalRequestId(value bool) *HTTPListenerPolicySpecApplyConfiguration {
+	b.PreserveExternalRequestId = &value
+	return b
+}
+
+// WithTracing sets the Tracing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tracing field is set to the value of the last call.
+func (b *HTTPListenerPolicySpecApplyConfiguration) WithTracing(value *TracingApplyConfiguration) *HTTPListenerPolicySpecApplyConfiguration {
+	b.Tracing = value
+	return b
+}
@@ -5,16 +5,18 @@ package v1alpha1
 // KubernetesProxyConfigApplyConfiguration represents a declarative configuration of the KubernetesProxyConfig type for use
 // with apply.
 type KubernetesProxyConfigApplyConfiguration struct {
-	Deployment     *ProxyDeploymentApplyConfiguration  `json:"deployment,omitempty"`
-	EnvoyContainer *EnvoyContainerApplyConfiguration   `json:"envoyContainer,omitempty"`
-	SdsContainer   *SdsContainerApplyConfiguration     `json:"sdsContainer,omitempty"`
-	PodTemplate    *PodApplyConfiguration              `json:"podTemplate,omitempty"`
-	Service        *ServiceApplyConfiguration          `json:"service,omitempty"`
-	ServiceAccount *ServiceAccountApplyConfiguration   `json:"serviceAccount,omitempty"`
-	Istio          *IstioIntegrationApplyConfiguration `json:"istio,omitempty"`
-	Stats          *StatsConfigApplyConfiguration      `json:"stats,omitempty"`
-	AiExtension    *AiExtensionApplyConfiguration      `json:"aiExtension,omitempty"`
-	FloatingUserId *bool                               `json:"floatingUserId,omitempty"`
+	Deployment              *ProxyDeploymentApplyConfiguration         `json:"deployment,omitempty"`
+	HorizontalPodAutoscaler *HorizontalPodAutoscalerApplyConfiguration `json:"horizontalPodAutoscaler,omitempty"`
+	PodDisruptionBudget     *PodDisruptionBudgetApplyConfiguration     `json:"podDisruptionBudget,omitempty"`
+	EnvoyContainer          *EnvoyContainerApplyConfiguration          `json:"envoyContainer,omitempty"`
+	SdsContainer            *SdsContainerApplyConfiguration            `json:"sdsContainer,omitempty"`
+	PodTemplate             *PodApplyConfiguration                     `json:"podTemplate,omitempty"`
+	Service                 *ServiceApplyConfiguration                 `json:"service,omitempty"`
+	ServiceAccount          *ServiceAccountApplyConfiguration          `json:"serviceAccount,omitempty"`
+	Istio                   *IstioIntegrationApplyConfiguration        `json:"istio,omitempty"`
+	Stats                   *StatsConfigApplyConfiguration             `json:"stats,omitempty"`
+	AiExtension             *AiExtensionApplyConfiguration             `json:"aiExtension,omitempty"`
+	FloatingUserId          *bool                                      `json:"floatingUserId,omitempty"`
 }
 
 // KubernetesProxyConfigApplyConfiguration constructs a declarative configuration of the KubernetesProxyConfig type for use with
@@ -31,6 +33,22 @@ func (b *KubernetesProxyConfigApplyConfiguration) WithDeployment(value *ProxyDep
 	return b
 }
 
+// WithHorizontalPodAutoscaler sets the HorizontalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HorizontalPodAutoscaler field is set to the value of the last call.
+func (b *KubernetesProxyConfigApplyConfiguration) WithHorizontalPodAutoscaler(value *HorizontalPodAutoscalerApplyConfiguration) *KubernetesProxyConfigApplyConfiguration {
+	b.HorizontalPodAutoscaler = value
+	return b
+}
+
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *KubernetesProxyConfigApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetApplyConfiguration) *KubernetesProxyConfigApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
 // WithEnvoyContainer sets the EnvoyContainer field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the EnvoyContainer field is set to the value of the last call.
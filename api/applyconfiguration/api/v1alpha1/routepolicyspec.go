@@ -5,8 +5,14 @@ package v1alpha1
 // RoutePolicySpecApplyConfiguration represents a declarative configuration of the RoutePolicySpec type for use
 // with apply.
 type RoutePolicySpecApplyConfiguration struct {
-	TargetRef *LocalPolicyTargetReferenceApplyConfiguration `json:"targetRef,omitempty"`
-	Timeout   *int                                          `json:"timeout,omitempty"`
+	TargetRef          *LocalPolicyTargetReferenceApplyConfiguration `json:"targetRef,omitempty"`
+	Timeout            *int                                          `json:"timeout,omitempty"`
+	Timeouts           *TimeoutsApplyConfiguration                   `json:"timeouts,omitempty"`
+	Retry              *RetryPolicyApplyConfiguration                `json:"retry,omitempty"`
+	Fault              *FaultInjectionApplyConfiguration             `json:"fault,omitempty"`
+	HashPolicies       []HashPolicyApplyConfiguration                `json:"hashPolicies,omitempty"`
+	DisableCompression *bool                                         `json:"disableCompression,omitempty"`
+	Tracing            *RouteTracingApplyConfiguration               `json:"tracing,omitempty"`
 }
 
 // RoutePolicySpecApplyConfiguration constructs a declarative configuration of the RoutePolicySpec type for use with
@@ -30,3 +36,56 @@ func (b *RoutePolicySpecApplyConfiguration) WithTimeout(value int) *RoutePolicyS
 	b.Timeout = &value
 	return b
 }
+
+// WithTimeouts sets the Timeouts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeouts field is set to the value of the last call.
+func (b *RoutePolicySpecApplyConfiguration) WithTimeouts(value *TimeoutsApplyConfiguration) *RoutePolicySpecApplyConfiguration {
+	b.Timeouts = value
+	return b
+}
+
+// WithRetry sets the Retry field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retry field is set to the value of the last call.
+func (b *RoutePolicySpecApplyConfiguration) WithRetry(value *RetryPolicyApplyConfiguration) *RoutePolicySpecApplyConfiguration {
+	b.Retry = value
+	return b
+}
+
+// WithFault sets the Fault field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Fault field is set to the value of the last call.
+func (b *RoutePolicySpecApplyConfiguration) WithFault(value *FaultInjectionApplyConfiguration) *RoutePolicySpecApplyConfiguration {
+	b.Fault = value
+	return b
+}
+
+// WithHashPolicies adds the given value to the HashPolicies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HashPolicies field.
+func (b *RoutePolicySpecApplyConfiguration) WithHashPolicies(values ...*HashPolicyApplyConfiguration) *RoutePolicySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHashPolicies")
+		}
+		b.HashPolicies = append(b.HashPolicies, *values[i])
+	}
+	return b
+}
+
+// WithDisableCompression sets the DisableCompression field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableCompression field is set to the value of the last call.
+func (b *RoutePolicySpecApplyConfiguration) WithDisableCompression(value bool) *RoutePolicySpecApplyConfiguration {
+	b.DisableCompression = &value
+	return b
+}
+
+// WithTracing sets the Tracing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tracing field is set to the value of the last call.
+func (b *RoutePolicySpecApplyConfiguration) WithTracing(value *RouteTracingApplyConfiguration) *RoutePolicySpecApplyConfiguration {
+	b.Tracing = value
+	return b
+}
@@ -7,6 +7,7 @@ package v1alpha1
 type UpstreamSpecApplyConfiguration struct {
 	Aws    *AwsUpstreamApplyConfiguration    `json:"aws,omitempty"`
 	Static *StaticUpstreamApplyConfiguration `json:"static,omitempty"`
+	Dns    *DnsUpstreamApplyConfiguration    `json:"dns,omitempty"`
 }
 
 // UpstreamSpecApplyConfiguration constructs a declarative configuration of the UpstreamSpec type for use with
@@ -30,3 +31,11 @@ func (b *UpstreamSpecApplyConfiguration) WithStatic(value *StaticUpstreamApplyCo
 	b.Static = value
 	return b
 }
+
+// WithDns sets the Dns field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Dns field is set to the value of the last call.
+func (b *UpstreamSpecApplyConfiguration) WithDns(value *DnsUpstreamApplyConfiguration) *UpstreamSpecApplyConfiguration {
+	b.Dns = value
+	return b
+}
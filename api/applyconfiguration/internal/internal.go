@@ -35,6 +35,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: grpcService
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GrpcService
+    - name: openTelemetry
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenTelemetryAccessLogService
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AccessLogFilter
   map:
     fields:
@@ -123,6 +126,12 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.api.core.v1.LocalObjectReference
       default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.BrotliCompressor
+  map:
+    fields:
+    - name: quality
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CELFilter
   map:
     fields:
@@ -130,6 +139,119 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CORSPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CORSPolicySpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CORSPolicySpec
+  map:
+    fields:
+    - name: allowCredentials
+      type:
+        scalar: boolean
+    - name: allowHeaders
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: allowMethods
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: allowOrigins
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: exposeHeaders
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: maxAge
+      type:
+        scalar: numeric
+    - name: targetRef
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReferenceWithSectionName
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CircuitBreakers
+  map:
+    fields:
+    - name: maxConnections
+      type:
+        scalar: numeric
+    - name: maxPendingRequests
+      type:
+        scalar: numeric
+    - name: maxRequests
+      type:
+        scalar: numeric
+    - name: maxRetries
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Compression
+  map:
+    fields:
+    - name: brotli
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.BrotliCompressor
+    - name: contentTypes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: disableOnEtagHeader
+      type:
+        scalar: boolean
+    - name: gzip
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GzipCompressor
+    - name: minContentLength
+      type:
+        scalar: numeric
+    - name: removeAcceptEncodingHeader
+      type:
+        scalar: boolean
+    - name: zstd
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ZstdCompressor
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CookieHashPolicy
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: path
+      type:
+        scalar: string
+    - name: ttl
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CustomLabel
   map:
     fields:
@@ -147,6 +269,32 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CustomTag
+  map:
+    fields:
+    - name: environment
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CustomTagSource
+    - name: literal
+      type:
+        scalar: string
+    - name: requestHeader
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CustomTagSource
+    - name: tag
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CustomTagSource
+  map:
+    fields:
+    - name: defaultValue
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DirectResponse
   map:
     fields:
@@ -190,6 +338,33 @@ var schemaYAML = typed.YAMLObject(`types:
         elementType:
           namedType: __untyped_deduced_
         elementRelationship: separable
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DnsUpstream
+  map:
+    fields:
+    - name: healthCheck
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HealthCheck
+    - name: hosts
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
+          elementRelationship: atomic
+    - name: http2
+      type:
+        scalar: boolean
+    - name: lookupType
+      type:
+        scalar: string
+    - name: refreshRate
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: respectDnsTtl
+      type:
+        scalar: boolean
+    - name: tls
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamTls
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DurationFilter
   map:
     fields:
@@ -225,6 +400,120 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: securityContext
       type:
         namedType: io.k8s.api.core.v1.SecurityContext
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthBufferSettings
+  map:
+    fields:
+    - name: allowPartialMessage
+      type:
+        scalar: boolean
+    - name: maxRequestBytes
+      type:
+        scalar: numeric
+      default: 0
+    - name: packAsBytes
+      type:
+        scalar: boolean
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthPolicySpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthPolicySpec
+  map:
+    fields:
+    - name: contextExtensions
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: disable
+      type:
+        scalar: boolean
+    - name: service
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthService
+    - name: targetRef
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReferenceWithSectionName
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthService
+  map:
+    fields:
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendRef
+      default: {}
+    - name: failOpen
+      type:
+        scalar: boolean
+    - name: headersToAuth
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: headersToUpstream
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: pathPrefix
+      type:
+        scalar: string
+    - name: protocol
+      type:
+        scalar: string
+    - name: timeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: withRequestBody
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ExtAuthBufferSettings
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultAbort
+  map:
+    fields:
+    - name: httpStatus
+      type:
+        scalar: numeric
+      default: 0
+    - name: percentage
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultDelay
+  map:
+    fields:
+    - name: fixedDelay
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: percentage
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultInjection
+  map:
+    fields:
+    - name: abort
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultAbort
+    - name: delay
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultDelay
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FileSink
   map:
     fields:
@@ -307,6 +596,29 @@ var schemaYAML = typed.YAMLObject(`types:
         elementType:
           namedType: __untyped_deduced_
         elementRelationship: separable
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GlobalRateLimitPolicy
+  map:
+    fields:
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendObjectReference
+      default: {}
+    - name: descriptors
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptor
+          elementRelationship: atomic
+    - name: domain
+      type:
+        scalar: string
+      default: ""
+    - name: failOpen
+      type:
+        scalar: boolean
+    - name: timeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GracefulShutdownSpec
   map:
     fields:
@@ -316,6 +628,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: sleepTimeSeconds
       type:
         scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GrpcHealthCheck
+  map:
+    fields:
+    - name: authority
+      type:
+        scalar: string
+    - name: serviceName
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GrpcService
   map:
     fields:
@@ -356,6 +677,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GzipCompressor
+  map:
+    fields:
+    - name: level
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HTTPListenerPolicy
   map:
     fields:
@@ -386,34 +713,218 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AccessLog
           elementRelationship: atomic
+    - name: accessLogFlushInterval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
     - name: compress
       type:
         scalar: boolean
-    - name: targetRef
+    - name: compression
       type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
-      default: {}
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderFilter
-  map:
-    fields:
-    - name: header
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Compression
+    - name: http1ProtocolOptions
       type:
-        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderMatch
-      default: {}
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
-  map:
-    fields:
-    - name: host
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Http1ProtocolOptions
+    - name: http2ProtocolOptions
       type:
-        scalar: string
-      default: ""
-    - name: port
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Http2ProtocolOptions
+    - name: idleTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxRequestHeadersKb
       type:
         scalar: numeric
-      default: 0
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Image
-  map:
-    fields:
+    - name: mergeSlashes
+      type:
+        scalar: boolean
+    - name: normalizePath
+      type:
+        scalar: boolean
+    - name: preserveExternalRequestId
+      type:
+        scalar: boolean
+    - name: requestTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: serverHeaderTransformation
+      type:
+        scalar: string
+    - name: serverName
+      type:
+        scalar: string
+    - name: streamIdleTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: targetRef
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
+      default: {}
+    - name: tcpAccessLog
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AccessLog
+          elementRelationship: atomic
+    - name: tracing
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Tracing
+    - name: upstreamAccessLog
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AccessLog
+          elementRelationship: atomic
+    - name: useRemoteAddress
+      type:
+        scalar: boolean
+    - name: xffNumTrustedHops
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HTTPStatusRange
+  map:
+    fields:
+    - name: end
+      type:
+        scalar: numeric
+      default: 0
+    - name: start
+      type:
+        scalar: numeric
+      default: 0
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HashPolicy
+  map:
+    fields:
+    - name: cookie
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CookieHashPolicy
+    - name: header
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderHashPolicy
+    - name: queryParameter
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.QueryParameterHashPolicy
+    - name: sourceIP
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SourceIPHashPolicy
+    - name: terminal
+      type:
+        scalar: boolean
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderFilter
+  map:
+    fields:
+    - name: header
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.HTTPHeaderMatch
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderHashPolicy
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HealthCheck
+  map:
+    fields:
+    - name: grpc
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GrpcHealthCheck
+    - name: healthyThreshold
+      type:
+        scalar: numeric
+    - name: http
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HttpHealthCheck
+    - name: interval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: tcp
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TcpHealthCheck
+    - name: timeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: unhealthyThreshold
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HorizontalPodAutoscaler
+  map:
+    fields:
+    - name: behavior
+      type:
+        namedType: io.k8s.api.autoscaling.v2.HorizontalPodAutoscalerBehavior
+    - name: maxReplicas
+      type:
+        scalar: numeric
+    - name: metrics
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.autoscaling.v2.MetricSpec
+          elementRelationship: atomic
+    - name: minReplicas
+      type:
+        scalar: numeric
+    - name: targetCPUUtilizationPercentage
+      type:
+        scalar: numeric
+    - name: targetMemoryUtilizationPercentage
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
+  map:
+    fields:
+    - name: host
+      type:
+        scalar: string
+      default: ""
+    - name: port
+      type:
+        scalar: numeric
+      default: 0
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Http1ProtocolOptions
+  map:
+    fields:
+    - name: acceptHttp10
+      type:
+        scalar: boolean
+    - name: defaultHostForHttp10
+      type:
+        scalar: string
+    - name: headerFormat
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Http2ProtocolOptions
+  map:
+    fields:
+    - name: initialConnectionWindowSize
+      type:
+        scalar: numeric
+    - name: initialStreamWindowSize
+      type:
+        scalar: numeric
+    - name: maxConcurrentStreams
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HttpHealthCheck
+  map:
+    fields:
+    - name: expectedStatuses
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HTTPStatusRange
+          elementRelationship: atomic
+    - name: host
+      type:
+        scalar: string
+    - name: path
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Image
+  map:
+    fields:
     - name: digest
       type:
         scalar: string
@@ -465,6 +976,109 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: istioProxyContainer
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.IstioContainer
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWKS
+  map:
+    fields:
+    - name: local
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalJWKS
+    - name: remote
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RemoteJWKS
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTClaimToHeader
+  map:
+    fields:
+    - name: claim
+      type:
+        scalar: string
+      default: ""
+    - name: header
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTPolicySpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTPolicySpec
+  map:
+    fields:
+    - name: providers
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTProvider
+          elementRelationship: associative
+          keys:
+          - name
+    - name: requirement
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTRequirement
+    - name: targetRef
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTProvider
+  map:
+    fields:
+    - name: audiences
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: claimsToHeaders
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTClaimToHeader
+          elementRelationship: atomic
+    - name: forward
+      type:
+        scalar: boolean
+    - name: issuer
+      type:
+        scalar: string
+    - name: jwks
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWKS
+      default: {}
+    - name: name
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.JWTRequirement
+  map:
+    fields:
+    - name: allowMissing
+      type:
+        scalar: boolean
+    - name: providers
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: requireAll
+      type:
+        scalar: boolean
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.KubernetesProxyConfig
   map:
     fields:
@@ -480,9 +1094,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: floatingUserId
       type:
         scalar: boolean
+    - name: horizontalPodAutoscaler
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HorizontalPodAutoscaler
     - name: istio
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.IstioIntegration
+    - name: podDisruptionBudget
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PodDisruptionBudget
     - name: podTemplate
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Pod
@@ -498,6 +1118,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: stats
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StatsConfig
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LeastRequestLoadBalancer
+  map:
+    fields:
+    - name: choiceCount
+      type:
+        scalar: numeric
+    - name: slowStartWindow
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ListenerPolicy
   map:
     fields:
@@ -529,6 +1158,31 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
       default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LoadBalancer
+  map:
+    fields:
+    - name: leastRequest
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LeastRequestLoadBalancer
+    - name: maglev
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MaglevLoadBalancer
+    - name: ringHash
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RingHashLoadBalancer
+    - name: roundRobin
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RoundRobinLoadBalancer
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalJWKS
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+    - name: secretRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.SecretObjectReference
+      default: {}
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
   map:
     fields:
@@ -544,28 +1198,108 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Pod
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReferenceWithSectionName
   map:
     fields:
-    - name: affinity
-      type:
-        namedType: io.k8s.api.core.v1.Affinity
-    - name: extraAnnotations
+    - name: group
       type:
-        map:
-          elementType:
-            scalar: string
-    - name: extraLabels
+        scalar: string
+      default: ""
+    - name: kind
       type:
-        map:
-          elementType:
-            scalar: string
-    - name: gracefulShutdown
+        scalar: string
+      default: ""
+    - name: name
       type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GracefulShutdownSpec
-    - name: imagePullSecrets
+        scalar: string
+      default: ""
+    - name: sectionName
       type:
-        list:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalRateLimitPolicy
+  map:
+    fields:
+    - name: tokenBucket
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TokenBucket
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MaglevLoadBalancer
+  map:
+    fields:
+    - name: tableSize
+      type:
+        scalar: numeric
+    - name: useHostnameForHashing
+      type:
+        scalar: boolean
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenTelemetryAccessLogService
+  map:
+    fields:
+    - name: attributes
+      type:
+        namedType: __untyped_atomic_
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendRef
+    - name: body
+      type:
+        scalar: string
+    - name: disableBuiltinLabels
+      type:
+        scalar: boolean
+    - name: logName
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenTelemetryTracingProvider
+  map:
+    fields:
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendRef
+    - name: serviceName
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OutlierDetection
+  map:
+    fields:
+    - name: baseEjectionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: consecutive5xx
+      type:
+        scalar: numeric
+    - name: consecutiveGatewayErrors
+      type:
+        scalar: numeric
+    - name: interval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxEjectionPercent
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Pod
+  map:
+    fields:
+    - name: affinity
+      type:
+        namedType: io.k8s.api.core.v1.Affinity
+    - name: extraAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: extraLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: gracefulShutdown
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GracefulShutdownSpec
+    - name: imagePullSecrets
+      type:
+        list:
           elementType:
             namedType: io.k8s.api.core.v1.LocalObjectReference
           elementRelationship: atomic
@@ -579,26 +1313,553 @@ var schemaYAML = typed.YAMLObject(`types:
             scalar: string
     - name: readinessProbe
       type:
-        namedType: io.k8s.api.core.v1.Probe
-    - name: securityContext
+        namedType: io.k8s.api.core.v1.Probe
+    - name: securityContext
+      type:
+        namedType: io.k8s.api.core.v1.PodSecurityContext
+    - name: terminationGracePeriodSeconds
+      type:
+        scalar: numeric
+    - name: tolerations
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.Toleration
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PodDisruptionBudget
+  map:
+    fields:
+    - name: maxUnavailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: minAvailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyAncestorStatus
+  map:
+    fields:
+    - name: ancestorRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.ParentReference
+      default: {}
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: controllerName
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
+  map:
+    fields:
+    - name: ancestors
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyAncestorStatus
+          elementRelationship: atomic
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ProxyDeployment
+  map:
+    fields:
+    - name: replicas
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.QueryParameterHashPolicy
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptor
+  map:
+    fields:
+    - name: entries
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntry
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntry
+  map:
+    fields:
+    - name: generic
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntryGeneric
+    - name: header
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitDescriptorEntryGeneric
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: value
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitPolicySpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitPolicySpec
+  map:
+    fields:
+    - name: global
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GlobalRateLimitPolicy
+    - name: local
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalRateLimitPolicy
+    - name: targetRef
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReferenceWithSectionName
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RemoteJWKS
+  map:
+    fields:
+    - name: backendRef
+      type:
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendObjectReference
+      default: {}
+    - name: cacheDuration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: timeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: url
+      type:
+        scalar: string
+      default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ResponseFlagFilter
+  map:
+    fields:
+    - name: flags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RetryBackoff
+  map:
+    fields:
+    - name: baseInterval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxInterval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RetryPolicy
+  map:
+    fields:
+    - name: backoff
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RetryBackoff
+    - name: numRetries
+      type:
+        scalar: numeric
+    - name: perTryTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: retriableStatusCodes
+      type:
+        list:
+          elementType:
+            scalar: numeric
+          elementRelationship: atomic
+    - name: retryOn
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RingHashLoadBalancer
+  map:
+    fields:
+    - name: maximumRingSize
+      type:
+        scalar: numeric
+    - name: minimumRingSize
+      type:
+        scalar: numeric
+    - name: useHostnameForHashing
+      type:
+        scalar: boolean
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RoundRobinLoadBalancer
+  map:
+    fields:
+    - name: slowStartWindow
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RoutePolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RoutePolicySpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RoutePolicySpec
+  map:
+    fields:
+    - name: disableCompression
+      type:
+        scalar: boolean
+    - name: fault
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.FaultInjection
+    - name: hashPolicies
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HashPolicy
+          elementRelationship: atomic
+    - name: retry
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RetryPolicy
+    - name: targetRef
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
+      default: {}
+    - name: timeout
+      type:
+        scalar: numeric
+    - name: timeouts
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Timeouts
+    - name: tracing
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RouteTracing
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RouteTracing
+  map:
+    fields:
+    - name: clientSampling
+      type:
+        scalar: numeric
+    - name: overallSampling
+      type:
+        scalar: numeric
+    - name: randomSampling
+      type:
+        scalar: numeric
+    - name: spanName
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SdsBootstrap
+  map:
+    fields:
+    - name: logLevel
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SdsContainer
+  map:
+    fields:
+    - name: bootstrap
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SdsBootstrap
+    - name: image
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Image
+    - name: resources
+      type:
+        namedType: io.k8s.api.core.v1.ResourceRequirements
+    - name: securityContext
+      type:
+        namedType: io.k8s.api.core.v1.SecurityContext
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SelfManagedGateway
+  map:
+    fields:
+    - name: sdsServer
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
+    - name: xdsServer
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Service
+  map:
+    fields:
+    - name: clusterIP
+      type:
+        scalar: string
+    - name: extraAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: extraLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: type
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ServiceAccount
+  map:
+    fields:
+    - name: extraAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: extraLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SourceIPHashPolicy
+  map:
+    elementType:
+      scalar: untyped
+      list:
+        elementType:
+          namedType: __untyped_atomic_
+        elementRelationship: atomic
+      map:
+        elementType:
+          namedType: __untyped_deduced_
+        elementRelationship: separable
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StaticUpstream
+  map:
+    fields:
+    - name: hosts
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
+          elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StatsConfig
+  map:
+    fields:
+    - name: enableStatsRoute
+      type:
+        scalar: boolean
+    - name: enabled
+      type:
+        scalar: boolean
+    - name: routePrefixRewrite
+      type:
+        scalar: string
+    - name: statsRoutePrefixRewrite
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StatusCodeFilter
+  map:
+    fields:
+    - name: op
+      type:
+        scalar: string
+    - name: value
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TcpHealthCheck
+  map:
+    fields:
+    - name: receive
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: send
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TcpKeepalive
+  map:
+    fields:
+    - name: interval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: probes
+      type:
+        scalar: numeric
+    - name: time
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Timeouts
+  map:
+    fields:
+    - name: idle
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: request
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TokenBucket
+  map:
+    fields:
+    - name: fillInterval
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxTokens
+      type:
+        scalar: numeric
+      default: 0
+    - name: tokensPerFill
+      type:
+        scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Tracing
+  map:
+    fields:
+    - name: clientSampling
+      type:
+        scalar: numeric
+    - name: customTags
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CustomTag
+          elementRelationship: atomic
+    - name: openTelemetry
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenTelemetryTracingProvider
+    - name: overallSampling
+      type:
+        scalar: numeric
+    - name: randomSampling
+      type:
+        scalar: numeric
+    - name: spawnUpstreamSpan
+      type:
+        scalar: boolean
+    - name: zipkin
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ZipkinTracingProvider
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Upstream
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamPolicySpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamPolicySpec
+  map:
+    fields:
+    - name: circuitBreakers
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.CircuitBreakers
+    - name: connectTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: healthCheck
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HealthCheck
+    - name: http2ProtocolOptions
       type:
-        namedType: io.k8s.api.core.v1.PodSecurityContext
-    - name: terminationGracePeriodSeconds
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Http2ProtocolOptions
+    - name: loadBalancer
       type:
-        scalar: numeric
-    - name: tolerations
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LoadBalancer
+    - name: outlierDetection
       type:
-        list:
-          elementType:
-            namedType: io.k8s.api.core.v1.Toleration
-          elementRelationship: atomic
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyAncestorStatus
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OutlierDetection
+    - name: targetRef
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReferenceWithSectionName
+      default: {}
+    - name: tcpKeepalive
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TcpKeepalive
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamSpec
   map:
     fields:
-    - name: ancestorRef
+    - name: aws
       type:
-        namedType: io.k8s.sigs.gateway-api.apis.v1.ParentReference
-      default: {}
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsUpstream
+    - name: dns
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DnsUpstream
+    - name: static
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StaticUpstream
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamStatus
+  map:
+    fields:
     - name: conditions
       type:
         list:
@@ -607,212 +1868,209 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
-    - name: controllerName
-      type:
-        scalar: string
-      default: ""
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamTls
   map:
     fields:
-    - name: ancestors
+    - name: caCertificateRefs
       type:
         list:
           elementType:
-            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyAncestorStatus
+            namedType: io.k8s.api.core.v1.LocalObjectReference
           elementRelationship: atomic
-    - name: conditions
+    - name: insecureSkipVerify
       type:
-        list:
-          elementType:
-            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
-          elementRelationship: associative
-          keys:
-          - type
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ProxyDeployment
-  map:
-    fields:
-    - name: replicas
+        scalar: boolean
+    - name: sni
       type:
-        scalar: numeric
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ResponseFlagFilter
-  map:
-    fields:
-    - name: flags
+        scalar: string
+    - name: subjectAltNames
       type:
         list:
           elementType:
             scalar: string
           elementRelationship: atomic
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RoutePolicy
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ZipkinTracingProvider
   map:
     fields:
-    - name: apiVersion
+    - name: backendRef
       type:
-        scalar: string
-    - name: kind
+        namedType: io.k8s.sigs.gateway-api.apis.v1.BackendRef
+    - name: collectorEndpoint
       type:
         scalar: string
-    - name: metadata
-      type:
-        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
-      default: {}
-    - name: spec
-      type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RoutePolicySpec
-      default: {}
-    - name: status
+    - name: traceId128Bit
       type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyStatus
-      default: {}
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RoutePolicySpec
+        scalar: boolean
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ZstdCompressor
   map:
     fields:
-    - name: targetRef
-      type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LocalPolicyTargetReference
-      default: {}
-    - name: timeout
+    - name: level
       type:
         scalar: numeric
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SdsBootstrap
+- name: io.k8s.api.autoscaling.v2.ContainerResourceMetricSource
   map:
     fields:
-    - name: logLevel
+    - name: container
       type:
         scalar: string
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SdsContainer
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: target
+      type:
+        namedType: io.k8s.api.autoscaling.v2.MetricTarget
+      default: {}
+- name: io.k8s.api.autoscaling.v2.CrossVersionObjectReference
   map:
     fields:
-    - name: bootstrap
-      type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SdsBootstrap
-    - name: image
+    - name: apiVersion
       type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Image
-    - name: resources
+        scalar: string
+    - name: kind
       type:
-        namedType: io.k8s.api.core.v1.ResourceRequirements
-    - name: securityContext
+        scalar: string
+      default: ""
+    - name: name
       type:
-        namedType: io.k8s.api.core.v1.SecurityContext
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SelfManagedGateway
+        scalar: string
+      default: ""
+- name: io.k8s.api.autoscaling.v2.ExternalMetricSource
   map:
-    elementType:
-      scalar: untyped
-      list:
-        elementType:
-          namedType: __untyped_atomic_
-        elementRelationship: atomic
-      map:
-        elementType:
-          namedType: __untyped_deduced_
-        elementRelationship: separable
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Service
+    fields:
+    - name: metric
+      type:
+        namedType: io.k8s.api.autoscaling.v2.MetricIdentifier
+      default: {}
+    - name: target
+      type:
+        namedType: io.k8s.api.autoscaling.v2.MetricTarget
+      default: {}
+- name: io.k8s.api.autoscaling.v2.HPAScalingPolicy
   map:
     fields:
-    - name: clusterIP
+    - name: periodSeconds
+      type:
+        scalar: numeric
+      default: 0
+    - name: type
       type:
         scalar: string
-    - name: extraAnnotations
+      default: ""
+    - name: value
       type:
-        map:
-          elementType:
-            scalar: string
-    - name: extraLabels
+        scalar: numeric
+      default: 0
+- name: io.k8s.api.autoscaling.v2.HPAScalingRules
+  map:
+    fields:
+    - name: policies
       type:
-        map:
+        list:
           elementType:
-            scalar: string
-    - name: type
+            namedType: io.k8s.api.autoscaling.v2.HPAScalingPolicy
+          elementRelationship: atomic
+    - name: selectPolicy
       type:
         scalar: string
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ServiceAccount
+    - name: stabilizationWindowSeconds
+      type:
+        scalar: numeric
+- name: io.k8s.api.autoscaling.v2.HorizontalPodAutoscalerBehavior
   map:
     fields:
-    - name: extraAnnotations
+    - name: scaleDown
       type:
-        map:
-          elementType:
-            scalar: string
-    - name: extraLabels
+        namedType: io.k8s.api.autoscaling.v2.HPAScalingRules
+    - name: scaleUp
       type:
-        map:
-          elementType:
-            scalar: string
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StaticUpstream
+        namedType: io.k8s.api.autoscaling.v2.HPAScalingRules
+- name: io.k8s.api.autoscaling.v2.MetricIdentifier
   map:
     fields:
-    - name: hosts
+    - name: name
       type:
-        list:
-          elementType:
-            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
-          elementRelationship: atomic
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StatsConfig
+        scalar: string
+      default: ""
+    - name: selector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+- name: io.k8s.api.autoscaling.v2.MetricSpec
   map:
     fields:
-    - name: enableStatsRoute
+    - name: containerResource
       type:
-        scalar: boolean
-    - name: enabled
+        namedType: io.k8s.api.autoscaling.v2.ContainerResourceMetricSource
+    - name: external
       type:
-        scalar: boolean
-    - name: routePrefixRewrite
+        namedType: io.k8s.api.autoscaling.v2.ExternalMetricSource
+    - name: object
       type:
-        scalar: string
-    - name: statsRoutePrefixRewrite
+        namedType: io.k8s.api.autoscaling.v2.ObjectMetricSource
+    - name: pods
+      type:
+        namedType: io.k8s.api.autoscaling.v2.PodsMetricSource
+    - name: resource
+      type:
+        namedType: io.k8s.api.autoscaling.v2.ResourceMetricSource
+    - name: type
       type:
         scalar: string
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StatusCodeFilter
+      default: ""
+- name: io.k8s.api.autoscaling.v2.MetricTarget
   map:
     fields:
-    - name: op
+    - name: averageUtilization
+      type:
+        scalar: numeric
+    - name: averageValue
+      type:
+        namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: type
       type:
         scalar: string
+      default: ""
     - name: value
       type:
-        scalar: numeric
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Upstream
+        namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+- name: io.k8s.api.autoscaling.v2.ObjectMetricSource
   map:
     fields:
-    - name: apiVersion
+    - name: describedObject
       type:
-        scalar: string
-    - name: kind
-      type:
-        scalar: string
-    - name: metadata
-      type:
-        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+        namedType: io.k8s.api.autoscaling.v2.CrossVersionObjectReference
       default: {}
-    - name: spec
+    - name: metric
       type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamSpec
+        namedType: io.k8s.api.autoscaling.v2.MetricIdentifier
       default: {}
-    - name: status
+    - name: target
       type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamStatus
+        namedType: io.k8s.api.autoscaling.v2.MetricTarget
       default: {}
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamSpec
+- name: io.k8s.api.autoscaling.v2.PodsMetricSource
   map:
     fields:
-    - name: aws
+    - name: metric
       type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AwsUpstream
-    - name: static
+        namedType: io.k8s.api.autoscaling.v2.MetricIdentifier
+      default: {}
+    - name: target
       type:
-        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StaticUpstream
-- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.UpstreamStatus
+        namedType: io.k8s.api.autoscaling.v2.MetricTarget
+      default: {}
+- name: io.k8s.api.autoscaling.v2.ResourceMetricSource
   map:
     fields:
-    - name: conditions
+    - name: name
       type:
-        list:
-          elementType:
-            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
-          elementRelationship: associative
-          keys:
-          - type
+        scalar: string
+      default: ""
+    - name: target
+      type:
+        namedType: io.k8s.api.autoscaling.v2.MetricTarget
+      default: {}
 - name: io.k8s.api.core.v1.Affinity
   map:
     fields:
@@ -1638,6 +2896,8 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+  scalar: string
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
   map:
     elementType:
@@ -1811,6 +3071,25 @@ var schemaYAML = typed.YAMLObject(`types:
         elementRelationship: separable
 - name: io.k8s.apimachinery.pkg.util.intstr.IntOrString
   scalar: untyped
+- name: io.k8s.sigs.gateway-api.apis.v1.BackendObjectReference
+  map:
+    fields:
+    - name: group
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: namespace
+      type:
+        scalar: string
+    - name: port
+      type:
+        scalar: numeric
 - name: io.k8s.sigs.gateway-api.apis.v1.BackendRef
   map:
     fields:
@@ -1869,6 +3148,22 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: sectionName
       type:
         scalar: string
+- name: io.k8s.sigs.gateway-api.apis.v1.SecretObjectReference
+  map:
+    fields:
+    - name: group
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: namespace
+      type:
+        scalar: string
 - name: __untyped_atomic_
   scalar: untyped
   list:
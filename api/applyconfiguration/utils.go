@@ -27,20 +27,52 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.AiExtensionStatsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AwsUpstream"):
 		return &apiv1alpha1.AwsUpstreamApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("BrotliCompressor"):
+		return &apiv1alpha1.BrotliCompressorApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CELFilter"):
 		return &apiv1alpha1.CELFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CircuitBreakers"):
+		return &apiv1alpha1.CircuitBreakersApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Compression"):
+		return &apiv1alpha1.CompressionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CookieHashPolicy"):
+		return &apiv1alpha1.CookieHashPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CORSPolicy"):
+		return &apiv1alpha1.CORSPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CORSPolicySpec"):
+		return &apiv1alpha1.CORSPolicySpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CustomLabel"):
 		return &apiv1alpha1.CustomLabelApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CustomTag"):
+		return &apiv1alpha1.CustomTagApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CustomTagSource"):
+		return &apiv1alpha1.CustomTagSourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DirectResponse"):
 		return &apiv1alpha1.DirectResponseApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DirectResponseSpec"):
 		return &apiv1alpha1.DirectResponseSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DnsUpstream"):
+		return &apiv1alpha1.DnsUpstreamApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DurationFilter"):
 		return &apiv1alpha1.DurationFilterApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EnvoyBootstrap"):
 		return &apiv1alpha1.EnvoyBootstrapApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EnvoyContainer"):
 		return &apiv1alpha1.EnvoyContainerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExtAuthBufferSettings"):
+		return &apiv1alpha1.ExtAuthBufferSettingsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExtAuthPolicy"):
+		return &apiv1alpha1.ExtAuthPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExtAuthPolicySpec"):
+		return &apiv1alpha1.ExtAuthPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExtAuthService"):
+		return &apiv1alpha1.ExtAuthServiceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FaultAbort"):
+		return &apiv1alpha1.FaultAbortApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FaultDelay"):
+		return &apiv1alpha1.FaultDelayApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FaultInjection"):
+		return &apiv1alpha1.FaultInjectionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FileSink"):
 		return &apiv1alpha1.FileSinkApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FilterType"):
@@ -49,52 +81,132 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.GatewayParametersApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GatewayParametersSpec"):
 		return &apiv1alpha1.GatewayParametersSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GlobalRateLimitPolicy"):
+		return &apiv1alpha1.GlobalRateLimitPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GracefulShutdownSpec"):
 		return &apiv1alpha1.GracefulShutdownSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GrpcHealthCheck"):
+		return &apiv1alpha1.GrpcHealthCheckApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GrpcService"):
 		return &apiv1alpha1.GrpcServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GrpcStatusFilter"):
 		return &apiv1alpha1.GrpcStatusFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GzipCompressor"):
+		return &apiv1alpha1.GzipCompressorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HashPolicy"):
+		return &apiv1alpha1.HashPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HeaderFilter"):
 		return &apiv1alpha1.HeaderFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HeaderHashPolicy"):
+		return &apiv1alpha1.HeaderHashPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HealthCheck"):
+		return &apiv1alpha1.HealthCheckApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler"):
+		return &apiv1alpha1.HorizontalPodAutoscalerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Host"):
 		return &apiv1alpha1.HostApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Http1ProtocolOptions"):
+		return &apiv1alpha1.Http1ProtocolOptionsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Http2ProtocolOptions"):
+		return &apiv1alpha1.Http2ProtocolOptionsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HttpHealthCheck"):
+		return &apiv1alpha1.HttpHealthCheckApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HTTPListenerPolicy"):
 		return &apiv1alpha1.HTTPListenerPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HTTPListenerPolicySpec"):
 		return &apiv1alpha1.HTTPListenerPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HTTPStatusRange"):
+		return &apiv1alpha1.HTTPStatusRangeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Image"):
 		return &apiv1alpha1.ImageApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IstioContainer"):
 		return &apiv1alpha1.IstioContainerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IstioIntegration"):
 		return &apiv1alpha1.IstioIntegrationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWKS"):
+		return &apiv1alpha1.JWKSApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTClaimToHeader"):
+		return &apiv1alpha1.JWTClaimToHeaderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTPolicy"):
+		return &apiv1alpha1.JWTPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTPolicySpec"):
+		return &apiv1alpha1.JWTPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTProvider"):
+		return &apiv1alpha1.JWTProviderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JWTRequirement"):
+		return &apiv1alpha1.JWTRequirementApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KubernetesProxyConfig"):
 		return &apiv1alpha1.KubernetesProxyConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LeastRequestLoadBalancer"):
+		return &apiv1alpha1.LeastRequestLoadBalancerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ListenerPolicy"):
 		return &apiv1alpha1.ListenerPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ListenerPolicySpec"):
 		return &apiv1alpha1.ListenerPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancer"):
+		return &apiv1alpha1.LoadBalancerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LocalJWKS"):
+		return &apiv1alpha1.LocalJWKSApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalPolicyTargetReference"):
 		return &apiv1alpha1.LocalPolicyTargetReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LocalPolicyTargetReferenceWithSectionName"):
+		return &apiv1alpha1.LocalPolicyTargetReferenceWithSectionNameApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LocalRateLimitPolicy"):
+		return &apiv1alpha1.LocalRateLimitPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MaglevLoadBalancer"):
+		return &apiv1alpha1.MaglevLoadBalancerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenTelemetryAccessLogService"):
+		return &apiv1alpha1.OpenTelemetryAccessLogServiceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenTelemetryTracingProvider"):
+		return &apiv1alpha1.OpenTelemetryTracingProviderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OutlierDetection"):
+		return &apiv1alpha1.OutlierDetectionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Pod"):
 		return &apiv1alpha1.PodApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PodDisruptionBudget"):
+		return &apiv1alpha1.PodDisruptionBudgetApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PolicyAncestorStatus"):
 		return &apiv1alpha1.PolicyAncestorStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PolicyStatus"):
 		return &apiv1alpha1.PolicyStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ProxyDeployment"):
 		return &apiv1alpha1.ProxyDeploymentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("QueryParameterHashPolicy"):
+		return &apiv1alpha1.QueryParameterHashPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitDescriptor"):
+		return &apiv1alpha1.RateLimitDescriptorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitDescriptorEntry"):
+		return &apiv1alpha1.RateLimitDescriptorEntryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitDescriptorEntryGeneric"):
+		return &apiv1alpha1.RateLimitDescriptorEntryGenericApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitPolicy"):
+		return &apiv1alpha1.RateLimitPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RateLimitPolicySpec"):
+		return &apiv1alpha1.RateLimitPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RemoteJWKS"):
+		return &apiv1alpha1.RemoteJWKSApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResponseFlagFilter"):
 		return &apiv1alpha1.ResponseFlagFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RetryBackoff"):
+		return &apiv1alpha1.RetryBackoffApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RetryPolicy"):
+		return &apiv1alpha1.RetryPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RingHashLoadBalancer"):
+		return &apiv1alpha1.RingHashLoadBalancerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RoundRobinLoadBalancer"):
+		return &apiv1alpha1.RoundRobinLoadBalancerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RoutePolicy"):
 		return &apiv1alpha1.RoutePolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RoutePolicySpec"):
 		return &apiv1alpha1.RoutePolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RouteTracing"):
+		return &apiv1alpha1.RouteTracingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SdsBootstrap"):
 		return &apiv1alpha1.SdsBootstrapApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SdsContainer"):
 		return &apiv1alpha1.SdsContainerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SelfManagedGateway"):
+		return &apiv1alpha1.SelfManagedGatewayApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Service"):
 		return &apiv1alpha1.ServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ServiceAccount"):
@@ -105,12 +217,32 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.StatsConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("StatusCodeFilter"):
 		return &apiv1alpha1.StatusCodeFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TcpHealthCheck"):
+		return &apiv1alpha1.TcpHealthCheckApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TcpKeepalive"):
+		return &apiv1alpha1.TcpKeepaliveApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Timeouts"):
+		return &apiv1alpha1.TimeoutsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TokenBucket"):
+		return &apiv1alpha1.TokenBucketApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Tracing"):
+		return &apiv1alpha1.TracingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Upstream"):
 		return &apiv1alpha1.UpstreamApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UpstreamPolicy"):
+		return &apiv1alpha1.UpstreamPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UpstreamPolicySpec"):
+		return &apiv1alpha1.UpstreamPolicySpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("UpstreamSpec"):
 		return &apiv1alpha1.UpstreamSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("UpstreamStatus"):
 		return &apiv1alpha1.UpstreamStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UpstreamTls"):
+		return &apiv1alpha1.UpstreamTlsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ZipkinTracingProvider"):
+		return &apiv1alpha1.ZipkinTracingProviderApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ZstdCompressor"):
+		return &apiv1alpha1.ZstdCompressorApplyConfiguration{}
 
 	}
 	return nil
@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=corspolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=corspolicies/status,verbs=get;update;patch
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={app=kgateway,app.kubernetes.io/name=kgateway}
+// +kubebuilder:resource:categories=kgateway,shortName=corsp
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=Direct"
+type CORSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CORSPolicySpec `json:"spec,omitempty"`
+	Status PolicyStatus   `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type CORSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CORSPolicy `json:"items"`
+}
+
+// CORSPolicySpec configures Cross-Origin Resource Sharing (CORS).
+// A policy targeting a Gateway sets the defaults of all of its routes, which a policy targeting an
+// HTTPRoute or HTTPRoute rule (using sectionName) replaces.
+// The fields follow the CORS filter of HTTPRoute.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/http/http_filters/cors_filter
+type CORSPolicySpec struct {
+	// TargetRef is the Gateway, HTTPRoute or HTTPRoute rule (using sectionName) the policy applies to.
+	TargetRef LocalPolicyTargetReferenceWithSectionName `json:"targetRef,omitempty"`
+
+	// AllowOrigins lists the origins allowed to make requests, e.g. `https://example.com`.
+	// `*` allows any origin, and a leading `*` in the host allows any subdomain, e.g. `https://*.example.com`.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	AllowOrigins []string `json:"allowOrigins"`
+
+	// AllowCredentials allows requests with credentials, such as cookies.
+	AllowCredentials bool `json:"allowCredentials,omitempty"`
+
+	// AllowMethods lists the methods allowed in requests, e.g. `GET`.
+	// +kubebuilder:validation:MaxItems=9
+	AllowMethods []string `json:"allowMethods,omitempty"`
+
+	// AllowHeaders lists the headers allowed in requests.
+	// +kubebuilder:validation:MaxItems=64
+	AllowHeaders []string `json:"allowHeaders,omitempty"`
+
+	// ExposeHeaders lists the response headers readable by the client.
+	// +kubebuilder:validation:MaxItems=64
+	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
+
+	// MaxAge is how long, in seconds, the client may cache the response to a preflight request.
+	// Defaults to 5.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	MaxAge int32 `json:"maxAge,omitempty"`
+}
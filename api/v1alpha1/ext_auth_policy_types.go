@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=extauthpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=extauthpolicies/status,verbs=get;update;patch
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={app=kgateway,app.kubernetes.io/name=kgateway}
+// +kubebuilder:resource:categories=kgateway,shortName=eap
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=Direct"
+type ExtAuthPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExtAuthPolicySpec `json:"spec,omitempty"`
+	Status PolicyStatus      `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type ExtAuthPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExtAuthPolicy `json:"items"`
+}
+
+// ExtAuthPolicySpec configures external authorization of requests.
+// A policy with a service enables external authorization on the targeted Gateway, HTTPRoute or rule.
+// A policy without a service targeting a route or rule disables external authorization, or sets the
+// context extensions sent to the auth service, for the matching requests.
+// +kubebuilder:validation:XValidation:message="one of 'service', 'disable' or 'contextExtensions' must be set",rule="has(self.service) || (has(self.disable) && self.disable) || has(self.contextExtensions)"
+// +kubebuilder:validation:XValidation:message="'disable' can't be set with 'service' or 'contextExtensions'",rule="!has(self.disable) || !self.disable || (!has(self.service) && !has(self.contextExtensions))"
+type ExtAuthPolicySpec struct {
+	// TargetRef is the Gateway, HTTPRoute or HTTPRoute rule (using sectionName) the policy applies to.
+	TargetRef LocalPolicyTargetReferenceWithSectionName `json:"targetRef,omitempty"`
+
+	// Service is the auth service called to authorize each request.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/http/http_filters/ext_authz_filter
+	Service *ExtAuthService `json:"service,omitempty"`
+
+	// Disable turns off external authorization for the targeted routes.
+	Disable bool `json:"disable,omitempty"`
+
+	// ContextExtensions are sent to a gRPC auth service along with each request.
+	ContextExtensions map[string]string `json:"contextExtensions,omitempty"`
+}
+
+// ExtAuthService configures the auth service.
+type ExtAuthService struct {
+	// BackendRef is the auth service.
+	// A gRPC Service must declare HTTP/2 with the `appProtocol` of its port, e.g. `kubernetes.io/h2c`.
+	// +kubebuilder:validation:Required
+	BackendRef gwv1.BackendRef `json:"backendRef"`
+
+	// Protocol is the API implemented by the auth service. Defaults to GRPC.
+	// +kubebuilder:default=GRPC
+	Protocol ExtAuthProtocol `json:"protocol,omitempty"`
+
+	// PathPrefix is prepended to the path of requests sent to an HTTP auth service.
+	PathPrefix string `json:"pathPrefix,omitempty"`
+
+	// Timeout for calls to the auth service. Defaults to 200ms.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailOpen allows requests when the auth service can't be reached or returns an error.
+	FailOpen bool `json:"failOpen,omitempty"`
+
+	// HeadersToAuth lists the client request headers sent to the auth service.
+	// When unset, all the headers are sent.
+	HeadersToAuth []string `json:"headersToAuth,omitempty"`
+
+	// HeadersToUpstream lists the headers of an HTTP auth service's response that are added to the
+	// upstream request when the request is allowed.
+	// A gRPC auth service sets the headers to add in its response instead.
+	HeadersToUpstream []string `json:"headersToUpstream,omitempty"`
+
+	// WithRequestBody buffers the request body and sends it to the auth service.
+	WithRequestBody *ExtAuthBufferSettings `json:"withRequestBody,omitempty"`
+}
+
+// ExtAuthProtocol is the API implemented by an auth service.
+// +kubebuilder:validation:Enum=GRPC;HTTP
+type ExtAuthProtocol string
+
+const (
+	ExtAuthProtocolGRPC ExtAuthProtocol = "GRPC"
+	ExtAuthProtocolHTTP ExtAuthProtocol = "HTTP"
+)
+
+// ExtAuthBufferSettings configures how the request body is buffered for the auth service.
+type ExtAuthBufferSettings struct {
+	// MaxRequestBytes is the maximum size of the buffered body.
+	// Requests with a larger body are rejected with a 413 unless AllowPartialMessage is set.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestBytes uint32 `json:"maxRequestBytes"`
+
+	// AllowPartialMessage sends the first MaxRequestBytes of larger bodies instead of rejecting them.
+	AllowPartialMessage bool `json:"allowPartialMessage,omitempty"`
+
+	// PackAsBytes sends the body as raw bytes instead of a UTF-8 string to a gRPC auth service.
+	PackAsBytes bool `json:"packAsBytes,omitempty"`
+}
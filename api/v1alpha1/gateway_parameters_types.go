@@ -42,7 +42,11 @@ type GatewayParametersSpec struct {
 	// +kubebuilder:validation:Optional
 	Kube *KubernetesProxyConfig `json:"kube,omitempty"`
 
-	// The proxy will be self-managed and not auto-provisioned.
+	// The proxy will be self-managed and not auto-provisioned. The envoy bootstrap connecting the
+	// proxy to the control plane is generated in the `<gateway name>-bootstrap` ConfigMap, under
+	// the `envoy.yaml` key. The proxy may run outside of the cluster; its locality is taken from
+	// the `--service-zone` and similar flags rather than from a pod. The `ProxyConnected`
+	// condition of the Gateway reports whether the proxy is connected.
 	//
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:pruning:PreserveUnknownFields
@@ -54,6 +58,19 @@ type GatewayParametersStatus struct {
 }
 
 type SelfManagedGateway struct {
+	// The address of the xDS server of the control plane that the proxy connects to. A proxy
+	// running outside of the cluster can't resolve the in-cluster address of the control plane
+	// Service, which is used when unset.
+	//
+	// +kubebuilder:validation:Optional
+	XdsServer *Host `json:"xdsServer,omitempty"`
+
+	// The address of the SDS server providing the proxy the workload certificates of the istio
+	// mTLS integration, typically an istio agent running next to the proxy. When unset, no SDS
+	// server is configured in the bootstrap, and the proxy can't originate istio mTLS.
+	//
+	// +kubebuilder:validation:Optional
+	SdsServer *Host `json:"sdsServer,omitempty"`
 }
 
 // Configuration for the set of Kubernetes resources that will be provisioned
@@ -65,6 +82,18 @@ type KubernetesProxyConfig struct {
 	// +kubebuilder:validation:Optional
 	Deployment *ProxyDeployment `json:"deployment,omitempty"`
 
+	// Configuration for the HorizontalPodAutoscaler scaling the proxy deployment. When set,
+	// the number of replicas is managed by the HorizontalPodAutoscaler rather than
+	// `deployment.replicas`.
+	//
+	// +kubebuilder:validation:Optional
+	HorizontalPodAutoscaler *HorizontalPodAutoscaler `json:"horizontalPodAutoscaler,omitempty"`
+
+	// Configuration for the PodDisruptionBudget of the proxy pods.
+	//
+	// +kubebuilder:validation:Optional
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
 	// Configuration for the container running Envoy.
 	//
 	// +kubebuilder:validation:Optional
@@ -117,6 +146,20 @@ func (in *KubernetesProxyConfig) GetDeployment() *ProxyDeployment {
 	return in.Deployment
 }
 
+func (in *KubernetesProxyConfig) GetHorizontalPodAutoscaler() *HorizontalPodAutoscaler {
+	if in == nil {
+		return nil
+	}
+	return in.HorizontalPodAutoscaler
+}
+
+func (in *KubernetesProxyConfig) GetPodDisruptionBudget() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	return in.PodDisruptionBudget
+}
+
 func (in *KubernetesProxyConfig) GetEnvoyContainer() *EnvoyContainer {
 	if in == nil {
 		return nil
@@ -14,6 +14,11 @@ const (
 	RoutePolicyKind        = "RoutePolicy"
 	ListenerPolicyKind     = "ListenerPolicy"
 	HTTPListenerPolicyKind = "HTTPListenerPolicy"
+	RateLimitPolicyKind    = "RateLimitPolicy"
+	ExtAuthPolicyKind      = "ExtAuthPolicy"
+	JWTPolicyKind          = "JWTPolicy"
+	CORSPolicyKind         = "CORSPolicy"
+	UpstreamPolicyKind     = "UpstreamPolicy"
 )
 
 var (
@@ -48,4 +53,29 @@ var (
 		Version: GroupVersion.Version,
 		Kind:    HTTPListenerPolicyKind,
 	}
+	RateLimitPolicyGVK = schema.GroupVersionKind{
+		Group:   GroupName,
+		Version: GroupVersion.Version,
+		Kind:    RateLimitPolicyKind,
+	}
+	ExtAuthPolicyGVK = schema.GroupVersionKind{
+		Group:   GroupName,
+		Version: GroupVersion.Version,
+		Kind:    ExtAuthPolicyKind,
+	}
+	JWTPolicyGVK = schema.GroupVersionKind{
+		Group:   GroupName,
+		Version: GroupVersion.Version,
+		Kind:    JWTPolicyKind,
+	}
+	CORSPolicyGVK = schema.GroupVersionKind{
+		Group:   GroupName,
+		Version: GroupVersion.Version,
+		Kind:    CORSPolicyKind,
+	}
+	UpstreamPolicyGVK = schema.GroupVersionKind{
+		Group:   GroupName,
+		Version: GroupVersion.Version,
+		Kind:    UpstreamPolicyKind,
+	}
 )
@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HealthCheck configures active health checking of the endpoints of an upstream.
+// +kubebuilder:validation:XValidation:message="There must one and only one health check type set",rule="1 == (has(self.http)?1:0) + (has(self.grpc)?1:0) + (has(self.tcp)?1:0)"
+type HealthCheck struct {
+	// Timeout is the time to wait for a health check response. Defaults to 1s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Interval between health checks. Defaults to 10s.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// UnhealthyThreshold is the number of failed health checks before an endpoint is marked
+	// unhealthy. Defaults to 2.
+	// +kubebuilder:validation:Minimum=1
+	UnhealthyThreshold *uint32 `json:"unhealthyThreshold,omitempty"`
+
+	// HealthyThreshold is the number of successful health checks before an endpoint is marked
+	// healthy. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	HealthyThreshold *uint32 `json:"healthyThreshold,omitempty"`
+
+	// Http checks the endpoints with HTTP requests.
+	Http *HttpHealthCheck `json:"http,omitempty"`
+
+	// Grpc checks the endpoints with the gRPC health checking protocol.
+	Grpc *GrpcHealthCheck `json:"grpc,omitempty"`
+
+	// Tcp checks the endpoints by opening TCP connections.
+	Tcp *TcpHealthCheck `json:"tcp,omitempty"`
+}
+
+// HttpHealthCheck configures HTTP health checks.
+type HttpHealthCheck struct {
+	// Path of the health check requests.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path"`
+
+	// Host header of the health check requests. Defaults to the name of the cluster.
+	Host string `json:"host,omitempty"`
+
+	// ExpectedStatuses are the ranges of response statuses considered healthy. Defaults to 200.
+	ExpectedStatuses []HTTPStatusRange `json:"expectedStatuses,omitempty"`
+}
+
+// HTTPStatusRange is an inclusive range of HTTP response statuses.
+// +kubebuilder:validation:XValidation:message="start must not be greater than end",rule="self.start <= self.end"
+type HTTPStatusRange struct {
+	// +kubebuilder:validation:Required
+	Start HTTPStatusCode `json:"start"`
+	// +kubebuilder:validation:Required
+	End HTTPStatusCode `json:"end"`
+}
+
+// GrpcHealthCheck configures gRPC health checks.
+type GrpcHealthCheck struct {
+	// ServiceName is the name of the service to check. The whole server is checked if empty.
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// Authority header of the health check requests. Defaults to the name of the cluster.
+	Authority string `json:"authority,omitempty"`
+}
+
+// TcpHealthCheck configures TCP health checks. The endpoint is healthy if the connection succeeds
+// and, if set, the response contains the expected payloads.
+type TcpHealthCheck struct {
+	// Send is the payload sent once connected.
+	Send string `json:"send,omitempty"`
+
+	// Receive are the payloads expected in the response.
+	Receive []string `json:"receive,omitempty"`
+}
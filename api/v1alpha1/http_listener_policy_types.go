@@ -32,14 +32,272 @@ type HTTPListenerPolicyList struct {
 
 type HTTPListenerPolicySpec struct {
 	TargetRef LocalPolicyTargetReference `json:"targetRef,omitempty"`
-	Compress  bool                       `json:"compress,omitempty"`
+
+	// Compress compresses responses with gzip, with the default settings.
+	//
+	// Deprecated: use Compression instead. Compress is ignored when Compression is set.
+	Compress bool `json:"compress,omitempty"`
+
+	// Compression compresses responses according to the encodings accepted by clients.
+	// Routes can disable it with a RoutePolicy.
+	Compression *Compression `json:"compression,omitempty"`
 
 	// AccessLoggingConfig contains various settings for Envoy's access logging service.
 	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/api-v3/config/accesslog/v3/accesslog.proto
 	// +kubebuilder:validation:Items={type=object}
 	AccessLog []AccessLog `json:"accessLog,omitempty"`
+
+	// TcpAccessLog are the access logs of the connections of TCP listeners, e.g. the ones of TCPRoutes.
+	// Filters and formatters that depend on HTTP requests, such as header or status code filters, don't match TCP connections.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/api-v3/extensions/filters/network/tcp_proxy/v3/tcp_proxy.proto
+	// +kubebuilder:validation:Items={type=object}
+	TcpAccessLog []AccessLog `json:"tcpAccessLog,omitempty"`
+
+	// UpstreamAccessLog are the access logs of the requests the router sends to upstreams,
+	// e.g. one for each retry of a request.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/api-v3/extensions/filters/http/router/v3/router.proto
+	// +kubebuilder:validation:Items={type=object}
+	UpstreamAccessLog []AccessLog `json:"upstreamAccessLog,omitempty"`
+
+	// AccessLogFlushInterval periodically flushes the access logs of long-lived requests and connections,
+	// in addition to the log written when they end. It applies to AccessLog, TcpAccessLog and UpstreamAccessLog,
+	// and must be at least 1ms.
+	AccessLogFlushInterval *metav1.Duration `json:"accessLogFlushInterval,omitempty"`
+
+	// XffNumTrustedHops is the number of additional ingress proxy hops from the right side of the
+	// x-forwarded-for header to trust when determining the origin client's IP address.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/http/http_conn_man/headers#x-forwarded-for
+	XffNumTrustedHops *uint32 `json:"xffNumTrustedHops,omitempty"`
+
+	// UseRemoteAddress makes the connection's remote address the origin client's address,
+	// instead of the one found in the x-forwarded-for header. Defaults to true.
+	UseRemoteAddress *bool `json:"useRemoteAddress,omitempty"`
+
+	// ServerHeaderTransformation controls how the server header of responses is set.
+	// Defaults to Overwrite.
+	ServerHeaderTransformation *ServerHeaderTransformation `json:"serverHeaderTransformation,omitempty"`
+
+	// ServerName is the value of the server header set on responses. Defaults to "envoy".
+	ServerName string `json:"serverName,omitempty"`
+
+	// MaxRequestHeadersKb is the maximum size of the request headers, in KiB. Defaults to 60.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8192
+	MaxRequestHeadersKb *uint32 `json:"maxRequestHeadersKb,omitempty"`
+
+	// StreamIdleTimeout is how long a stream can be without activity before it is reset.
+	// Defaults to 5m. Set to 0s to disable it.
+	StreamIdleTimeout *metav1.Duration `json:"streamIdleTimeout,omitempty"`
+
+	// IdleTimeout is how long a downstream connection can be without active streams before it is
+	// closed. Defaults to 1h. Set to 0s to disable it.
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// RequestTimeout is how long envoy waits for the entire request to be received.
+	// Disabled by default.
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+
+	// NormalizePath normalizes the request path according to RFC 3986 before routing.
+	// Defaults to true.
+	NormalizePath *bool `json:"normalizePath,omitempty"`
+
+	// MergeSlashes merges adjacent slashes in the request path before routing. Defaults to true.
+	MergeSlashes *bool `json:"mergeSlashes,omitempty"`
+
+	// Http1ProtocolOptions configures HTTP/1 connections from downstream clients.
+	Http1ProtocolOptions *Http1ProtocolOptions `json:"http1ProtocolOptions,omitempty"`
+
+	// Http2ProtocolOptions configures HTTP/2 connections from downstream clients.
+	Http2ProtocolOptions *Http2ProtocolOptions `json:"http2ProtocolOptions,omitempty"`
+
+	// PreserveExternalRequestId keeps the x-request-id header of requests from external clients,
+	// instead of generating a new one.
+	PreserveExternalRequestId *bool `json:"preserveExternalRequestId,omitempty"`
+
+	// Tracing sends the spans of requests to a tracing collector.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/intro/arch_overview/observability/tracing
+	Tracing *Tracing `json:"tracing,omitempty"`
 }
 
+// Compression configures the compression of responses. When a client accepts several of the
+// configured encodings, the one with the highest quality value in its accept-encoding header is used.
+// +kubebuilder:validation:XValidation:message="at least one compressor must be set",rule="has(self.gzip) || has(self.brotli) || has(self.zstd)"
+type Compression struct {
+	// Gzip compresses responses with gzip.
+	Gzip *GzipCompressor `json:"gzip,omitempty"`
+
+	// Brotli compresses responses with brotli.
+	Brotli *BrotliCompressor `json:"brotli,omitempty"`
+
+	// Zstd compresses responses with zstd.
+	Zstd *ZstdCompressor `json:"zstd,omitempty"`
+
+	// MinContentLength is the minimum size of the responses to compress, in bytes. Defaults to 30.
+	MinContentLength *uint32 `json:"minContentLength,omitempty"`
+
+	// ContentTypes are the content types of the responses to compress. Defaults to
+	// application/javascript, application/json, application/xhtml+xml, image/svg+xml, text/css,
+	// text/html, text/plain and text/xml.
+	// +kubebuilder:validation:MaxItems=64
+	ContentTypes []string `json:"contentTypes,omitempty"`
+
+	// DisableOnEtagHeader skips the compression of responses with an etag header.
+	DisableOnEtagHeader bool `json:"disableOnEtagHeader,omitempty"`
+
+	// RemoveAcceptEncodingHeader removes the accept-encoding header from requests before they are
+	// forwarded, so that upstreams do not compress the responses themselves.
+	RemoveAcceptEncodingHeader bool `json:"removeAcceptEncodingHeader,omitempty"`
+}
+
+// GzipCompressor configures gzip compression.
+type GzipCompressor struct {
+	// Level is the compression level, from 1 (fastest) to 9 (smallest). Defaults to 6.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	Level *uint32 `json:"level,omitempty"`
+}
+
+// BrotliCompressor configures brotli compression.
+type BrotliCompressor struct {
+	// Quality is the compression quality, from 0 (fastest) to 11 (smallest). Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=11
+	Quality *uint32 `json:"quality,omitempty"`
+}
+
+// ZstdCompressor configures zstd compression.
+type ZstdCompressor struct {
+	// Level is the compression level, from 1 (fastest) to 22 (smallest). Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=22
+	Level *uint32 `json:"level,omitempty"`
+}
+
+// Tracing configures the distributed tracing of requests.
+// +kubebuilder:validation:XValidation:message="There must one and only one tracing provider set",rule="1 == (has(self.openTelemetry)?1:0) + (has(self.zipkin)?1:0)"
+type Tracing struct {
+	// OpenTelemetry sends the spans to an OpenTelemetry collector.
+	OpenTelemetry *OpenTelemetryTracingProvider `json:"openTelemetry,omitempty"`
+
+	// Zipkin sends the spans to a Zipkin collector.
+	Zipkin *ZipkinTracingProvider `json:"zipkin,omitempty"`
+
+	// ClientSampling is the percentage of requests with the x-client-trace-id header that are
+	// traced. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ClientSampling *uint32 `json:"clientSampling,omitempty"`
+
+	// RandomSampling is the percentage of the other requests that are traced. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	RandomSampling *uint32 `json:"randomSampling,omitempty"`
+
+	// OverallSampling is the percentage of the requests selected by the other sampling settings,
+	// or by a sampling decision of the client, that are traced. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	OverallSampling *uint32 `json:"overallSampling,omitempty"`
+
+	// CustomTags are added to the spans.
+	// +kubebuilder:validation:MaxItems=32
+	CustomTags []CustomTag `json:"customTags,omitempty"`
+
+	// SpawnUpstreamSpan creates a span for each request to an upstream, in addition to the span of
+	// the downstream request.
+	SpawnUpstreamSpan bool `json:"spawnUpstreamSpan,omitempty"`
+}
+
+// OpenTelemetryTracingProvider sends spans to an OpenTelemetry collector with OTLP over gRPC.
+type OpenTelemetryTracingProvider struct {
+	// The OpenTelemetry collector. Can be any type of supported backed (Kubernetes Service, kgateway
+	// Upstream, etc..), that accepts gRPC requests.
+	// +kubebuilder:validation:Required
+	BackendRef *gwv1.BackendRef `json:"backendRef"`
+
+	// ServiceName is the service name of the spans. Defaults to the name of the envoy cluster of
+	// the proxy, `<gateway name>.<gateway namespace>`.
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// ZipkinTracingProvider sends spans to a Zipkin collector with the JSON v2 API.
+type ZipkinTracingProvider struct {
+	// The Zipkin collector. Can be any type of supported backed (Kubernetes Service, kgateway
+	// Upstream, etc..).
+	// +kubebuilder:validation:Required
+	BackendRef *gwv1.BackendRef `json:"backendRef"`
+
+	// CollectorEndpoint is the path of the collector API. Defaults to /api/v2/spans.
+	CollectorEndpoint string `json:"collectorEndpoint,omitempty"`
+
+	// TraceId128Bit generates 128 bit trace ids instead of 64 bit ones.
+	TraceId128Bit bool `json:"traceId128Bit,omitempty"`
+}
+
+// CustomTag is a tag added to spans.
+// +kubebuilder:validation:XValidation:message="There must one and only one custom tag value set",rule="1 == (has(self.literal)?1:0) + (has(self.requestHeader)?1:0) + (has(self.environment)?1:0)"
+type CustomTag struct {
+	// Tag is the name of the tag.
+	// +kubebuilder:validation:MinLength=1
+	Tag string `json:"tag"`
+
+	// Literal is a static value of the tag.
+	Literal *string `json:"literal,omitempty"`
+
+	// RequestHeader takes the value of the tag from a request header.
+	RequestHeader *CustomTagSource `json:"requestHeader,omitempty"`
+
+	// Environment takes the value of the tag from an environment variable of the proxy.
+	Environment *CustomTagSource `json:"environment,omitempty"`
+}
+
+// CustomTagSource is a named source of the value of a custom tag.
+type CustomTagSource struct {
+	// Name of the request header or environment variable.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// DefaultValue is the value of the tag when the source is missing. The tag is omitted by default.
+	DefaultValue string `json:"defaultValue,omitempty"`
+}
+
+// ServerHeaderTransformation controls how the server header of responses is set.
+// +kubebuilder:validation:Enum=Overwrite;AppendIfAbsent;PassThrough
+type ServerHeaderTransformation string
+
+const (
+	// Overwrite always sets the server header to the server name.
+	OverwriteServerHeader ServerHeaderTransformation = "Overwrite"
+	// AppendIfAbsent sets the server header to the server name if the upstream did not set it.
+	AppendIfAbsentServerHeader ServerHeaderTransformation = "AppendIfAbsent"
+	// PassThrough keeps the server header of the upstream, and does not set one otherwise.
+	PassThroughServerHeader ServerHeaderTransformation = "PassThrough"
+)
+
+// Http1ProtocolOptions configures HTTP/1 connections.
+// +kubebuilder:validation:XValidation:message="defaultHostForHttp10 requires acceptHttp10",rule="!has(self.defaultHostForHttp10) || (has(self.acceptHttp10) && self.acceptHttp10)"
+type Http1ProtocolOptions struct {
+	// AcceptHttp10 accepts HTTP/1.0 and HTTP/0.9 requests.
+	AcceptHttp10 bool `json:"acceptHttp10,omitempty"`
+
+	// DefaultHostForHttp10 is the host used for HTTP/1.0 requests without a host header.
+	DefaultHostForHttp10 string `json:"defaultHostForHttp10,omitempty"`
+
+	// HeaderFormat is the casing of the response header keys. Envoy lowercases them by default.
+	HeaderFormat *HeaderFormat `json:"headerFormat,omitempty"`
+}
+
+// HeaderFormat is the casing of HTTP/1 header keys.
+// +kubebuilder:validation:Enum=ProperCase;PreserveCase
+type HeaderFormat string
+
+const (
+	// ProperCaseHeaderFormat capitalizes the first letter of each word of the header keys.
+	ProperCaseHeaderFormat HeaderFormat = "ProperCase"
+	// PreserveCaseHeaderFormat keeps the casing of the header keys as they were received.
+	PreserveCaseHeaderFormat HeaderFormat = "PreserveCase"
+)
+
 // AccessLog represents the top-level access log configuration.
 type AccessLog struct {
 	// Output access logs to local file
@@ -48,6 +306,9 @@ type AccessLog struct {
 	// Send access logs to gRPC service
 	GrpcService *GrpcService `json:"grpcService,omitempty"`
 
+	// Send access logs to an OpenTelemetry collector
+	OpenTelemetry *OpenTelemetryAccessLogService `json:"openTelemetry,omitempty"`
+
 	// Filter access logs configuration
 	Filter *AccessLogFilter `json:"filter,omitempty"`
 }
@@ -86,6 +347,32 @@ type GrpcService struct {
 	AdditionalResponseTrailersToLog []string `json:"additionalResponseTrailersToLog,omitempty"`
 }
 
+// OpenTelemetryAccessLogService represents the OpenTelemetry collector configuration for access logs.
+// The log records have the k8s.gateway.name and k8s.namespace.name resource attributes of the gateway.
+// Based on: https://www.envoyproxy.io/docs/envoy/v1.33.0/api-v3/extensions/access_loggers/open_telemetry/v3/logs_service.proto
+type OpenTelemetryAccessLogService struct {
+	// name of log stream
+	// +kubebuilder:validation:Required
+	LogName string `json:"logName"`
+
+	// The OpenTelemetry collector, receiving the logs with OTLP over gRPC. Can be any type of
+	// supported backed (Kubernetes Service, kgateway Upstream, etc..)
+	// +kubebuilder:validation:Required
+	BackendRef *gwv1.BackendRef `json:"backendRef"`
+
+	// the format string of the body of the log records.
+	// https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/observability/access_log/usage#format-strings
+	Body string `json:"body,omitempty"`
+
+	// the attributes of the log records, as an object mapping the attribute names to format
+	// strings, in the same way as the JsonFormat of a FileSink.
+	// https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/observability/access_log/usage#format-dictionaries
+	Attributes *runtime.RawExtension `json:"attributes,omitempty"`
+
+	// DisableBuiltinLabels omits the envoy built-in attributes of the log records, e.g. the log name.
+	DisableBuiltinLabels bool `json:"disableBuiltinLabels,omitempty"`
+}
+
 // AccessLogFilter represents the top-level filter structure.
 // Based on: https://www.envoyproxy.io/docs/envoy/v1.33.0/api-v3/config/accesslog/v3/accesslog.proto#config-accesslog-v3-accesslogfilter
 // +kubebuilder:validation:MaxProperties=1
@@ -0,0 +1,138 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=jwtpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=jwtpolicies/status,verbs=get;update;patch
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={app=kgateway,app.kubernetes.io/name=kgateway}
+// +kubebuilder:resource:categories=kgateway,shortName=jwtp
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=Direct"
+type JWTPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JWTPolicySpec `json:"spec,omitempty"`
+	Status PolicyStatus  `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type JWTPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JWTPolicy `json:"items"`
+}
+
+// JWTPolicySpec configures the authentication of requests with JSON Web Tokens (JWT).
+// Requests to the targeted Gateway or HTTPRoute must carry a valid JWT, as defined by the requirement.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/http/http_filters/jwt_authn_filter
+type JWTPolicySpec struct {
+	// TargetRef is the Gateway or HTTPRoute the policy applies to.
+	TargetRef LocalPolicyTargetReference `json:"targetRef,omitempty"`
+
+	// Providers are the issuers of the accepted JWTs.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Providers []JWTProvider `json:"providers"`
+
+	// Requirement defines the providers whose JWTs are accepted by the targeted routes.
+	// Defaults to requiring a valid JWT from any of the providers.
+	Requirement *JWTRequirement `json:"requirement,omitempty"`
+}
+
+// JWTProvider configures how the JWTs of an issuer are verified.
+type JWTProvider struct {
+	// Name identifies the provider in the requirement.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Issuer is the expected `iss` claim of the JWTs. Not checked when unset.
+	Issuer string `json:"issuer,omitempty"`
+
+	// Audiences lists the accepted `aud` claims of the JWTs. Not checked when unset.
+	Audiences []string `json:"audiences,omitempty"`
+
+	// JWKS is the JSON Web Key Set used to verify the signature of the JWTs.
+	// +kubebuilder:validation:Required
+	JWKS JWKS `json:"jwks"`
+
+	// Forward keeps the JWT in the request sent upstream. By default, the JWT is removed once verified.
+	Forward bool `json:"forward,omitempty"`
+
+	// ClaimsToHeaders copies claims of verified JWTs to headers of the request sent upstream.
+	ClaimsToHeaders []JWTClaimToHeader `json:"claimsToHeaders,omitempty"`
+}
+
+// JWKS is the source of a JSON Web Key Set.
+// +kubebuilder:validation:XValidation:message="exactly one of 'remote' or 'local' must be set",rule="has(self.remote) != has(self.local)"
+type JWKS struct {
+	// Remote fetches the JWKS from an HTTP server.
+	Remote *RemoteJWKS `json:"remote,omitempty"`
+
+	// Local reads the JWKS from a Secret.
+	Local *LocalJWKS `json:"local,omitempty"`
+}
+
+// RemoteJWKS configures how the JWKS is fetched.
+type RemoteJWKS struct {
+	// BackendRef is the Upstream or Service serving the JWKS.
+	// +kubebuilder:validation:Required
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
+
+	// URL of the JWKS. The host is sent as the Host header, the request is sent to the backend.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// Timeout for fetching the JWKS. Defaults to 5s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// CacheDuration is how long the JWKS is cached. Defaults to 5m.
+	CacheDuration *metav1.Duration `json:"cacheDuration,omitempty"`
+}
+
+// LocalJWKS references a Secret holding the JWKS.
+type LocalJWKS struct {
+	// SecretRef is the Secret holding the JWKS.
+	// +kubebuilder:validation:Required
+	SecretRef gwv1.SecretObjectReference `json:"secretRef"`
+
+	// Key of the JWKS in the Secret. Defaults to `jwks`.
+	// +kubebuilder:default=jwks
+	Key string `json:"key,omitempty"`
+}
+
+// JWTClaimToHeader copies a claim of a verified JWT to a request header.
+type JWTClaimToHeader struct {
+	// Claim is the name of the claim. Nested claims are separated by `.`, e.g. `user.id`.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Claim string `json:"claim"`
+
+	// Header is the name of the request header.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Header string `json:"header"`
+}
+
+// JWTRequirement defines the JWTs required by requests.
+type JWTRequirement struct {
+	// Providers lists the names of the providers whose JWTs are accepted.
+	// Defaults to all the providers of the policy.
+	Providers []string `json:"providers,omitempty"`
+
+	// RequireAll requires a valid JWT from each of the providers, instead of any of them.
+	RequireAll bool `json:"requireAll,omitempty"`
+
+	// AllowMissing accepts requests without a JWT. Requests with an invalid JWT are still rejected.
+	AllowMissing bool `json:"allowMissing,omitempty"`
+}
@@ -1,7 +1,9 @@
 package v1alpha1
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // A container image. See https://kubernetes.io/docs/concepts/containers/images
@@ -332,3 +334,123 @@ func (in *GracefulShutdownSpec) GetSleepTimeSeconds() *int {
 	}
 	return in.SleepTimeSeconds
 }
+
+// Configuration for a Kubernetes HorizontalPodAutoscaler. See
+// https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/
+// for details.
+//
+// +kubebuilder:validation:XValidation:message="minReplicas must not be greater than maxReplicas",rule="!has(self.minReplicas) || !has(self.maxReplicas) || self.minReplicas <= self.maxReplicas"
+type HorizontalPodAutoscaler struct {
+	// The lower limit for the number of replicas. Defaults to 1.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *uint32 `json:"minReplicas,omitempty"`
+
+	// The upper limit for the number of replicas. Defaults to 100.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas *uint32 `json:"maxReplicas,omitempty"`
+
+	// The target average CPU utilization of the pods, as a percentage of the
+	// requested CPU.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *uint32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// The target average memory utilization of the pods, as a percentage of the
+	// requested memory.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *uint32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Additional metrics used to compute the desired number of replicas, such as
+	// custom or external metrics.
+	//
+	// +kubebuilder:validation:Optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// The scaling behavior in the up and down directions.
+	//
+	// +kubebuilder:validation:Optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+func (in *HorizontalPodAutoscaler) GetMinReplicas() *uint32 {
+	if in == nil {
+		return nil
+	}
+	return in.MinReplicas
+}
+
+func (in *HorizontalPodAutoscaler) GetMaxReplicas() *uint32 {
+	if in == nil {
+		return nil
+	}
+	return in.MaxReplicas
+}
+
+func (in *HorizontalPodAutoscaler) GetTargetCPUUtilizationPercentage() *uint32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetCPUUtilizationPercentage
+}
+
+func (in *HorizontalPodAutoscaler) GetTargetMemoryUtilizationPercentage() *uint32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetMemoryUtilizationPercentage
+}
+
+func (in *HorizontalPodAutoscaler) GetMetrics() []autoscalingv2.MetricSpec {
+	if in == nil {
+		return nil
+	}
+	return in.Metrics
+}
+
+func (in *HorizontalPodAutoscaler) GetBehavior() *autoscalingv2.HorizontalPodAutoscalerBehavior {
+	if in == nil {
+		return nil
+	}
+	return in.Behavior
+}
+
+// Configuration for a Kubernetes PodDisruptionBudget. See
+// https://kubernetes.io/docs/tasks/run-application/configure-pdb/
+// for details. If neither `minAvailable` nor `maxUnavailable` is set, at least
+// one pod must remain available.
+//
+// +kubebuilder:validation:XValidation:message="only one of 'minAvailable' or 'maxUnavailable' may be set",rule="!(has(self.minAvailable) && has(self.maxUnavailable))"
+type PodDisruptionBudget struct {
+	// The number or percentage of pods that must remain available during a
+	// voluntary disruption, such as a node drain.
+	//
+	// +kubebuilder:validation:Optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// The number or percentage of pods that can be unavailable during a
+	// voluntary disruption, such as a node drain.
+	//
+	// +kubebuilder:validation:Optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+func (in *PodDisruptionBudget) GetMinAvailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MinAvailable
+}
+
+func (in *PodDisruptionBudget) GetMaxUnavailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MaxUnavailable
+}
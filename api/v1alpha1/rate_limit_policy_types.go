@@ -0,0 +1,142 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=ratelimitpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=ratelimitpolicies/status,verbs=get;update;patch
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={app=kgateway,app.kubernetes.io/name=kgateway}
+// +kubebuilder:resource:categories=kgateway,shortName=rlp
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=Direct"
+type RateLimitPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RateLimitPolicySpec `json:"spec,omitempty"`
+	Status PolicyStatus        `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type RateLimitPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RateLimitPolicy `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:message="at least one of 'local' or 'global' must be set",rule="has(self.local) || has(self.global)"
+type RateLimitPolicySpec struct {
+	// TargetRef is the Gateway, HTTPRoute or HTTPRoute rule (using sectionName) the policy applies to.
+	TargetRef LocalPolicyTargetReferenceWithSectionName `json:"targetRef,omitempty"`
+
+	// Local rate limits requests in each Envoy instance independently, using a token bucket.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/http/http_filters/local_rate_limit_filter
+	Local *LocalRateLimitPolicy `json:"local,omitempty"`
+
+	// Global rate limits requests using an external rate limit service.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/http/http_filters/rate_limit_filter
+	Global *GlobalRateLimitPolicy `json:"global,omitempty"`
+}
+
+// LocalRateLimitPolicy configures the local rate limit of the targeted routes.
+type LocalRateLimitPolicy struct {
+	// TokenBucket limits the rate at which requests are allowed.
+	// +kubebuilder:validation:Required
+	TokenBucket TokenBucket `json:"tokenBucket"`
+}
+
+// TokenBucket is a token bucket that is refilled at a fixed interval.
+// Each request consumes a token; requests are rejected while the bucket is empty.
+type TokenBucket struct {
+	// MaxTokens is the maximum number of tokens in the bucket, which is also its initial size.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxTokens uint32 `json:"maxTokens"`
+
+	// TokensPerFill is the number of tokens added to the bucket at each fill interval. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	TokensPerFill *uint32 `json:"tokensPerFill,omitempty"`
+
+	// FillInterval is the interval at which tokens are added to the bucket.
+	// +kubebuilder:validation:Required
+	FillInterval metav1.Duration `json:"fillInterval"`
+}
+
+// GlobalRateLimitPolicy configures the global rate limit of the targeted routes.
+type GlobalRateLimitPolicy struct {
+	// BackendRef is the rate limit service, which must implement the Envoy rate limit gRPC API.
+	// A Service must declare HTTP/2 with the `appProtocol` of its port, e.g. `kubernetes.io/h2c`.
+	// +kubebuilder:validation:Required
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
+
+	// Domain is the rate limit domain sent to the rate limit service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Domain string `json:"domain"`
+
+	// Descriptors are sent to the rate limit service for each request.
+	// Each descriptor is rate limited independently.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Descriptors []RateLimitDescriptor `json:"descriptors"`
+
+	// FailOpen allows requests when the rate limit service can't be reached.
+	FailOpen bool `json:"failOpen,omitempty"`
+
+	// Timeout for calls to the rate limit service. Defaults to 20ms.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// RateLimitDescriptor is a list of entries, which the rate limit service matches against its configuration.
+type RateLimitDescriptor struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Entries []RateLimitDescriptorEntry `json:"entries"`
+}
+
+// RateLimitDescriptorEntry is a single entry of a descriptor.
+// +kubebuilder:validation:XValidation:message="'header' must be set for the Header type",rule="self.type != 'Header' || has(self.header)"
+// +kubebuilder:validation:XValidation:message="'generic' must be set for the Generic type",rule="self.type != 'Generic' || has(self.generic)"
+type RateLimitDescriptorEntry struct {
+	// Type of the entry, which determines where its value is taken from.
+	// +kubebuilder:validation:Required
+	Type RateLimitDescriptorEntryType `json:"type"`
+
+	// Header is the name of the request header used as the value of a Header entry.
+	// The entry key is the header name. Requests without the header are not rate limited by the descriptor.
+	Header *string `json:"header,omitempty"`
+
+	// Generic is the key and value of a Generic entry.
+	Generic *RateLimitDescriptorEntryGeneric `json:"generic,omitempty"`
+}
+
+// RateLimitDescriptorEntryType is the type of a descriptor entry.
+// +kubebuilder:validation:Enum=Generic;Header;RemoteAddress;Path
+type RateLimitDescriptorEntryType string
+
+const (
+	// RateLimitDescriptorEntryTypeGeneric is a static key and value.
+	RateLimitDescriptorEntryTypeGeneric RateLimitDescriptorEntryType = "Generic"
+	// RateLimitDescriptorEntryTypeHeader is the value of a request header.
+	RateLimitDescriptorEntryTypeHeader RateLimitDescriptorEntryType = "Header"
+	// RateLimitDescriptorEntryTypeRemoteAddress is the client address, with the "remote_address" key.
+	RateLimitDescriptorEntryTypeRemoteAddress RateLimitDescriptorEntryType = "RemoteAddress"
+	// RateLimitDescriptorEntryTypePath is the request path, with the "path" key.
+	RateLimitDescriptorEntryTypePath RateLimitDescriptorEntryType = "Path"
+)
+
+// RateLimitDescriptorEntryGeneric is a static descriptor entry.
+type RateLimitDescriptorEntryGeneric struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
@@ -30,6 +30,198 @@ type RoutePolicyList struct {
 
 type RoutePolicySpec struct {
 	TargetRef LocalPolicyTargetReference `json:"targetRef,omitempty"`
+
+	// Timeout is the request timeout in seconds.
+	// Superseded by Timeouts.Request, which takes precedence when both are set.
 	// +kubebuilder:validation:Minimum=1
 	Timeout int `json:"timeout,omitempty"`
+
+	// Timeouts configures the request and idle timeouts of the route.
+	Timeouts *Timeouts `json:"timeouts,omitempty"`
+
+	// Retry configures how failed requests are retried.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/api-v3/config/route/v3/route_components.proto#config-route-v3-retrypolicy
+	Retry *RetryPolicy `json:"retry,omitempty"`
+
+	// Fault injects delays and aborts into a percentage of requests.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/http/http_filters/fault_filter
+	Fault *FaultInjection `json:"fault,omitempty"`
+
+	// HashPolicies are the keys of the consistent hash of requests, which routes requests with the
+	// same keys to the same endpoint. The backends must use the ring hash or maglev load balancer of
+	// an UpstreamPolicy.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/api-v3/config/route/v3/route_components.proto#config-route-v3-routeaction-hashpolicy
+	// +kubebuilder:validation:MaxItems=16
+	HashPolicies []HashPolicy `json:"hashPolicies,omitempty"`
+
+	// DisableCompression disables the compression of responses configured by an
+	// HTTPListenerPolicy, e.g. for server-sent events.
+	DisableCompression bool `json:"disableCompression,omitempty"`
+
+	// Tracing overrides the tracing settings of the HTTPListenerPolicy for the route.
+	Tracing *RouteTracing `json:"tracing,omitempty"`
+}
+
+// RouteTracing overrides the tracing settings of a route. When any sampling setting is set, the
+// sampling settings of the route replace those of the listener, with a default of 100.
+type RouteTracing struct {
+	// SpanName is the name of the spans of the route. Defaults to a name generated by envoy.
+	SpanName string `json:"spanName,omitempty"`
+
+	// ClientSampling is the percentage of requests with the x-client-trace-id header that are
+	// traced.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ClientSampling *uint32 `json:"clientSampling,omitempty"`
+
+	// RandomSampling is the percentage of the other requests that are traced.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	RandomSampling *uint32 `json:"randomSampling,omitempty"`
+
+	// OverallSampling is the percentage of the requests selected by the other sampling settings,
+	// or by a sampling decision of the client, that are traced.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	OverallSampling *uint32 `json:"overallSampling,omitempty"`
+}
+
+// HashPolicy is a key of the consistent hash of requests. The keys of all the hash policies are
+// combined, policies whose key is missing from the request are skipped.
+// +kubebuilder:validation:XValidation:message="There must one and only one hash policy type set",rule="1 == (has(self.header)?1:0) + (has(self.cookie)?1:0) + (has(self.sourceIP)?1:0) + (has(self.queryParameter)?1:0)"
+type HashPolicy struct {
+	// Header hashes the value of a request header.
+	Header *HeaderHashPolicy `json:"header,omitempty"`
+
+	// Cookie hashes the value of a cookie.
+	Cookie *CookieHashPolicy `json:"cookie,omitempty"`
+
+	// SourceIP hashes the IP address of the client.
+	SourceIP *SourceIPHashPolicy `json:"sourceIP,omitempty"`
+
+	// QueryParameter hashes the value of a query parameter.
+	QueryParameter *QueryParameterHashPolicy `json:"queryParameter,omitempty"`
+
+	// Terminal skips the following hash policies if this one has a key.
+	Terminal bool `json:"terminal,omitempty"`
+}
+
+// HeaderHashPolicy hashes the value of a request header.
+type HeaderHashPolicy struct {
+	// Name of the header.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// CookieHashPolicy hashes the value of a cookie.
+type CookieHashPolicy struct {
+	// Name of the cookie.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// TTL of the cookie generated when the request has none, which makes sessions sticky.
+	// No cookie is generated if unset.
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// Path of the generated cookie.
+	Path string `json:"path,omitempty"`
+}
+
+// SourceIPHashPolicy hashes the IP address of the client.
+type SourceIPHashPolicy struct{}
+
+// QueryParameterHashPolicy hashes the value of a query parameter.
+type QueryParameterHashPolicy struct {
+	// Name of the query parameter.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// Timeouts configures the timeouts of a route.
+type Timeouts struct {
+	// Request is the timeout for the entire request, including retries.
+	// A value of 0 disables the timeout.
+	Request *metav1.Duration `json:"request,omitempty"`
+
+	// Idle is the timeout for the stream being idle, i.e. with no activity
+	// in either direction. A value of 0 disables the timeout.
+	Idle *metav1.Duration `json:"idle,omitempty"`
+}
+
+// RetryPolicy configures how failed requests are retried.
+type RetryPolicy struct {
+	// RetryOn lists the conditions under which a request is retried.
+	// +kubebuilder:validation:MinItems=1
+	RetryOn []RetryOnCondition `json:"retryOn"`
+
+	// NumRetries is the maximum number of retries. Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	NumRetries *uint32 `json:"numRetries,omitempty"`
+
+	// PerTryTimeout is the timeout of each attempt, including the first one.
+	PerTryTimeout *metav1.Duration `json:"perTryTimeout,omitempty"`
+
+	// Backoff configures the exponential backoff between retries.
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// RetriableStatusCodes lists the status codes that trigger a retry.
+	// Setting this implies the `retriable-status-codes` retryOn condition.
+	RetriableStatusCodes []HTTPStatusCode `json:"retriableStatusCodes,omitempty"`
+}
+
+// RetryOnCondition is a condition under which a request is retried.
+// Based on: https://www.envoyproxy.io/docs/envoy/v1.33.0/configuration/http/http_filters/router_filter#x-envoy-retry-on
+// +kubebuilder:validation:Enum="5xx";gateway-error;reset;reset-before-request;connect-failure;envoy-ratelimited;retriable-4xx;refused-stream;retriable-status-codes;retriable-headers;http3-post-connect-failure;cancelled;deadline-exceeded;internal;resource-exhausted;unavailable
+type RetryOnCondition string
+
+// HTTPStatusCode is an HTTP response status code.
+// +kubebuilder:validation:Minimum=100
+// +kubebuilder:validation:Maximum=599
+type HTTPStatusCode uint32
+
+// RetryBackoff configures the exponential backoff between retries.
+type RetryBackoff struct {
+	// BaseInterval is the base interval between retries.
+	// +kubebuilder:validation:Required
+	BaseInterval metav1.Duration `json:"baseInterval"`
+
+	// MaxInterval is the maximum interval between retries.
+	// Defaults to 10 times the base interval.
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+}
+
+// FaultInjection configures the faults injected into requests.
+// +kubebuilder:validation:MinProperties=1
+type FaultInjection struct {
+	// Delay delays a percentage of requests before forwarding them.
+	Delay *FaultDelay `json:"delay,omitempty"`
+
+	// Abort responds to a percentage of requests with an error instead of forwarding them.
+	Abort *FaultAbort `json:"abort,omitempty"`
+}
+
+// FaultDelay delays a percentage of requests.
+type FaultDelay struct {
+	// FixedDelay is the delay added to the request.
+	// +kubebuilder:validation:Required
+	FixedDelay metav1.Duration `json:"fixedDelay"`
+
+	// Percentage of requests to delay. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *uint32 `json:"percentage,omitempty"`
+}
+
+// FaultAbort aborts a percentage of requests.
+type FaultAbort struct {
+	// HttpStatus is the status code returned for aborted requests.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	HttpStatus uint32 `json:"httpStatus"`
+
+	// Percentage of requests to abort. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *uint32 `json:"percentage,omitempty"`
 }
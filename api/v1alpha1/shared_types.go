@@ -17,6 +17,15 @@ type LocalPolicyTargetReference struct {
 	Name gwv1.ObjectName `json:"name"`
 }
 
+// LocalPolicyTargetReferenceWithSectionName identifies a target resource, and optionally a section
+// of it (e.g. a named route rule or a gateway listener), in the same namespace as the policy.
+type LocalPolicyTargetReferenceWithSectionName struct {
+	LocalPolicyTargetReference `json:",inline"`
+
+	// SectionName is the name of a section within the target resource.
+	SectionName *gwv1.SectionName `json:"sectionName,omitempty"`
+}
+
 type PolicyStatus struct {
 	//
 	// +optional
@@ -29,10 +29,11 @@ type UpstreamList struct {
 	Items           []Upstream `json:"items"`
 }
 
-// +kubebuilder:validation:XValidation:message="There must one and only one upstream type set",rule="1 == (self.aws != null?1:0) + (self.static != null?1:0)"
+// +kubebuilder:validation:XValidation:message="There must one and only one upstream type set",rule="1 == (self.aws != null?1:0) + (self.static != null?1:0) + (self.dns != null?1:0)"
 type UpstreamSpec struct {
 	Aws    *AwsUpstream    `json:"aws,omitempty"`
 	Static *StaticUpstream `json:"static,omitempty"`
+	Dns    *DnsUpstream    `json:"dns,omitempty"`
 }
 type AwsUpstream struct {
 	Region    string                      `json:"region,omitempty"`
@@ -42,6 +43,61 @@ type StaticUpstream struct {
 	Hosts []Host `json:"hosts,omitempty"`
 }
 
+// DnsUpstream is an external backend whose hosts are resolved by Envoy.
+// +kubebuilder:validation:XValidation:message="logical DNS upstreams must have exactly one host",rule="!has(self.lookupType) || self.lookupType != 'Logical' || size(self.hosts) == 1"
+type DnsUpstream struct {
+	// Hosts are the hostnames of the backend.
+	// +kubebuilder:validation:MinItems=1
+	Hosts []Host `json:"hosts"`
+
+	// LookupType is how Envoy resolves the hosts. `Strict` load balances over all the resolved
+	// addresses, `Logical` only connects to the first one, which suits large web services
+	// returning many addresses. Defaults to `Strict`.
+	// +kubebuilder:default=Strict
+	LookupType DnsLookupType `json:"lookupType,omitempty"`
+
+	// RefreshRate is the interval at which the hosts are resolved again. Defaults to 5s.
+	RefreshRate *metav1.Duration `json:"refreshRate,omitempty"`
+
+	// RespectDnsTtl uses the TTL of the DNS records as refresh rate instead of RefreshRate.
+	RespectDnsTtl bool `json:"respectDnsTtl,omitempty"`
+
+	// Tls originates TLS to the backend.
+	Tls *UpstreamTls `json:"tls,omitempty"`
+
+	// Http2 sends HTTP/2 requests to the backend.
+	Http2 bool `json:"http2,omitempty"`
+
+	// HealthCheck actively checks the health of the resolved hosts.
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+}
+
+// DnsLookupType is how Envoy resolves the hosts of a DnsUpstream.
+// +kubebuilder:validation:Enum=Strict;Logical
+type DnsLookupType string
+
+const (
+	DnsLookupTypeStrict  DnsLookupType = "Strict"
+	DnsLookupTypeLogical DnsLookupType = "Logical"
+)
+
+// UpstreamTls configures TLS origination to an upstream.
+type UpstreamTls struct {
+	// Sni is the server name sent in the TLS handshake. Defaults to the first host.
+	Sni string `json:"sni,omitempty"`
+
+	// CACertificateRefs are Secrets, in the namespace of the upstream, holding the CA certificates
+	// under the `ca.crt` key. The CA certificates of the system are used if empty.
+	// +kubebuilder:validation:MaxItems=8
+	CACertificateRefs []corev1.LocalObjectReference `json:"caCertificateRefs,omitempty"`
+
+	// SubjectAltNames the certificate of the backend must match one of. Defaults to the SNI.
+	SubjectAltNames []string `json:"subjectAltNames,omitempty"`
+
+	// InsecureSkipVerify disables the validation of the certificate of the backend.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
 type Host struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=253
@@ -56,3 +112,188 @@ type UpstreamStatus struct {
 	// +kubebuilder:validation:MaxItems=8
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
+
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=upstreampolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=upstreampolicies/status,verbs=get;update;patch
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={app=kgateway,app.kubernetes.io/name=kgateway}
+// +kubebuilder:resource:categories=kgateway,shortName=upp
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=Direct"
+type UpstreamPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UpstreamPolicySpec `json:"spec,omitempty"`
+	Status PolicyStatus       `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type UpstreamPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpstreamPolicy `json:"items"`
+}
+
+// UpstreamPolicySpec configures the connections to an upstream.
+// When several policies target the same upstream, the fields set by the oldest policy win.
+type UpstreamPolicySpec struct {
+	// TargetRef is the Service, Service port (using sectionName) or Upstream the policy applies to.
+	// +kubebuilder:validation:XValidation:message="targetRef must be a Service or an Upstream",rule="(self.group == '' && self.kind == 'Service') || (self.group == 'gateway.kgateway.dev' && self.kind == 'Upstream')"
+	TargetRef LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
+
+	// ConnectTimeout is the timeout for new connections to the upstream. Defaults to 5s.
+	ConnectTimeout *metav1.Duration `json:"connectTimeout,omitempty"`
+
+	// TcpKeepalive enables TCP keepalive on the connections to the upstream.
+	TcpKeepalive *TcpKeepalive `json:"tcpKeepalive,omitempty"`
+
+	// CircuitBreakers limits the connections and requests to the upstream.
+	CircuitBreakers *CircuitBreakers `json:"circuitBreakers,omitempty"`
+
+	// OutlierDetection ejects failing endpoints from load balancing.
+	OutlierDetection *OutlierDetection `json:"outlierDetection,omitempty"`
+
+	// Http2ProtocolOptions makes the upstream use HTTP/2 with the given options.
+	Http2ProtocolOptions *Http2ProtocolOptions `json:"http2ProtocolOptions,omitempty"`
+
+	// LoadBalancer is the load balancing algorithm over the endpoints of the upstream.
+	// Defaults to round robin.
+	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
+
+	// HealthCheck actively checks the health of the endpoints of the upstream. Unhealthy endpoints
+	// are removed from load balancing, in addition to the endpoints that are not ready.
+	// It replaces the health check of a DNS upstream.
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+}
+
+// TcpKeepalive configures TCP keepalive probes.
+type TcpKeepalive struct {
+	// Probes is the number of unanswered probes before the connection is dropped.
+	// Defaults to the system setting, usually 9.
+	// +kubebuilder:validation:Minimum=1
+	Probes *uint32 `json:"probes,omitempty"`
+
+	// Time is how long a connection is idle before probes are sent. Defaults to the system setting,
+	// usually 2h.
+	Time *metav1.Duration `json:"time,omitempty"`
+
+	// Interval between probes. Defaults to the system setting, usually 75s.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// CircuitBreakers limits the resources used for an upstream. Each limit defaults to 1024.
+type CircuitBreakers struct {
+	// MaxConnections is the maximum number of connections to the upstream.
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+
+	// MaxPendingRequests is the maximum number of requests waiting for a connection.
+	MaxPendingRequests *uint32 `json:"maxPendingRequests,omitempty"`
+
+	// MaxRequests is the maximum number of concurrent requests to the upstream.
+	MaxRequests *uint32 `json:"maxRequests,omitempty"`
+
+	// MaxRetries is the maximum number of concurrent retries to the upstream. Defaults to 3.
+	MaxRetries *uint32 `json:"maxRetries,omitempty"`
+}
+
+// OutlierDetection configures passive health checking of the endpoints of an upstream.
+type OutlierDetection struct {
+	// Consecutive5xx is the number of consecutive 5xx responses before an endpoint is ejected.
+	// Defaults to 5.
+	Consecutive5xx *uint32 `json:"consecutive5xx,omitempty"`
+
+	// ConsecutiveGatewayErrors is the number of consecutive 502, 503 or 504 responses before an
+	// endpoint is ejected. Disabled if unset.
+	ConsecutiveGatewayErrors *uint32 `json:"consecutiveGatewayErrors,omitempty"`
+
+	// Interval between ejection analyses. Defaults to 10s.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// BaseEjectionTime is how long an endpoint is ejected, multiplied by the number of times it
+	// was ejected. Defaults to 30s.
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+
+	// MaxEjectionPercent is the maximum percentage of endpoints that can be ejected. Defaults to 10.
+	// +kubebuilder:validation:Maximum=100
+	MaxEjectionPercent *uint32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// Http2ProtocolOptions configures HTTP/2 connections.
+type Http2ProtocolOptions struct {
+	// MaxConcurrentStreams is the maximum number of concurrent streams per connection.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2147483647
+	MaxConcurrentStreams *uint32 `json:"maxConcurrentStreams,omitempty"`
+
+	// InitialStreamWindowSize is the initial flow control window of each stream, in bytes.
+	// +kubebuilder:validation:Minimum=65535
+	// +kubebuilder:validation:Maximum=2147483647
+	InitialStreamWindowSize *uint32 `json:"initialStreamWindowSize,omitempty"`
+
+	// InitialConnectionWindowSize is the initial flow control window of each connection, in bytes.
+	// +kubebuilder:validation:Minimum=65535
+	// +kubebuilder:validation:Maximum=2147483647
+	InitialConnectionWindowSize *uint32 `json:"initialConnectionWindowSize,omitempty"`
+}
+
+// LoadBalancer configures the load balancing algorithm of an upstream.
+// +kubebuilder:validation:XValidation:message="There must one and only one load balancer type set",rule="1 == (has(self.roundRobin)?1:0) + (has(self.leastRequest)?1:0) + (has(self.ringHash)?1:0) + (has(self.maglev)?1:0)"
+type LoadBalancer struct {
+	// RoundRobin picks the endpoints in turn.
+	RoundRobin *RoundRobinLoadBalancer `json:"roundRobin,omitempty"`
+
+	// LeastRequest picks the endpoint with the fewest active requests out of a random sample.
+	LeastRequest *LeastRequestLoadBalancer `json:"leastRequest,omitempty"`
+
+	// RingHash consistently hashes requests to endpoints, using the hash policies of the route.
+	RingHash *RingHashLoadBalancer `json:"ringHash,omitempty"`
+
+	// Maglev consistently hashes requests to endpoints, using the hash policies of the route.
+	// It is faster to build and look up than RingHash, but less stable when endpoints change.
+	Maglev *MaglevLoadBalancer `json:"maglev,omitempty"`
+}
+
+// RoundRobinLoadBalancer configures round robin load balancing.
+type RoundRobinLoadBalancer struct {
+	// SlowStartWindow is how long the traffic to new endpoints is progressively increased.
+	SlowStartWindow *metav1.Duration `json:"slowStartWindow,omitempty"`
+}
+
+// LeastRequestLoadBalancer configures least request load balancing.
+type LeastRequestLoadBalancer struct {
+	// ChoiceCount is the number of random endpoints compared. Defaults to 2.
+	// +kubebuilder:validation:Minimum=2
+	ChoiceCount *uint32 `json:"choiceCount,omitempty"`
+
+	// SlowStartWindow is how long the traffic to new endpoints is progressively increased.
+	SlowStartWindow *metav1.Duration `json:"slowStartWindow,omitempty"`
+}
+
+// RingHashLoadBalancer configures ring hash load balancing.
+type RingHashLoadBalancer struct {
+	// MinimumRingSize is the minimum number of entries of the hash ring. Defaults to 1024.
+	// +kubebuilder:validation:Minimum=1
+	MinimumRingSize *uint64 `json:"minimumRingSize,omitempty"`
+
+	// MaximumRingSize is the maximum number of entries of the hash ring. Defaults to 8M.
+	// +kubebuilder:validation:Minimum=1
+	MaximumRingSize *uint64 `json:"maximumRingSize,omitempty"`
+
+	// UseHostnameForHashing hashes the endpoints by hostname instead of address, which keeps the
+	// hash keys stable when the addresses of DNS endpoints change.
+	UseHostnameForHashing bool `json:"useHostnameForHashing,omitempty"`
+}
+
+// MaglevLoadBalancer configures maglev load balancing.
+type MaglevLoadBalancer struct {
+	// TableSize is the size of the lookup table, which must be a prime number. Defaults to 65537.
+	// +kubebuilder:validation:Minimum=1
+	TableSize *uint64 `json:"tableSize,omitempty"`
+
+	// UseHostnameForHashing hashes the endpoints by hostname instead of address, which keeps the
+	// hash keys stable when the addresses of DNS endpoints change.
+	UseHostnameForHashing bool `json:"useHostnameForHashing,omitempty"`
+}
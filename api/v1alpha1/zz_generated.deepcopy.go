@@ -5,9 +5,11 @@
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -24,6 +26,11 @@ func (in *AccessLog) DeepCopyInto(out *AccessLog) {
 		*out = new(GrpcService)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OpenTelemetry != nil {
+		in, out := &in.OpenTelemetry, &out.OpenTelemetry
+		*out = new(OpenTelemetryAccessLogService)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Filter != nil {
 		in, out := &in.Filter, &out.Filter
 		*out = new(AccessLogFilter)
@@ -90,24 +97,24 @@ func (in *AiExtension) DeepCopyInto(out *AiExtension) {
 	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.SecurityContext)
+		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
-		*out = make([]v1.ContainerPort, len(*in))
+		*out = make([]corev1.ContainerPort, len(*in))
 		copy(*out, *in)
 	}
 	if in.Stats != nil {
@@ -169,6 +176,26 @@ func (in *AwsUpstream) DeepCopy() *AwsUpstream {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrotliCompressor) DeepCopyInto(out *BrotliCompressor) {
+	*out = *in
+	if in.Quality != nil {
+		in, out := &in.Quality, &out.Quality
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrotliCompressor.
+func (in *BrotliCompressor) DeepCopy() *BrotliCompressor {
+	if in == nil {
+		return nil
+	}
+	out := new(BrotliCompressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CELFilter) DeepCopyInto(out *CELFilter) {
 	*out = *in
@@ -184,6 +211,136 @@ func (in *CELFilter) DeepCopy() *CELFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicy) DeepCopyInto(out *CORSPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicy.
+func (in *CORSPolicy) DeepCopy() *CORSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CORSPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicyList) DeepCopyInto(out *CORSPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CORSPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicyList.
+func (in *CORSPolicyList) DeepCopy() *CORSPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CORSPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicySpec) DeepCopyInto(out *CORSPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.AllowOrigins != nil {
+		in, out := &in.AllowOrigins, &out.AllowOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowMethods != nil {
+		in, out := &in.AllowMethods, &out.AllowMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowHeaders != nil {
+		in, out := &in.AllowHeaders, &out.AllowHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExposeHeaders != nil {
+		in, out := &in.ExposeHeaders, &out.ExposeHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicySpec.
+func (in *CORSPolicySpec) DeepCopy() *CORSPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakers) DeepCopyInto(out *CircuitBreakers) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakers.
+func (in *CircuitBreakers) DeepCopy() *CircuitBreakers {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComparisonFilter) DeepCopyInto(out *ComparisonFilter) {
 	*out = *in
@@ -199,6 +356,66 @@ func (in *ComparisonFilter) DeepCopy() *ComparisonFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Compression) DeepCopyInto(out *Compression) {
+	*out = *in
+	if in.Gzip != nil {
+		in, out := &in.Gzip, &out.Gzip
+		*out = new(GzipCompressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Brotli != nil {
+		in, out := &in.Brotli, &out.Brotli
+		*out = new(BrotliCompressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zstd != nil {
+		in, out := &in.Zstd, &out.Zstd
+		*out = new(ZstdCompressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinContentLength != nil {
+		in, out := &in.MinContentLength, &out.MinContentLength
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Compression.
+func (in *Compression) DeepCopy() *Compression {
+	if in == nil {
+		return nil
+	}
+	out := new(Compression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CookieHashPolicy) DeepCopyInto(out *CookieHashPolicy) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookieHashPolicy.
+func (in *CookieHashPolicy) DeepCopy() *CookieHashPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CookieHashPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomLabel) DeepCopyInto(out *CustomLabel) {
 	*out = *in
@@ -224,6 +441,51 @@ func (in *CustomLabel) DeepCopy() *CustomLabel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTag) DeepCopyInto(out *CustomTag) {
+	*out = *in
+	if in.Literal != nil {
+		in, out := &in.Literal, &out.Literal
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestHeader != nil {
+		in, out := &in.RequestHeader, &out.RequestHeader
+		*out = new(CustomTagSource)
+		**out = **in
+	}
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(CustomTagSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTag.
+func (in *CustomTag) DeepCopy() *CustomTag {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTagSource) DeepCopyInto(out *CustomTagSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTagSource.
+func (in *CustomTagSource) DeepCopy() *CustomTagSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTagSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectResponse) DeepCopyInto(out *DirectResponse) {
 	*out = *in
@@ -313,6 +575,41 @@ func (in *DirectResponseStatus) DeepCopy() *DirectResponseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DnsUpstream) DeepCopyInto(out *DnsUpstream) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]Host, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshRate != nil {
+		in, out := &in.RefreshRate, &out.RefreshRate
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Tls != nil {
+		in, out := &in.Tls, &out.Tls
+		*out = new(UpstreamTls)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DnsUpstream.
+func (in *DnsUpstream) DeepCopy() *DnsUpstream {
+	if in == nil {
+		return nil
+	}
+	out := new(DnsUpstream)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DurationFilter) DeepCopyInto(out *DurationFilter) {
 	*out = *in
@@ -370,12 +667,12 @@ func (in *EnvoyContainer) DeepCopyInto(out *EnvoyContainer) {
 	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.SecurityContext)
+		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -391,78 +688,282 @@ func (in *EnvoyContainer) DeepCopy() *EnvoyContainer {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileSink) DeepCopyInto(out *FileSink) {
+func (in *ExtAuthBufferSettings) DeepCopyInto(out *ExtAuthBufferSettings) {
 	*out = *in
-	if in.JsonFormat != nil {
-		in, out := &in.JsonFormat, &out.JsonFormat
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
-func (in *FileSink) DeepCopy() *FileSink {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthBufferSettings.
+func (in *ExtAuthBufferSettings) DeepCopy() *ExtAuthBufferSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSink)
+	out := new(ExtAuthBufferSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FilterType) DeepCopyInto(out *FilterType) {
+func (in *ExtAuthPolicy) DeepCopyInto(out *ExtAuthPolicy) {
 	*out = *in
-	if in.StatusCodeFilter != nil {
-		in, out := &in.StatusCodeFilter, &out.StatusCodeFilter
-		*out = new(StatusCodeFilter)
-		**out = **in
-	}
-	if in.DurationFilter != nil {
-		in, out := &in.DurationFilter, &out.DurationFilter
-		*out = new(DurationFilter)
-		**out = **in
-	}
-	if in.HeaderFilter != nil {
-		in, out := &in.HeaderFilter, &out.HeaderFilter
-		*out = new(HeaderFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ResponseFlagFilter != nil {
-		in, out := &in.ResponseFlagFilter, &out.ResponseFlagFilter
-		*out = new(ResponseFlagFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GrpcStatusFilter != nil {
-		in, out := &in.GrpcStatusFilter, &out.GrpcStatusFilter
-		*out = new(GrpcStatusFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CELFilter != nil {
-		in, out := &in.CELFilter, &out.CELFilter
-		*out = new(CELFilter)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterType.
-func (in *FilterType) DeepCopy() *FilterType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthPolicy.
+func (in *ExtAuthPolicy) DeepCopy() *ExtAuthPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(FilterType)
+	out := new(ExtAuthPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExtAuthPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayParameters) DeepCopyInto(out *GatewayParameters) {
+func (in *ExtAuthPolicyList) DeepCopyInto(out *ExtAuthPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
-}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExtAuthPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthPolicyList.
+func (in *ExtAuthPolicyList) DeepCopy() *ExtAuthPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExtAuthPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthPolicySpec) DeepCopyInto(out *ExtAuthPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ExtAuthService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContextExtensions != nil {
+		in, out := &in.ContextExtensions, &out.ContextExtensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthPolicySpec.
+func (in *ExtAuthPolicySpec) DeepCopy() *ExtAuthPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthService) DeepCopyInto(out *ExtAuthService) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HeadersToAuth != nil {
+		in, out := &in.HeadersToAuth, &out.HeadersToAuth
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HeadersToUpstream != nil {
+		in, out := &in.HeadersToUpstream, &out.HeadersToUpstream
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WithRequestBody != nil {
+		in, out := &in.WithRequestBody, &out.WithRequestBody
+		*out = new(ExtAuthBufferSettings)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthService.
+func (in *ExtAuthService) DeepCopy() *ExtAuthService {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultAbort) DeepCopyInto(out *FaultAbort) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbort.
+func (in *FaultAbort) DeepCopy() *FaultAbort {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultAbort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultDelay) DeepCopyInto(out *FaultDelay) {
+	*out = *in
+	out.FixedDelay = in.FixedDelay
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelay.
+func (in *FaultDelay) DeepCopy() *FaultDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbort)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjection.
+func (in *FaultInjection) DeepCopy() *FaultInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSink) DeepCopyInto(out *FileSink) {
+	*out = *in
+	if in.JsonFormat != nil {
+		in, out := &in.JsonFormat, &out.JsonFormat
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
+func (in *FileSink) DeepCopy() *FileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterType) DeepCopyInto(out *FilterType) {
+	*out = *in
+	if in.StatusCodeFilter != nil {
+		in, out := &in.StatusCodeFilter, &out.StatusCodeFilter
+		*out = new(StatusCodeFilter)
+		**out = **in
+	}
+	if in.DurationFilter != nil {
+		in, out := &in.DurationFilter, &out.DurationFilter
+		*out = new(DurationFilter)
+		**out = **in
+	}
+	if in.HeaderFilter != nil {
+		in, out := &in.HeaderFilter, &out.HeaderFilter
+		*out = new(HeaderFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseFlagFilter != nil {
+		in, out := &in.ResponseFlagFilter, &out.ResponseFlagFilter
+		*out = new(ResponseFlagFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrpcStatusFilter != nil {
+		in, out := &in.GrpcStatusFilter, &out.GrpcStatusFilter
+		*out = new(GrpcStatusFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CELFilter != nil {
+		in, out := &in.CELFilter, &out.CELFilter
+		*out = new(CELFilter)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterType.
+func (in *FilterType) DeepCopy() *FilterType {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayParameters) DeepCopyInto(out *GatewayParameters) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayParameters.
 func (in *GatewayParameters) DeepCopy() *GatewayParameters {
@@ -525,7 +1026,7 @@ func (in *GatewayParametersSpec) DeepCopyInto(out *GatewayParametersSpec) {
 	if in.SelfManaged != nil {
 		in, out := &in.SelfManaged, &out.SelfManaged
 		*out = new(SelfManagedGateway)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -554,6 +1055,34 @@ func (in *GatewayParametersStatus) DeepCopy() *GatewayParametersStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRateLimitPolicy) DeepCopyInto(out *GlobalRateLimitPolicy) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = make([]RateLimitDescriptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimitPolicy.
+func (in *GlobalRateLimitPolicy) DeepCopy() *GlobalRateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRateLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GracefulShutdownSpec) DeepCopyInto(out *GracefulShutdownSpec) {
 	*out = *in
@@ -579,6 +1108,21 @@ func (in *GracefulShutdownSpec) DeepCopy() *GracefulShutdownSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrpcHealthCheck) DeepCopyInto(out *GrpcHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrpcHealthCheck.
+func (in *GrpcHealthCheck) DeepCopy() *GrpcHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(GrpcHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrpcService) DeepCopyInto(out *GrpcService) {
 	*out = *in
@@ -634,6 +1178,26 @@ func (in *GrpcStatusFilter) DeepCopy() *GrpcStatusFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GzipCompressor) DeepCopyInto(out *GzipCompressor) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GzipCompressor.
+func (in *GzipCompressor) DeepCopy() *GzipCompressor {
+	if in == nil {
+		return nil
+	}
+	out := new(GzipCompressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPListenerPolicy) DeepCopyInto(out *HTTPListenerPolicy) {
 	*out = *in
@@ -697,6 +1261,11 @@ func (in *HTTPListenerPolicyList) DeepCopyObject() runtime.Object {
 func (in *HTTPListenerPolicySpec) DeepCopyInto(out *HTTPListenerPolicySpec) {
 	*out = *in
 	out.TargetRef = in.TargetRef
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(Compression)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AccessLog != nil {
 		in, out := &in.AccessLog, &out.AccessLog
 		*out = make([]AccessLog, len(*in))
@@ -704,30 +1273,276 @@ func (in *HTTPListenerPolicySpec) DeepCopyInto(out *HTTPListenerPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPListenerPolicySpec.
-func (in *HTTPListenerPolicySpec) DeepCopy() *HTTPListenerPolicySpec {
-	if in == nil {
-		return nil
+	if in.TcpAccessLog != nil {
+		in, out := &in.TcpAccessLog, &out.TcpAccessLog
+		*out = make([]AccessLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(HTTPListenerPolicySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HeaderFilter) DeepCopyInto(out *HeaderFilter) {
-	*out = *in
-	in.Header.DeepCopyInto(&out.Header)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderFilter.
-func (in *HeaderFilter) DeepCopy() *HeaderFilter {
-	if in == nil {
-		return nil
+	if in.UpstreamAccessLog != nil {
+		in, out := &in.UpstreamAccessLog, &out.UpstreamAccessLog
+		*out = make([]AccessLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(HeaderFilter)
+	if in.AccessLogFlushInterval != nil {
+		in, out := &in.AccessLogFlushInterval, &out.AccessLogFlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.XffNumTrustedHops != nil {
+		in, out := &in.XffNumTrustedHops, &out.XffNumTrustedHops
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.UseRemoteAddress != nil {
+		in, out := &in.UseRemoteAddress, &out.UseRemoteAddress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServerHeaderTransformation != nil {
+		in, out := &in.ServerHeaderTransformation, &out.ServerHeaderTransformation
+		*out = new(ServerHeaderTransformation)
+		**out = **in
+	}
+	if in.MaxRequestHeadersKb != nil {
+		in, out := &in.MaxRequestHeadersKb, &out.MaxRequestHeadersKb
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.StreamIdleTimeout != nil {
+		in, out := &in.StreamIdleTimeout, &out.StreamIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NormalizePath != nil {
+		in, out := &in.NormalizePath, &out.NormalizePath
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeSlashes != nil {
+		in, out := &in.MergeSlashes, &out.MergeSlashes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Http1ProtocolOptions != nil {
+		in, out := &in.Http1ProtocolOptions, &out.Http1ProtocolOptions
+		*out = new(Http1ProtocolOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Http2ProtocolOptions != nil {
+		in, out := &in.Http2ProtocolOptions, &out.Http2ProtocolOptions
+		*out = new(Http2ProtocolOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreserveExternalRequestId != nil {
+		in, out := &in.PreserveExternalRequestId, &out.PreserveExternalRequestId
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(Tracing)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPListenerPolicySpec.
+func (in *HTTPListenerPolicySpec) DeepCopy() *HTTPListenerPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPListenerPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPStatusRange) DeepCopyInto(out *HTTPStatusRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPStatusRange.
+func (in *HTTPStatusRange) DeepCopy() *HTTPStatusRange {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPStatusRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashPolicy) DeepCopyInto(out *HashPolicy) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(HeaderHashPolicy)
+		**out = **in
+	}
+	if in.Cookie != nil {
+		in, out := &in.Cookie, &out.Cookie
+		*out = new(CookieHashPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceIP != nil {
+		in, out := &in.SourceIP, &out.SourceIP
+		*out = new(SourceIPHashPolicy)
+		**out = **in
+	}
+	if in.QueryParameter != nil {
+		in, out := &in.QueryParameter, &out.QueryParameter
+		*out = new(QueryParameterHashPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashPolicy.
+func (in *HashPolicy) DeepCopy() *HashPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HashPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderFilter) DeepCopyInto(out *HeaderFilter) {
+	*out = *in
+	in.Header.DeepCopyInto(&out.Header)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderFilter.
+func (in *HeaderFilter) DeepCopy() *HeaderFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderHashPolicy) DeepCopyInto(out *HeaderHashPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderHashPolicy.
+func (in *HeaderHashPolicy) DeepCopy() *HeaderHashPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderHashPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.UnhealthyThreshold != nil {
+		in, out := &in.UnhealthyThreshold, &out.UnhealthyThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.HealthyThreshold != nil {
+		in, out := &in.HealthyThreshold, &out.HealthyThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Http != nil {
+		in, out := &in.Http, &out.Http
+		*out = new(HttpHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Grpc != nil {
+		in, out := &in.Grpc, &out.Grpc
+		*out = new(GrpcHealthCheck)
+		**out = **in
+	}
+	if in.Tcp != nil {
+		in, out := &in.Tcp, &out.Tcp
+		*out = new(TcpHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalPodAutoscaler) DeepCopyInto(out *HorizontalPodAutoscaler) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscaler.
+func (in *HorizontalPodAutoscaler) DeepCopy() *HorizontalPodAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalPodAutoscaler)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -747,6 +1562,76 @@ func (in *Host) DeepCopy() *Host {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Http1ProtocolOptions) DeepCopyInto(out *Http1ProtocolOptions) {
+	*out = *in
+	if in.HeaderFormat != nil {
+		in, out := &in.HeaderFormat, &out.HeaderFormat
+		*out = new(HeaderFormat)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Http1ProtocolOptions.
+func (in *Http1ProtocolOptions) DeepCopy() *Http1ProtocolOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(Http1ProtocolOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Http2ProtocolOptions) DeepCopyInto(out *Http2ProtocolOptions) {
+	*out = *in
+	if in.MaxConcurrentStreams != nil {
+		in, out := &in.MaxConcurrentStreams, &out.MaxConcurrentStreams
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.InitialStreamWindowSize != nil {
+		in, out := &in.InitialStreamWindowSize, &out.InitialStreamWindowSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.InitialConnectionWindowSize != nil {
+		in, out := &in.InitialConnectionWindowSize, &out.InitialConnectionWindowSize
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Http2ProtocolOptions.
+func (in *Http2ProtocolOptions) DeepCopy() *Http2ProtocolOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(Http2ProtocolOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpHealthCheck) DeepCopyInto(out *HttpHealthCheck) {
+	*out = *in
+	if in.ExpectedStatuses != nil {
+		in, out := &in.ExpectedStatuses, &out.ExpectedStatuses
+		*out = make([]HTTPStatusRange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpHealthCheck.
+func (in *HttpHealthCheck) DeepCopy() *HttpHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -772,7 +1657,7 @@ func (in *Image) DeepCopyInto(out *Image) {
 	}
 	if in.PullPolicy != nil {
 		in, out := &in.PullPolicy, &out.PullPolicy
-		*out = new(v1.PullPolicy)
+		*out = new(corev1.PullPolicy)
 		**out = **in
 	}
 }
@@ -797,12 +1682,12 @@ func (in *IstioContainer) DeepCopyInto(out *IstioContainer) {
 	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.SecurityContext)
+		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LogLevel != nil {
@@ -847,7 +1732,7 @@ func (in *IstioIntegration) DeepCopyInto(out *IstioIntegration) {
 	}
 	if in.CustomSidecars != nil {
 		in, out := &in.CustomSidecars, &out.CustomSidecars
-		*out = make([]v1.Container, len(*in))
+		*out = make([]corev1.Container, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -864,6 +1749,179 @@ func (in *IstioIntegration) DeepCopy() *IstioIntegration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWKS) DeepCopyInto(out *JWKS) {
+	*out = *in
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(RemoteJWKS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalJWKS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWKS.
+func (in *JWKS) DeepCopy() *JWKS {
+	if in == nil {
+		return nil
+	}
+	out := new(JWKS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTClaimToHeader) DeepCopyInto(out *JWTClaimToHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTClaimToHeader.
+func (in *JWTClaimToHeader) DeepCopy() *JWTClaimToHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTClaimToHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTPolicy) DeepCopyInto(out *JWTPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTPolicy.
+func (in *JWTPolicy) DeepCopy() *JWTPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JWTPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTPolicyList) DeepCopyInto(out *JWTPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JWTPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTPolicyList.
+func (in *JWTPolicyList) DeepCopy() *JWTPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JWTPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTPolicySpec) DeepCopyInto(out *JWTPolicySpec) {
+	*out = *in
+	out.TargetRef = in.TargetRef
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]JWTProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Requirement != nil {
+		in, out := &in.Requirement, &out.Requirement
+		*out = new(JWTRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTPolicySpec.
+func (in *JWTPolicySpec) DeepCopy() *JWTPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTProvider) DeepCopyInto(out *JWTProvider) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.JWKS.DeepCopyInto(&out.JWKS)
+	if in.ClaimsToHeaders != nil {
+		in, out := &in.ClaimsToHeaders, &out.ClaimsToHeaders
+		*out = make([]JWTClaimToHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTProvider.
+func (in *JWTProvider) DeepCopy() *JWTProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTRequirement) DeepCopyInto(out *JWTRequirement) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTRequirement.
+func (in *JWTRequirement) DeepCopy() *JWTRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesProxyConfig) DeepCopyInto(out *KubernetesProxyConfig) {
 	*out = *in
@@ -872,6 +1930,16 @@ func (in *KubernetesProxyConfig) DeepCopyInto(out *KubernetesProxyConfig) {
 		*out = new(ProxyDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HorizontalPodAutoscaler != nil {
+		in, out := &in.HorizontalPodAutoscaler, &out.HorizontalPodAutoscaler
+		*out = new(HorizontalPodAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnvoyContainer != nil {
 		in, out := &in.EnvoyContainer, &out.EnvoyContainer
 		*out = new(EnvoyContainer)
@@ -929,6 +1997,31 @@ func (in *KubernetesProxyConfig) DeepCopy() *KubernetesProxyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeastRequestLoadBalancer) DeepCopyInto(out *LeastRequestLoadBalancer) {
+	*out = *in
+	if in.ChoiceCount != nil {
+		in, out := &in.ChoiceCount, &out.ChoiceCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SlowStartWindow != nil {
+		in, out := &in.SlowStartWindow, &out.SlowStartWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeastRequestLoadBalancer.
+func (in *LeastRequestLoadBalancer) DeepCopy() *LeastRequestLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(LeastRequestLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ListenerPolicy) DeepCopyInto(out *ListenerPolicy) {
 	*out = *in
@@ -999,22 +2092,215 @@ func (in *ListenerPolicySpec) DeepCopy() *ListenerPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ListenerPolicySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalPolicyTargetReference) DeepCopyInto(out *LocalPolicyTargetReference) {
-	*out = *in
+	out := new(ListenerPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
+	*out = *in
+	if in.RoundRobin != nil {
+		in, out := &in.RoundRobin, &out.RoundRobin
+		*out = new(RoundRobinLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LeastRequest != nil {
+		in, out := &in.LeastRequest, &out.LeastRequest
+		*out = new(LeastRequestLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RingHash != nil {
+		in, out := &in.RingHash, &out.RingHash
+		*out = new(RingHashLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maglev != nil {
+		in, out := &in.Maglev, &out.Maglev
+		*out = new(MaglevLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
+func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalJWKS) DeepCopyInto(out *LocalJWKS) {
+	*out = *in
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalJWKS.
+func (in *LocalJWKS) DeepCopy() *LocalJWKS {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalJWKS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalPolicyTargetReference) DeepCopyInto(out *LocalPolicyTargetReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalPolicyTargetReference.
+func (in *LocalPolicyTargetReference) DeepCopy() *LocalPolicyTargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalPolicyTargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalPolicyTargetReferenceWithSectionName) DeepCopyInto(out *LocalPolicyTargetReferenceWithSectionName) {
+	*out = *in
+	out.LocalPolicyTargetReference = in.LocalPolicyTargetReference
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(apisv1.SectionName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalPolicyTargetReferenceWithSectionName.
+func (in *LocalPolicyTargetReferenceWithSectionName) DeepCopy() *LocalPolicyTargetReferenceWithSectionName {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalPolicyTargetReferenceWithSectionName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimitPolicy) DeepCopyInto(out *LocalRateLimitPolicy) {
+	*out = *in
+	in.TokenBucket.DeepCopyInto(&out.TokenBucket)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitPolicy.
+func (in *LocalRateLimitPolicy) DeepCopy() *LocalRateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaglevLoadBalancer) DeepCopyInto(out *MaglevLoadBalancer) {
+	*out = *in
+	if in.TableSize != nil {
+		in, out := &in.TableSize, &out.TableSize
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaglevLoadBalancer.
+func (in *MaglevLoadBalancer) DeepCopy() *MaglevLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(MaglevLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenTelemetryAccessLogService) DeepCopyInto(out *OpenTelemetryAccessLogService) {
+	*out = *in
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(apisv1.BackendRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetryAccessLogService.
+func (in *OpenTelemetryAccessLogService) DeepCopy() *OpenTelemetryAccessLogService {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenTelemetryAccessLogService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenTelemetryTracingProvider) DeepCopyInto(out *OpenTelemetryTracingProvider) {
+	*out = *in
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(apisv1.BackendRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetryTracingProvider.
+func (in *OpenTelemetryTracingProvider) DeepCopy() *OpenTelemetryTracingProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenTelemetryTracingProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
+	*out = *in
+	if in.Consecutive5xx != nil {
+		in, out := &in.Consecutive5xx, &out.Consecutive5xx
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConsecutiveGatewayErrors != nil {
+		in, out := &in.ConsecutiveGatewayErrors, &out.ConsecutiveGatewayErrors
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BaseEjectionTime != nil {
+		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalPolicyTargetReference.
-func (in *LocalPolicyTargetReference) DeepCopy() *LocalPolicyTargetReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetection.
+func (in *OutlierDetection) DeepCopy() *OutlierDetection {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalPolicyTargetReference)
+	out := new(OutlierDetection)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1038,12 +2324,12 @@ func (in *Pod) DeepCopyInto(out *Pod) {
 	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.PodSecurityContext)
+		*out = new(corev1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.NodeSelector != nil {
@@ -1055,12 +2341,12 @@ func (in *Pod) DeepCopyInto(out *Pod) {
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1077,12 +2363,12 @@ func (in *Pod) DeepCopyInto(out *Pod) {
 	}
 	if in.ReadinessProbe != nil {
 		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(v1.Probe)
+		*out = new(corev1.Probe)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(v1.Probe)
+		*out = new(corev1.Probe)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1097,13 +2383,38 @@ func (in *Pod) DeepCopy() *Pod {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudget) DeepCopyInto(out *PodDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudget.
+func (in *PodDisruptionBudget) DeepCopy() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyAncestorStatus) DeepCopyInto(out *PolicyAncestorStatus) {
 	*out = *in
 	in.AncestorRef.DeepCopyInto(&out.AncestorRef)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1125,7 +2436,7 @@ func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1169,6 +2480,194 @@ func (in *ProxyDeployment) DeepCopy() *ProxyDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParameterHashPolicy) DeepCopyInto(out *QueryParameterHashPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameterHashPolicy.
+func (in *QueryParameterHashPolicy) DeepCopy() *QueryParameterHashPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParameterHashPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitDescriptor) DeepCopyInto(out *RateLimitDescriptor) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]RateLimitDescriptorEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptor.
+func (in *RateLimitDescriptor) DeepCopy() *RateLimitDescriptor {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitDescriptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitDescriptorEntry) DeepCopyInto(out *RateLimitDescriptorEntry) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.Generic != nil {
+		in, out := &in.Generic, &out.Generic
+		*out = new(RateLimitDescriptorEntryGeneric)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptorEntry.
+func (in *RateLimitDescriptorEntry) DeepCopy() *RateLimitDescriptorEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitDescriptorEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitDescriptorEntryGeneric) DeepCopyInto(out *RateLimitDescriptorEntryGeneric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDescriptorEntryGeneric.
+func (in *RateLimitDescriptorEntryGeneric) DeepCopy() *RateLimitDescriptorEntryGeneric {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitDescriptorEntryGeneric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicy.
+func (in *RateLimitPolicy) DeepCopy() *RateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitPolicyList) DeepCopyInto(out *RateLimitPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RateLimitPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicyList.
+func (in *RateLimitPolicyList) DeepCopy() *RateLimitPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitPolicySpec) DeepCopyInto(out *RateLimitPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalRateLimitPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(GlobalRateLimitPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicySpec.
+func (in *RateLimitPolicySpec) DeepCopy() *RateLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteJWKS) DeepCopyInto(out *RemoteJWKS) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CacheDuration != nil {
+		in, out := &in.CacheDuration, &out.CacheDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteJWKS.
+func (in *RemoteJWKS) DeepCopy() *RemoteJWKS {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteJWKS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResponseFlagFilter) DeepCopyInto(out *ResponseFlagFilter) {
 	*out = *in
@@ -1189,12 +2688,118 @@ func (in *ResponseFlagFilter) DeepCopy() *ResponseFlagFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	out.BaseInterval = in.BaseInterval
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]RetryOnCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetriableStatusCodes != nil {
+		in, out := &in.RetriableStatusCodes, &out.RetriableStatusCodes
+		*out = make([]HTTPStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RingHashLoadBalancer) DeepCopyInto(out *RingHashLoadBalancer) {
+	*out = *in
+	if in.MinimumRingSize != nil {
+		in, out := &in.MinimumRingSize, &out.MinimumRingSize
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaximumRingSize != nil {
+		in, out := &in.MaximumRingSize, &out.MaximumRingSize
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RingHashLoadBalancer.
+func (in *RingHashLoadBalancer) DeepCopy() *RingHashLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(RingHashLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundRobinLoadBalancer) DeepCopyInto(out *RoundRobinLoadBalancer) {
+	*out = *in
+	if in.SlowStartWindow != nil {
+		in, out := &in.SlowStartWindow, &out.SlowStartWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundRobinLoadBalancer.
+func (in *RoundRobinLoadBalancer) DeepCopy() *RoundRobinLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundRobinLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoutePolicy) DeepCopyInto(out *RoutePolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1230,36 +2835,93 @@ func (in *RoutePolicyList) DeepCopyInto(out *RoutePolicyList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutePolicyList.
-func (in *RoutePolicyList) DeepCopy() *RoutePolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutePolicyList.
+func (in *RoutePolicyList) DeepCopy() *RoutePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoutePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutePolicySpec) DeepCopyInto(out *RoutePolicySpec) {
+	*out = *in
+	out.TargetRef = in.TargetRef
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(Timeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fault != nil {
+		in, out := &in.Fault, &out.Fault
+		*out = new(FaultInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HashPolicies != nil {
+		in, out := &in.HashPolicies, &out.HashPolicies
+		*out = make([]HashPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(RouteTracing)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutePolicySpec.
+func (in *RoutePolicySpec) DeepCopy() *RoutePolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutePolicyList)
+	out := new(RoutePolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RoutePolicyList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoutePolicySpec) DeepCopyInto(out *RoutePolicySpec) {
+func (in *RouteTracing) DeepCopyInto(out *RouteTracing) {
 	*out = *in
-	out.TargetRef = in.TargetRef
+	if in.ClientSampling != nil {
+		in, out := &in.ClientSampling, &out.ClientSampling
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RandomSampling != nil {
+		in, out := &in.RandomSampling, &out.RandomSampling
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.OverallSampling != nil {
+		in, out := &in.OverallSampling, &out.OverallSampling
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutePolicySpec.
-func (in *RoutePolicySpec) DeepCopy() *RoutePolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTracing.
+func (in *RouteTracing) DeepCopy() *RouteTracing {
 	if in == nil {
 		return nil
 	}
-	out := new(RoutePolicySpec)
+	out := new(RouteTracing)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1294,12 +2956,12 @@ func (in *SdsContainer) DeepCopyInto(out *SdsContainer) {
 	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.SecurityContext)
+		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Bootstrap != nil {
@@ -1322,6 +2984,16 @@ func (in *SdsContainer) DeepCopy() *SdsContainer {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelfManagedGateway) DeepCopyInto(out *SelfManagedGateway) {
 	*out = *in
+	if in.XdsServer != nil {
+		in, out := &in.XdsServer, &out.XdsServer
+		*out = new(Host)
+		**out = **in
+	}
+	if in.SdsServer != nil {
+		in, out := &in.SdsServer, &out.SdsServer
+		*out = new(Host)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfManagedGateway.
@@ -1339,7 +3011,7 @@ func (in *Service) DeepCopyInto(out *Service) {
 	*out = *in
 	if in.Type != nil {
 		in, out := &in.Type, &out.Type
-		*out = new(v1.ServiceType)
+		*out = new(corev1.ServiceType)
 		**out = **in
 	}
 	if in.ClusterIP != nil {
@@ -1402,6 +3074,21 @@ func (in *ServiceAccount) DeepCopy() *ServiceAccount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIPHashPolicy) DeepCopyInto(out *SourceIPHashPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIPHashPolicy.
+func (in *SourceIPHashPolicy) DeepCopy() *SourceIPHashPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIPHashPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticUpstream) DeepCopyInto(out *StaticUpstream) {
 	*out = *in
@@ -1472,6 +3159,149 @@ func (in *StatusCodeFilter) DeepCopy() *StatusCodeFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TcpHealthCheck) DeepCopyInto(out *TcpHealthCheck) {
+	*out = *in
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TcpHealthCheck.
+func (in *TcpHealthCheck) DeepCopy() *TcpHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(TcpHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TcpKeepalive) DeepCopyInto(out *TcpKeepalive) {
+	*out = *in
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TcpKeepalive.
+func (in *TcpKeepalive) DeepCopy() *TcpKeepalive {
+	if in == nil {
+		return nil
+	}
+	out := new(TcpKeepalive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Timeouts) DeepCopyInto(out *Timeouts) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Timeouts.
+func (in *Timeouts) DeepCopy() *Timeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(Timeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenBucket) DeepCopyInto(out *TokenBucket) {
+	*out = *in
+	if in.TokensPerFill != nil {
+		in, out := &in.TokensPerFill, &out.TokensPerFill
+		*out = new(uint32)
+		**out = **in
+	}
+	out.FillInterval = in.FillInterval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenBucket.
+func (in *TokenBucket) DeepCopy() *TokenBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tracing) DeepCopyInto(out *Tracing) {
+	*out = *in
+	if in.OpenTelemetry != nil {
+		in, out := &in.OpenTelemetry, &out.OpenTelemetry
+		*out = new(OpenTelemetryTracingProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zipkin != nil {
+		in, out := &in.Zipkin, &out.Zipkin
+		*out = new(ZipkinTracingProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientSampling != nil {
+		in, out := &in.ClientSampling, &out.ClientSampling
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RandomSampling != nil {
+		in, out := &in.RandomSampling, &out.RandomSampling
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.OverallSampling != nil {
+		in, out := &in.OverallSampling, &out.OverallSampling
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.CustomTags != nil {
+		in, out := &in.CustomTags, &out.CustomTags
+		*out = make([]CustomTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tracing.
+func (in *Tracing) DeepCopy() *Tracing {
+	if in == nil {
+		return nil
+	}
+	out := new(Tracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Upstream) DeepCopyInto(out *Upstream) {
 	*out = *in
@@ -1531,6 +3361,116 @@ func (in *UpstreamList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamPolicy) DeepCopyInto(out *UpstreamPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamPolicy.
+func (in *UpstreamPolicy) DeepCopy() *UpstreamPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpstreamPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamPolicyList) DeepCopyInto(out *UpstreamPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpstreamPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamPolicyList.
+func (in *UpstreamPolicyList) DeepCopy() *UpstreamPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpstreamPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamPolicySpec) DeepCopyInto(out *UpstreamPolicySpec) {
+	*out = *in
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.ConnectTimeout != nil {
+		in, out := &in.ConnectTimeout, &out.ConnectTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TcpKeepalive != nil {
+		in, out := &in.TcpKeepalive, &out.TcpKeepalive
+		*out = new(TcpKeepalive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreakers != nil {
+		in, out := &in.CircuitBreakers, &out.CircuitBreakers
+		*out = new(CircuitBreakers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Http2ProtocolOptions != nil {
+		in, out := &in.Http2ProtocolOptions, &out.Http2ProtocolOptions
+		*out = new(Http2ProtocolOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamPolicySpec.
+func (in *UpstreamPolicySpec) DeepCopy() *UpstreamPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpstreamSpec) DeepCopyInto(out *UpstreamSpec) {
 	*out = *in
@@ -1544,6 +3484,11 @@ func (in *UpstreamSpec) DeepCopyInto(out *UpstreamSpec) {
 		*out = new(StaticUpstream)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Dns != nil {
+		in, out := &in.Dns, &out.Dns
+		*out = new(DnsUpstream)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamSpec.
@@ -1561,7 +3506,7 @@ func (in *UpstreamStatus) DeepCopyInto(out *UpstreamStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1577,3 +3522,68 @@ func (in *UpstreamStatus) DeepCopy() *UpstreamStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamTls) DeepCopyInto(out *UpstreamTls) {
+	*out = *in
+	if in.CACertificateRefs != nil {
+		in, out := &in.CACertificateRefs, &out.CACertificateRefs
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubjectAltNames != nil {
+		in, out := &in.SubjectAltNames, &out.SubjectAltNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamTls.
+func (in *UpstreamTls) DeepCopy() *UpstreamTls {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamTls)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZipkinTracingProvider) DeepCopyInto(out *ZipkinTracingProvider) {
+	*out = *in
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(apisv1.BackendRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZipkinTracingProvider.
+func (in *ZipkinTracingProvider) DeepCopy() *ZipkinTracingProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ZipkinTracingProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZstdCompressor) DeepCopyInto(out *ZstdCompressor) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZstdCompressor.
+func (in *ZstdCompressor) DeepCopy() *ZstdCompressor {
+	if in == nil {
+		return nil
+	}
+	out := new(ZstdCompressor)
+	in.DeepCopyInto(out)
+	return out
+}
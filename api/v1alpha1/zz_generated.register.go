@@ -45,18 +45,28 @@ func init() {
 // Adds the list of known types to Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&CORSPolicy{},
+		&CORSPolicyList{},
 		&DirectResponse{},
 		&DirectResponseList{},
+		&ExtAuthPolicy{},
+		&ExtAuthPolicyList{},
 		&GatewayParameters{},
 		&GatewayParametersList{},
 		&HTTPListenerPolicy{},
 		&HTTPListenerPolicyList{},
+		&JWTPolicy{},
+		&JWTPolicyList{},
 		&ListenerPolicy{},
 		&ListenerPolicyList{},
+		&RateLimitPolicy{},
+		&RateLimitPolicyList{},
 		&RoutePolicy{},
 		&RoutePolicyList{},
 		&Upstream{},
 		&UpstreamList{},
+		&UpstreamPolicy{},
+		&UpstreamPolicyList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	v1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+)
+
+// ClientSnapshot is a connected client along with the snapshot it is served.
+type ClientSnapshot struct {
+	// Streams are the xds streams of the envoys that map to the client.
+	Streams  []krtcollections.ConnectedClientInfo `json:"streams"`
+	Snapshot envoycache.ResourceSnapshot          `json:"snapshot"`
+}
+
+// The clients handler lists the envoys connected to the xds server, the unique client each one maps to
+// and the state of their snapshots. The client query parameter drills down into the snapshot served
+// to a client.
+func addClientsHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, clients krtcollections.ConnectedClientsDebugger, cache envoycache.SnapshotCache) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		var response SnapshotResponseData
+		if client := r.URL.Query().Get("client"); client != "" {
+			response = getClientSnapshot(clients, cache, client)
+		} else {
+			response = completeSnapshotResponse(clients.ConnectedClients())
+		}
+		writeJSON(w, response, r)
+	})
+	profiles[path] = func() string {
+		return "Connected envoys and the state of their xDS snapshots. Use ?client=<resourceName> for the snapshot served to a client"
+	}
+}
+
+func getClientSnapshot(clients krtcollections.ConnectedClientsDebugger, xdsCache envoycache.SnapshotCache, client string) SnapshotResponseData {
+	var streams []krtcollections.ConnectedClientInfo
+	for _, c := range clients.ConnectedClients() {
+		if c.ResourceName == client {
+			streams = append(streams, c)
+		}
+	}
+	if len(streams) == 0 {
+		return SnapshotResponseData{Error: fmt.Errorf("client %s is not connected", client)}
+	}
+
+	snap, err := getXdsSnapshot(xdsCache, client)
+	if err != nil {
+		return SnapshotResponseData{Data: ClientSnapshot{Streams: streams}, Error: err}
+	}
+	return completeSnapshotResponse(ClientSnapshot{Streams: streams, Snapshot: snap})
+}
@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// HealthCheckedCluster is a cluster served to a proxy with active health checks.
+type HealthCheckedCluster struct {
+	Name         string            `json:"name"`
+	HealthChecks []json.RawMessage `json:"healthChecks"`
+	// Endpoints of the cluster, with the health status sent to the proxy in EDS.
+	Endpoints []HealthCheckedEndpoint `json:"endpoints"`
+}
+
+// HealthCheckedEndpoint is an endpoint of a HealthCheckedCluster.
+type HealthCheckedEndpoint struct {
+	Address string `json:"address"`
+	// EdsHealthStatus is the health status of the endpoint sent to the proxy in EDS, usually
+	// UNKNOWN. It is NOT the result of the active health checks, which is only known to the proxy
+	// and can be found in its admin `/clusters?format=json` endpoint.
+	EdsHealthStatus string `json:"edsHealthStatus"`
+}
+
+// The health checks handler returns, for each proxy, the clusters it actively health checks.
+func addHealthChecksHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, cache envoycache.SnapshotCache) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		response := getHealthChecksFromCache(cache)
+		writeJSON(w, response, r)
+	})
+	profiles[path] = func() string {
+		return "Actively health checked clusters and their endpoints; the results of the health checks are in the /clusters endpoint of the proxy admin"
+	}
+}
+
+func getHealthChecksFromCache(xdsCache envoycache.SnapshotCache) SnapshotResponseData {
+	cacheKeys := xdsCache.GetStatusKeys()
+	cacheEntries := make(map[string]interface{}, len(cacheKeys))
+
+	for _, k := range cacheKeys {
+		xdsSnapshot, err := getXdsSnapshot(xdsCache, k)
+		if err != nil {
+			cacheEntries[k] = err.Error()
+		} else {
+			cacheEntries[k] = healthCheckedClusters(xdsSnapshot)
+		}
+	}
+
+	return completeSnapshotResponse(cacheEntries)
+}
+
+func healthCheckedClusters(snap envoycache.ResourceSnapshot) []HealthCheckedCluster {
+	endpoints := snap.GetResources(resource.EndpointType)
+
+	var out []HealthCheckedCluster
+	for name, res := range snap.GetResources(resource.ClusterType) {
+		cluster, ok := res.(*envoy_config_cluster_v3.Cluster)
+		if !ok || len(cluster.GetHealthChecks()) == 0 {
+			continue
+		}
+
+		hcc := HealthCheckedCluster{Name: name}
+		for _, hc := range cluster.GetHealthChecks() {
+			b, err := protojson.Marshal(hc)
+			if err != nil {
+				continue
+			}
+			hcc.HealthChecks = append(hcc.HealthChecks, b)
+		}
+
+		// eds clusters have their endpoints in the endpoints of the snapshot
+		loadAssignment := cluster.GetLoadAssignment()
+		if eds, ok := endpoints[name].(*envoy_config_endpoint_v3.ClusterLoadAssignment); ok {
+			loadAssignment = eds
+		}
+		for _, locality := range loadAssignment.GetEndpoints() {
+			for _, lbEndpoint := range locality.GetLbEndpoints() {
+				addr := lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress()
+				hcc.Endpoints = append(hcc.Endpoints, HealthCheckedEndpoint{
+					Address:         net.JoinHostPort(addr.GetAddress(), strconv.FormatUint(uint64(addr.GetPortValue()), 10)),
+					EdsHealthStatus: lbEndpoint.GetHealthStatus().String(),
+				})
+			}
+		}
+		out = append(out, hcc)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
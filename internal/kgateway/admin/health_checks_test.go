@@ -0,0 +1,59 @@
+package admin
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+)
+
+var _ = Describe("healthCheckedClusters", func() {
+	It("lists the health checked clusters with their endpoints", func() {
+		healthCheck := &envoy_config_core_v3.HealthCheck{
+			HealthChecker: &envoy_config_core_v3.HealthCheck_TcpHealthCheck_{
+				TcpHealthCheck: &envoy_config_core_v3.HealthCheck_TcpHealthCheck{},
+			},
+		}
+		edsCluster := &envoy_config_cluster_v3.Cluster{
+			Name:         "kube_default_svc_80",
+			HealthChecks: []*envoy_config_core_v3.HealthCheck{healthCheck},
+		}
+		dnsCluster := &envoy_config_cluster_v3.Cluster{
+			Name:         "upstream_default_dns_0",
+			HealthChecks: []*envoy_config_core_v3.HealthCheck{healthCheck},
+		}
+		pluginutils.EnvoySingleEndpointLoadAssignment(dnsCluster, "example.com", 443)
+		plainCluster := &envoy_config_cluster_v3.Cluster{Name: "kube_default_other_80"}
+		endpoints := &envoy_config_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: "kube_default_svc_80",
+			Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{{
+					HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+						Endpoint: pluginutils.EnvoyEndpoint("10.0.0.1", 8080),
+					},
+					HealthStatus: envoy_config_core_v3.HealthStatus_HEALTHY,
+				}},
+			}},
+		}
+
+		snap, err := envoycache.NewSnapshot("1", map[resource.Type][]types.Resource{
+			resource.ClusterType:  {edsCluster, dnsCluster, plainCluster},
+			resource.EndpointType: {endpoints},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		clusters := healthCheckedClusters(snap)
+		Expect(clusters).To(HaveLen(2))
+		Expect(clusters[0].Name).To(Equal("kube_default_svc_80"))
+		Expect(clusters[0].HealthChecks).To(HaveLen(1))
+		Expect(clusters[0].Endpoints).To(ConsistOf(HealthCheckedEndpoint{Address: "10.0.0.1:8080", EdsHealthStatus: "HEALTHY"}))
+		Expect(clusters[1].Name).To(Equal("upstream_default_dns_0"))
+		Expect(clusters[1].Endpoints).To(ConsistOf(HealthCheckedEndpoint{Address: "example.com:443", EdsHealthStatus: "UNKNOWN"}))
+	})
+})
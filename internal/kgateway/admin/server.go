@@ -14,6 +14,7 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/controller"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 )
 
 const (
@@ -22,7 +23,7 @@ const (
 
 func RunAdminServer(ctx context.Context, setupOpts *controller.SetupOpts) error {
 	// serverHandlers defines the custom handlers that the Admin Server will support
-	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache)
+	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache, setupOpts.ConnectedClients)
 
 	// initialize the atomic log level
 	if envLogLevel := os.Getenv(contextutils.LogLevelEnvName); envLogLevel != "" {
@@ -40,10 +41,16 @@ type dynamicProfileDescription func() string
 
 // getServerHandlers returns the custom handlers for the Admin Server, which will be bound to the http.ServeMux
 // These endpoints serve as the basis for an Admin Interface for the Control Plane (https://github.com/kgateway-dev/kgateway/issues/6494)
-func getServerHandlers(_ context.Context, dbg *krt.DebugHandler, cache envoycache.SnapshotCache) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
+func getServerHandlers(_ context.Context, dbg *krt.DebugHandler, cache envoycache.SnapshotCache, clients krtcollections.ConnectedClientsDebugger) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 	return func(m *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 		addXdsSnapshotHandler("/snapshots/xds", m, profiles, cache)
 
+		if clients != nil {
+			addClientsHandler("/clients", m, profiles, clients, cache)
+		}
+
+		addHealthChecksHandler("/snapshots/healthchecks", m, profiles, cache)
+
 		addKrtSnapshotHandler("/snapshots/krt", m, profiles, dbg)
 
 		addLoggingHandler("/logging", m, profiles)
@@ -0,0 +1,25 @@
+// Package v1alpha1 contains the experimental XListenerSet API of the Gateway API
+// (gateway.networking.x-k8s.io/v1alpha1).
+//
+// The types mirror sigs.k8s.io/gateway-api/apisx/v1alpha1, which is only available from
+// gateway-api v1.3. Replace this package by the upstream one when the dependency is bumped.
+//
+// The API is experimental: XListenerSets are ignored unless KGW_ENABLEEXPERIMENTALLISTENERSETS is true.
+//
+// +kubebuilder:object:generate=true
+// +groupName=gateway.networking.x-k8s.io
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const GroupName = "gateway.networking.x-k8s.io"
+
+var (
+	// GroupVersion is group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+	// SchemeGroupVersion is alias to GroupVersion for client-go libraries.
+	SchemeGroupVersion = GroupVersion
+)
@@ -0,0 +1,147 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// XListenerSet defines a set of additional listeners to attach to an existing Gateway.
+//
+// +kubebuilder:object:root=true
+type XListenerSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of ListenerSet.
+	Spec ListenerSetSpec `json:"spec"`
+
+	// Status defines the current state of ListenerSet.
+	Status ListenerSetStatus `json:"status,omitempty"`
+}
+
+// ListenerSetSpec defines the desired state of a ListenerSet.
+type ListenerSetSpec struct {
+	// ParentRef references the Gateway that the listeners are attached to.
+	ParentRef ParentGatewayReference `json:"parentRef"`
+
+	// Listeners associated with this ListenerSet. Listeners define
+	// logical endpoints that are bound on this referenced parent Gateway's addresses.
+	Listeners []ListenerEntry `json:"listeners"`
+}
+
+// ListenerEntry embodies the concept of a logical endpoint where a Gateway accepts
+// network connections. It has the same fields as a Listener of a Gateway.
+type ListenerEntry struct {
+	// Name is the name of the Listener. This name MUST be unique within a ListenerSet.
+	Name gwv1.SectionName `json:"name"`
+
+	// Hostname specifies the virtual hostname to match for protocol types that
+	// define this concept.
+	Hostname *gwv1.Hostname `json:"hostname,omitempty"`
+
+	// Port is the network port.
+	Port gwv1.PortNumber `json:"port"`
+
+	// Protocol specifies the network protocol this listener expects to receive.
+	Protocol gwv1.ProtocolType `json:"protocol"`
+
+	// TLS is the TLS configuration for the Listener.
+	TLS *gwv1.GatewayTLSConfig `json:"tls,omitempty"`
+
+	// AllowedRoutes defines the types of routes that MAY be attached to a
+	// Listener and the trusted namespaces where those Route resources MAY be
+	// present.
+	AllowedRoutes *gwv1.AllowedRoutes `json:"allowedRoutes,omitempty"`
+}
+
+// ListenerSetStatus defines the observed state of a ListenerSet.
+type ListenerSetStatus struct {
+	// Conditions describe the current conditions of the ListenerSet.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Listeners provide status for each unique listener port defined in the Spec.
+	Listeners []ListenerEntryStatus `json:"listeners,omitempty"`
+}
+
+// ListenerEntryStatus is the status associated with a ListenerEntry.
+type ListenerEntryStatus struct {
+	// Name is the name of the Listener that this status corresponds to.
+	Name gwv1.SectionName `json:"name"`
+
+	// Port is the network port the listener is configured to listen on.
+	Port gwv1.PortNumber `json:"port"`
+
+	// SupportedKinds is the list indicating the Kinds supported by this
+	// listener.
+	SupportedKinds []gwv1.RouteGroupKind `json:"supportedKinds"`
+
+	// AttachedRoutes represents the total number of Routes that have been
+	// successfully attached to this Listener.
+	AttachedRoutes int32 `json:"attachedRoutes"`
+
+	// Conditions describe the current condition of this listener.
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// ParentGatewayReference identifies an API object including its namespace,
+// defaulting to Gateway.
+type ParentGatewayReference struct {
+	// Group is the group of the referent.
+	Group *gwv1.Group `json:"group"`
+
+	// Kind is kind of the referent. For example "Gateway".
+	Kind *gwv1.Kind `json:"kind"`
+
+	// Name is the name of the referent.
+	Name gwv1.ObjectName `json:"name"`
+
+	// Namespace is the namespace of the referent. If not present,
+	// the namespace of the referent is assumed to be the same as
+	// the namespace of the referring object.
+	Namespace *gwv1.Namespace `json:"namespace,omitempty"`
+}
+
+// ListenerSetConditionType is a type of condition for a ListenerSet.
+type ListenerSetConditionType string
+
+// ListenerSetConditionReason defines the set of reasons that explain why a
+// particular ListenerSet condition type has been raised.
+type ListenerSetConditionReason string
+
+const (
+	// This condition indicates whether a ListenerSet has generated some
+	// configuration that is assumed to be ready soon in the underlying data
+	// plane.
+	ListenerSetConditionProgrammed ListenerSetConditionType = "Programmed"
+
+	// This reason is used with the "Programmed" condition when the condition is
+	// true.
+	ListenerSetReasonProgrammed ListenerSetConditionReason = "Programmed"
+
+	// This reason is used with the "Programmed" condition when the ListenerSet is
+	// syntactically or semantically invalid.
+	ListenerSetReasonInvalid ListenerSetConditionReason = "Invalid"
+)
+
+const (
+	// This condition is true when the controller managing the ListenerSet is
+	// syntactically and semantically valid enough to produce some configuration
+	// in the underlying data plane.
+	ListenerSetConditionAccepted ListenerSetConditionType = "Accepted"
+
+	// This reason is used with the "Accepted" condition when the condition is
+	// True.
+	ListenerSetReasonAccepted ListenerSetConditionReason = "Accepted"
+
+	// This reason is used with the "Accepted" condition to indicate that the
+	// ListenerSet is not allowed to be attached to the Gateway.
+	ListenerSetReasonNotAllowed ListenerSetConditionReason = "NotAllowed"
+
+	// This reason is used with the "Accepted" condition to indicate that the
+	// parent Gateway of the ListenerSet is not accepted.
+	ListenerSetReasonParentNotAccepted ListenerSetConditionReason = "ParentNotAccepted"
+
+	// This reason is used with the "Accepted" condition to indicate that none
+	// of the listeners of the ListenerSet are valid.
+	ListenerSetReasonListenersNotValid ListenerSetConditionReason = "ListenersNotValid"
+)
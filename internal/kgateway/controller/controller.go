@@ -21,7 +21,6 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/deployer"
-	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 const (
@@ -87,8 +86,8 @@ func gatewayToParams(obj client.Object) []string {
 	if !ok {
 		panic(fmt.Sprintf("wrong type %T provided to indexer. expected Gateway", obj))
 	}
-	gwpName := gw.GetAnnotations()[wellknown.GatewayParametersAnnotationName]
-	if gwpName != "" {
+	gwpName, err := deployer.GetGatewayParametersName(gw)
+	if err == nil && gwpName != "" {
 		return []string{gwpName}
 	}
 	return []string{}
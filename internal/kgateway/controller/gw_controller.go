@@ -77,6 +77,10 @@ func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if err != nil {
 		return result, err
 	}
+	err = r.deployer.DeleteDisabledObjs(ctx, &gw, objs)
+	if err != nil {
+		return result, err
+	}
 
 	return result, nil
 }
@@ -143,6 +147,12 @@ func getDesiredAddresses(svc *corev1.Service) []api.GatewayStatusAddress {
 
 	var ret []api.GatewayStatusAddress
 	t := api.IPAddressType
+	for _, ip := range svc.Spec.ExternalIPs {
+		ret = append(ret, api.GatewayStatusAddress{
+			Type:  &t,
+			Value: ip,
+		})
+	}
 	if len(svc.Spec.ClusterIPs) != 0 {
 		for _, ip := range svc.Spec.ClusterIPs {
 			ret = append(ret, api.GatewayStatusAddress{
@@ -4,12 +4,15 @@ import (
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	sologatewayv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -21,12 +24,15 @@ var SchemeBuilder = runtime.SchemeBuilder{
 	// K8s Gateway API resources
 	gwv1.Install,
 	gwv1a2.Install,
+	gwv1a3.Install,
 	gwv1b1.Install,
 
 	// Kubernetes Core resources
 	corev1.AddToScheme,
 	appsv1.AddToScheme,
+	autoscalingv2.AddToScheme,
 	discoveryv1.AddToScheme,
+	policyv1.AddToScheme,
 
 	// Register the apiextensions API group
 	apiextensionsv1.AddToScheme,
@@ -50,6 +50,8 @@ type SetupOpts struct {
 	ExtraGatewayClasses []string
 
 	KrtDebugger *krt.DebugHandler
+	// ConnectedClients lists the envoys connected to the xds server, for the admin server.
+	ConnectedClients krtcollections.ConnectedClientsDebugger
 
 	XdsHost string
 	XdsPort int32
@@ -16,7 +16,11 @@ import (
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -30,7 +34,17 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/helm"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/internal/version"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/envoyutils/bootstrap"
+)
+
+const (
+	// SelfManagedBootstrapKey is the key of the envoy bootstrap in the ConfigMap of a self-managed Gateway
+	SelfManagedBootstrapKey = "envoy.yaml"
+
+	// label of the Gateway the proxy is generated for, as set by the helm chart on deployed proxies
+	selfManagedGatewayNameLabel = "gateway.networking.k8s.io/gateway-name"
 )
 
 var (
@@ -40,7 +54,8 @@ var (
 		return eris.Wrapf(wrapped, "(%s.%s) for %s (%s.%s)",
 			gwpNamespace, gwpName, resourceType, gwNamespace, gwName)
 	}
-	NilDeployerInputsErr = eris.New("nil inputs to NewDeployer")
+	NilDeployerInputsErr          = eris.New("nil inputs to NewDeployer")
+	UnsupportedParametersRefError = eris.New("unsupported parametersRef")
 )
 
 // A Deployer is responsible for deploying proxies
@@ -102,8 +117,7 @@ func (d *Deployer) GetGvksToWatch(ctx context.Context) ([]schema.GroupVersionKin
 	// In order to get the GVKs for the resources to watch, we need:
 	// - a placeholder Gateway (only the name and namespace are used, but the actual values don't matter,
 	//   as we only care about the GVKs of the rendered resources)
-	// - the minimal values that render all the proxy resources (HPA is not included because it's not
-	//   fully integrated/working at the moment)
+	// - the minimal values that render all the proxy resources
 	//
 	// Note: another option is to hardcode the GVKs here, but rendering the helm chart is a
 	// _slightly_ more dynamic way of getting the GVKs. It isn't a perfect solution since if
@@ -125,6 +139,12 @@ func (d *Deployer) GetGvksToWatch(ctx context.Context) ([]schema.GroupVersionKin
 				"enabled": false,
 			},
 			"image": map[string]any{},
+			"autoscaling": map[string]any{
+				"enabled": true,
+			},
+			"podDisruptionBudget": map[string]any{
+				"enabled": true,
+			},
 		},
 	}
 
@@ -170,8 +190,10 @@ func (d *Deployer) renderChartToObjects(gw *api.Gateway, vals map[string]any) ([
 func (d *Deployer) getGatewayParametersForGateway(ctx context.Context, gw *api.Gateway) (*v1alpha1.GatewayParameters, error) {
 	logger := log.FromContext(ctx)
 
-	// check for a gateway params annotation on the Gateway
-	gwpName := gw.GetAnnotations()[wellknown.GatewayParametersAnnotationName]
+	gwpName, err := GetGatewayParametersName(gw)
+	if err != nil {
+		return nil, err
+	}
 	if gwpName == "" {
 		// there is no custom GatewayParameters; use GatewayParameters attached to GatewayClass
 		logger.V(1).Info("no GatewayParameters found for Gateway",
@@ -183,7 +205,7 @@ func (d *Deployer) getGatewayParametersForGateway(ctx context.Context, gw *api.G
 	// the GatewayParameters must live in the same namespace as the Gateway
 	gwpNamespace := gw.GetNamespace()
 	gwp := &v1alpha1.GatewayParameters{}
-	err := d.cli.Get(ctx, client.ObjectKey{Namespace: gwpNamespace, Name: gwpName}, gwp)
+	err = d.cli.Get(ctx, client.ObjectKey{Namespace: gwpNamespace, Name: gwpName}, gwp)
 	if err != nil {
 		return nil, getGatewayParametersError(err, gwpNamespace, gwpName, gw.GetNamespace(), gw.GetName(), "Gateway")
 	}
@@ -198,6 +220,20 @@ func (d *Deployer) getGatewayParametersForGateway(ctx context.Context, gw *api.G
 	return mergedGwp, nil
 }
 
+// GetGatewayParametersName returns the name of the GatewayParameters specific to the Gateway, if any.
+// It is referenced by spec.infrastructure.parametersRef, or the gateway params annotation.
+func GetGatewayParametersName(gw *api.Gateway) (string, error) {
+	if gw.Spec.Infrastructure != nil && gw.Spec.Infrastructure.ParametersRef != nil {
+		ref := gw.Spec.Infrastructure.ParametersRef
+		if string(ref.Group) != v1alpha1.GroupName || string(ref.Kind) != v1alpha1.GatewayParametersKind {
+			return "", eris.Wrapf(UnsupportedParametersRefError, "%s/%s for Gateway (%s.%s)",
+				ref.Group, ref.Kind, gw.GetNamespace(), gw.GetName())
+		}
+		return ref.Name, nil
+	}
+	return gw.GetAnnotations()[wellknown.GatewayParametersAnnotationName], nil
+}
+
 // gets the default GatewayParameters associated with the GatewayClass of the provided Gateway
 func (d *Deployer) getDefaultGatewayParameters(ctx context.Context, gw *api.Gateway) (*v1alpha1.GatewayParameters, error) {
 	gwc, err := d.getGatewayClassFromGateway(ctx, gw)
@@ -305,18 +341,12 @@ func (d *Deployer) getValues(gw *api.Gateway, gwParam *v1alpha1.GatewayParameter
 	// deployment values
 	gateway.ReplicaCount = deployConfig.GetReplicas()
 
-	// TODO: The follow stanza has been commented out as autoscaling support has been removed.
-	// see https://github.com/solo-io/solo-projects/issues/5948 for more info.
-	//
-	// autoscalingVals := getAutoscalingValues(kubeProxyConfig.GetAutoscaling())
-	// vals.Gateway.Autoscaling = autoscalingVals
-	// if autoscalingVals == nil && deployConfig.GetReplicas() != nil {
-	// 	replicas := deployConfig.GetReplicas().GetValue()
-	// 	vals.Gateway.ReplicaCount = &replicas
-	// }
+	gateway.Autoscaling = getAutoscalingValues(kubeProxyConfig.GetHorizontalPodAutoscaler())
+	gateway.PodDisruptionBudget = getPodDisruptionBudgetValues(kubeProxyConfig.GetPodDisruptionBudget())
 
 	// service values
 	gateway.Service = getServiceValues(svcConfig)
+	applyAddressValues(gateway.Service, gw.Spec.Addresses)
 	// serviceaccount values
 	gateway.ServiceAccount = getServiceAccountValues(svcAccountConfig)
 	// pod template values
@@ -400,7 +430,8 @@ func (d *Deployer) Render(name, ns string, vals map[string]any) ([]client.Object
 //
 // * performs GatewayParameters lookup/merging etc to get a final set of helm values
 //
-// * use those helm values to render the internal `gloo-gateway` helm chart into k8s objects
+// * use those helm values to render the internal `gloo-gateway` helm chart into k8s objects,
+// or for a self-managed Gateway, generate the ConfigMap with the bootstrap of its proxy
 //
 // * sets ownerRefs on all generated objects
 //
@@ -410,11 +441,86 @@ func (d *Deployer) GetObjsToDeploy(ctx context.Context, gw *api.Gateway) ([]clie
 	if err != nil {
 		return nil, err
 	}
-	// If this is a self-managed Gateway, skip gateway auto provisioning
+	// If this is a self-managed Gateway, skip gateway auto provisioning and only provide the bootstrap of the proxy
+	var objs []client.Object
 	if gwParam != nil && gwParam.Spec.SelfManaged != nil {
-		return nil, nil
+		objs, err = d.getSelfManagedObjs(gw, gwParam.Spec.SelfManaged)
+	} else {
+		objs, err = d.getDeployedObjs(ctx, gw, gwParam)
+	}
+	if err != nil {
+		return nil, err
 	}
 
+	// Set owner ref
+	for _, obj := range objs {
+		obj.SetOwnerReferences([]metav1.OwnerReference{{
+			Kind:       gw.Kind,
+			APIVersion: gw.APIVersion,
+			Controller: ptr.To(true),
+			UID:        gw.UID,
+			Name:       gw.Name,
+		}})
+	}
+
+	applyInfrastructureMetadata(objs, gw.Spec.Infrastructure)
+
+	return objs, nil
+}
+
+// SelfManagedBootstrapName returns the name of the ConfigMap containing the envoy bootstrap of a self-managed Gateway.
+func SelfManagedBootstrapName(gwName string) string {
+	return gwName + "-bootstrap"
+}
+
+// getSelfManagedObjs returns the ConfigMap containing the envoy bootstrap of a self-managed proxy,
+// connecting it to the control plane as the given Gateway.
+func (d *Deployer) getSelfManagedObjs(gw *api.Gateway, selfManaged *v1alpha1.SelfManagedGateway) ([]client.Object, error) {
+	opts := bootstrap.XdsOptions{
+		Cluster: fmt.Sprintf("%s.%s", gw.GetName(), gw.GetNamespace()),
+		Role:    xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, gw.GetNamespace(), gw.GetName()),
+		XdsHost: d.inputs.ControlPlane.XdsHost,
+		XdsPort: uint32(d.inputs.ControlPlane.XdsPort),
+		// the proxy runs wherever the user deploys it, possibly outside of the cluster, so it has
+		// no pod, and neither the in-cluster address of the control plane nor the sds sidecar of
+		// the istio integration may be reachable from it
+		SelfManaged: true,
+	}
+	if server := selfManaged.XdsServer; server != nil {
+		opts.XdsHost = server.Host
+		opts.XdsPort = uint32(server.Port)
+	}
+	if server := selfManaged.SdsServer; server != nil {
+		opts.SdsClusterName = wellknown.SdsClusterName
+		opts.SdsHost = server.Host
+		opts.SdsPort = uint32(server.Port)
+	}
+	envoyBootstrap, err := bootstrap.FromXds(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate bootstrap for self-managed gateway %s.%s: %w", gw.GetNamespace(), gw.GetName(), err)
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SelfManagedBootstrapName(gw.GetName()),
+			Namespace: gw.GetNamespace(),
+			Labels: map[string]string{
+				selfManagedGatewayNameLabel: gw.GetName(),
+			},
+		},
+		Data: map[string]string{
+			SelfManagedBootstrapKey: envoyBootstrap,
+		},
+	}
+	return []client.Object{cm}, nil
+}
+
+// getDeployedObjs renders the helm chart into the objects deploying the proxy of the Gateway.
+func (d *Deployer) getDeployedObjs(ctx context.Context, gw *api.Gateway, gwParam *v1alpha1.GatewayParameters) ([]client.Object, error) {
 	logger := log.FromContext(ctx)
 
 	vals, err := d.getValues(gw, gwParam)
@@ -436,19 +542,38 @@ func (d *Deployer) GetObjsToDeploy(ctx context.Context, gw *api.Gateway) ([]clie
 	if err != nil {
 		return nil, fmt.Errorf("failed to get objects to deploy for gateway %s.%s: %w", gw.GetNamespace(), gw.GetName(), err)
 	}
+	return objs, nil
+}
 
-	// Set owner ref
-	for _, obj := range objs {
-		obj.SetOwnerReferences([]metav1.OwnerReference{{
-			Kind:       gw.Kind,
-			APIVersion: gw.APIVersion,
-			Controller: ptr.To(true),
-			UID:        gw.UID,
-			Name:       gw.Name,
-		}})
+// applyInfrastructureMetadata adds the labels and annotations of the Gateway infrastructure to all
+// the objects. Labels and annotations set by the chart take precedence.
+func applyInfrastructureMetadata(objs []client.Object, infra *api.GatewayInfrastructure) {
+	if infra == nil {
+		return
 	}
+	for _, obj := range objs {
+		labels := obj.GetLabels()
+		if labels == nil && len(infra.Labels) > 0 {
+			labels = make(map[string]string, len(infra.Labels))
+		}
+		for k, v := range infra.Labels {
+			if _, ok := labels[string(k)]; !ok {
+				labels[string(k)] = string(v)
+			}
+		}
+		obj.SetLabels(labels)
 
-	return objs, nil
+		annotations := obj.GetAnnotations()
+		if annotations == nil && len(infra.Annotations) > 0 {
+			annotations = make(map[string]string, len(infra.Annotations))
+		}
+		for k, v := range infra.Annotations {
+			if _, ok := annotations[string(k)]; !ok {
+				annotations[string(k)] = string(v)
+			}
+		}
+		obj.SetAnnotations(annotations)
+	}
 }
 
 func (d *Deployer) DeployObjs(ctx context.Context, objs []client.Object) error {
@@ -462,6 +587,54 @@ func (d *Deployer) DeployObjs(ctx context.Context, objs []client.Object) error {
 	return nil
 }
 
+// DeleteDisabledObjs deletes the HorizontalPodAutoscaler and PodDisruptionBudget of the Gateway
+// when they aren't among the objects to deploy anymore. Server-side apply only creates and updates
+// objects, so disabling them in the GatewayParameters would otherwise leave them behind.
+func (d *Deployer) DeleteDisabledObjs(ctx context.Context, gw *api.Gateway, objs []client.Object) error {
+	// the chart names the optional objects after the Deployment of the proxy
+	var key *client.ObjectKey
+	var hpaRendered, pdbRendered bool
+	for _, obj := range objs {
+		switch obj.(type) {
+		case *appsv1.Deployment:
+			key = ptr.To(client.ObjectKeyFromObject(obj))
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			hpaRendered = true
+		case *policyv1.PodDisruptionBudget:
+			pdbRendered = true
+		}
+	}
+	if key == nil {
+		return nil
+	}
+	var disabled []client.Object
+	if !hpaRendered {
+		disabled = append(disabled, &autoscalingv2.HorizontalPodAutoscaler{})
+	}
+	if !pdbRendered {
+		disabled = append(disabled, &policyv1.PodDisruptionBudget{})
+	}
+
+	logger := log.FromContext(ctx)
+	for _, existing := range disabled {
+		if err := d.cli.Get(ctx, *key, existing); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get %T %s: %w", existing, key, err)
+		}
+		// only delete the objects deployed for this Gateway
+		if !metav1.IsControlledBy(existing, gw) {
+			continue
+		}
+		logger.V(1).Info("deleting disabled object", "kind", fmt.Sprintf("%T", existing), "namespace", key.Namespace, "name", key.Name)
+		if err := d.cli.Delete(ctx, existing); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete %T %s: %w", existing, key, err)
+		}
+	}
+	return nil
+}
+
 func loadFs(filesystem fs.FS) (*chart.Chart, error) {
 	var bufferedFiles []*loader.BufferedFile
 	entries, err := fs.ReadDir(filesystem, ".")
@@ -14,7 +14,10 @@ import (
 	jsonpb "google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -74,6 +77,28 @@ func (objs *clientObjects) findDeployment(namespace, name string) *appsv1.Deploy
 	return nil
 }
 
+func (objs *clientObjects) findHorizontalPodAutoscaler(namespace, name string) *autoscalingv2.HorizontalPodAutoscaler {
+	for _, obj := range *objs {
+		if hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			if hpa.Name == name && hpa.Namespace == namespace {
+				return hpa
+			}
+		}
+	}
+	return nil
+}
+
+func (objs *clientObjects) findPodDisruptionBudget(namespace, name string) *policyv1.PodDisruptionBudget {
+	for _, obj := range *objs {
+		if pdb, ok := obj.(*policyv1.PodDisruptionBudget); ok {
+			if pdb.Name == name && pdb.Namespace == namespace {
+				return pdb
+			}
+		}
+	}
+	return nil
+}
+
 func (objs *clientObjects) findServiceAccount(namespace, name string) *corev1.ServiceAccount {
 	for _, obj := range *objs {
 		if sa, ok := obj.(*corev1.ServiceAccount); ok {
@@ -442,12 +467,14 @@ var _ = Describe("Deployer", func() {
 		It("should get gvks", func() {
 			gvks, err := d.GetGvksToWatch(context.Background())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(gvks).To(HaveLen(4))
+			Expect(gvks).To(HaveLen(6))
 			Expect(gvks).To(ConsistOf(
 				wellknown.DeploymentGVK,
 				wellknown.ServiceGVK,
 				wellknown.ServiceAccountGVK,
 				wellknown.ConfigMapGVK,
+				autoscalingv2.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler"),
+				policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget"),
 			))
 		})
 
@@ -524,6 +551,66 @@ var _ = Describe("Deployer", func() {
 				Expect(obj.GetName()).To(Equal(gw2.Name))
 			}
 		})
+
+		It("deletes the HorizontalPodAutoscaler and PodDisruptionBudget once they are disabled", func() {
+			ctx := context.Background()
+			gwp := &gw2_v1alpha1.GatewayParameters{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       gw2_v1alpha1.GatewayParametersKind,
+					APIVersion: gw2_v1alpha1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "autoscaled",
+					Namespace: defaultNamespace,
+				},
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+							MaxReplicas: ptr.To[uint32](10),
+						},
+						PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{},
+					},
+				},
+			}
+			cli := newFakeClientWithObjs(gwc, defaultGatewayParams(), gwp)
+			d, err := deployer.NewDeployer(cli, &deployer.Inputs{
+				ControllerName: wellknown.GatewayControllerName,
+				ControlPlane: deployer.ControlPlaneInfo{
+					XdsHost: "something.cluster.local", XdsPort: 1234,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			gw := defaultGateway()
+			gw.Annotations = map[string]string{
+				wellknown.GatewayParametersAnnotationName: gwp.Name,
+			}
+			var objs clientObjects
+			objs, err = d.GetObjsToDeploy(ctx, gw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objs.findHorizontalPodAutoscaler(defaultNamespace, gw.Name)).ToNot(BeNil())
+			Expect(objs.findPodDisruptionBudget(defaultNamespace, gw.Name)).ToNot(BeNil())
+			// the fake client doesn't support server-side apply
+			for _, obj := range objs {
+				Expect(cli.Create(ctx, obj)).To(Succeed())
+			}
+
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(gwp), gwp)).To(Succeed())
+			gwp.Spec.Kube.HorizontalPodAutoscaler = nil
+			gwp.Spec.Kube.PodDisruptionBudget = nil
+			Expect(cli.Update(ctx, gwp)).To(Succeed())
+
+			objs, err = d.GetObjsToDeploy(ctx, gw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objs.findHorizontalPodAutoscaler(defaultNamespace, gw.Name)).To(BeNil())
+			Expect(objs.findPodDisruptionBudget(defaultNamespace, gw.Name)).To(BeNil())
+			Expect(d.DeleteDisabledObjs(ctx, gw, objs)).To(Succeed())
+
+			key := client.ObjectKey{Namespace: defaultNamespace, Name: gw.Name}
+			Expect(apierrors.IsNotFound(cli.Get(ctx, key, &autoscalingv2.HorizontalPodAutoscaler{}))).To(BeTrue())
+			Expect(apierrors.IsNotFound(cli.Get(ctx, key, &policyv1.PodDisruptionBudget{}))).To(BeTrue())
+			Expect(cli.Get(ctx, key, &appsv1.Deployment{})).To(Succeed())
+		})
 	})
 
 	Context("Single gwc and gw", func() {
@@ -800,6 +887,18 @@ var _ = Describe("Deployer", func() {
 
 				return gw
 			}
+			defaultGatewayWithInfrastructureParams = func(gwpName string) *api.Gateway {
+				gw := defaultGateway()
+				gw.Spec.Infrastructure = &api.GatewayInfrastructure{
+					ParametersRef: &api.LocalParametersReference{
+						Group: gw2_v1alpha1.GroupName,
+						Kind:  gw2_v1alpha1.GatewayParametersKind,
+						Name:  gwpName,
+					},
+				}
+
+				return gw
+			}
 			defaultInput = func() *input {
 				return &input{
 					dInputs:    defaultDeployerInputs(),
@@ -1124,6 +1223,30 @@ var _ = Describe("Deployer", func() {
 			return generalAiAndSdsValidationFunc(objs, inp, true) // true: don't expect null runAsUser
 		}
 
+		selfManagedValidationFunc := func(objs clientObjects, inp *input) error {
+			// only the bootstrap of the proxy is generated
+			Expect(objs).To(HaveLen(1))
+			Expect(objs.findDeployment(defaultNamespace, inp.gw.Name)).To(BeNil())
+
+			cm := objs.findConfigMap(defaultNamespace, deployer.SelfManagedBootstrapName(inp.gw.Name))
+			Expect(cm).ToNot(BeNil())
+			Expect(cm.GetOwnerReferences()).To(HaveLen(1))
+			Expect(cm.GetOwnerReferences()[0].UID).To(Equal(inp.gw.UID))
+
+			bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, cm.Name)
+			Expect(bootstrapCfg.GetNode().GetMetadata().GetFields()["role"].GetStringValue()).To(Equal(
+				fmt.Sprintf("%s~%s~%s", wellknown.GatewayApiProxyValue, inp.gw.Namespace, inp.gw.Name)))
+			clusters := bootstrapCfg.GetStaticResources().GetClusters()
+			Expect(clusters).To(ContainElement(HaveField("Name", "xds_cluster")))
+			xdsAddress := clusters[0].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress()
+			Expect(xdsAddress.GetAddress()).To(Equal(inp.dInputs.ControlPlane.XdsHost))
+			Expect(xdsAddress.GetPortValue()).To(Equal(uint32(inp.dInputs.ControlPlane.XdsPort)))
+			Expect(clusters).ToNot(ContainElement(HaveField("Name", wellknown.SdsClusterName)))
+			Expect(bootstrapCfg.GetNode().GetMetadata().GetFields()[xds.SelfManagedKey].GetBoolValue()).To(BeTrue())
+			Expect(bootstrapCfg.GetDynamicResources().GetAdsConfig().GetGrpcServices()[0].GetEnvoyGrpc().GetClusterName()).To(Equal("xds_cluster"))
+			return nil
+		}
+
 		DescribeTable("create and validate objs", func(inp *input, expected *expectedOutput) {
 			checkErr := func(err, expectedErr error) (shouldReturn bool) {
 				GinkgoHelper()
@@ -1189,6 +1312,27 @@ var _ = Describe("Deployer", func() {
 					return validateGatewayParametersPropagation(objs, mergedGatewayParams())
 				},
 			}),
+			Entry("GatewayParameters overrides from the infrastructure parametersRef", &input{
+				dInputs:     defaultDeployerInputs(),
+				gw:          defaultGatewayWithInfrastructureParams(gwpOverrideName),
+				defaultGwp:  defaultGatewayParams(),
+				overrideGwp: defaultGatewayParamsOverride(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					return validateGatewayParametersPropagation(objs, mergedGatewayParams())
+				},
+			}),
+			Entry("unsupported infrastructure parametersRef", &input{
+				dInputs: defaultDeployerInputs(),
+				gw: func() *api.Gateway {
+					gw := defaultGatewayWithInfrastructureParams(gwpOverrideName)
+					gw.Spec.Infrastructure.ParametersRef.Kind = "ConfigMap"
+					return gw
+				}(),
+				defaultGwp: defaultGatewayParams(),
+			}, &expectedOutput{
+				getObjsErr: deployer.UnsupportedParametersRefError,
+			}),
 			Entry("Fully defined GatewayParameters", &input{
 				dInputs:    istioEnabledDeployerInputs(),
 				gw:         defaultGateway(),
@@ -1300,6 +1444,124 @@ var _ = Describe("Deployer", func() {
 					return nil
 				},
 			}),
+			Entry("tcp and udp listeners on the same port", &input{
+				dInputs: defaultDeployerInputs(),
+				gw: &api.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: defaultNamespace,
+						UID:       "1235",
+					},
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Gateway",
+						APIVersion: "gateway.solo.io/v1beta1",
+					},
+					Spec: api.GatewaySpec{
+						GatewayClassName: wellknown.GatewayClassName,
+						Listeners: []api.Listener{
+							{
+								Name:     "dns-tcp",
+								Port:     53,
+								Protocol: api.TCPProtocolType,
+							},
+							{
+								Name:     "dns-udp",
+								Port:     53,
+								Protocol: api.UDPProtocolType,
+							},
+						},
+					},
+				},
+				defaultGwp: defaultGatewayParams(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					svc := objs.findService(defaultNamespace, defaultServiceName)
+					Expect(svc).NotTo(BeNil())
+
+					Expect(svc.Spec.Ports).To(ConsistOf(
+						And(HaveField("Name", "dns-tcp"), HaveField("Port", int32(53)), HaveField("Protocol", corev1.ProtocolTCP)),
+						And(HaveField("Name", "dns-udp"), HaveField("Port", int32(53)), HaveField("Protocol", corev1.ProtocolUDP)),
+					))
+					return nil
+				},
+			}),
+			Entry("no HorizontalPodAutoscaler or PodDisruptionBudget by default", defaultInput(), &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					Expect(objs.findHorizontalPodAutoscaler(defaultNamespace, defaultDeploymentName)).To(BeNil())
+					Expect(objs.findPodDisruptionBudget(defaultNamespace, defaultDeploymentName)).To(BeNil())
+					return nil
+				},
+			}),
+			Entry("HorizontalPodAutoscaler and PodDisruptionBudget", &input{
+				dInputs:    defaultDeployerInputs(),
+				gw:         defaultGatewayWithGatewayParams(gwpOverrideName),
+				defaultGwp: defaultGatewayParams(),
+				overrideGwp: &gw2_v1alpha1.GatewayParameters{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       gw2_v1alpha1.GatewayParametersKind,
+						APIVersion: gw2_v1alpha1.GroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      gwpOverrideName,
+						Namespace: defaultNamespace,
+					},
+					Spec: gw2_v1alpha1.GatewayParametersSpec{
+						Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+							HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+								MinReplicas:                    ptr.To[uint32](2),
+								MaxReplicas:                    ptr.To[uint32](10),
+								TargetCPUUtilizationPercentage: ptr.To[uint32](70),
+								Metrics: []autoscalingv2.MetricSpec{{
+									Type: autoscalingv2.PodsMetricSourceType,
+									Pods: &autoscalingv2.PodsMetricSource{
+										Metric: autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+										Target: autoscalingv2.MetricTarget{
+											Type:         autoscalingv2.AverageValueMetricType,
+											AverageValue: ptr.To(resource.MustParse("100")),
+										},
+									},
+								}},
+								Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+									ScaleDown: &autoscalingv2.HPAScalingRules{
+										StabilizationWindowSeconds: ptr.To[int32](600),
+									},
+								},
+							},
+							PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+								MaxUnavailable: ptr.To(intstr.FromString("25%")),
+							},
+						},
+					},
+				},
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					gw := defaultGatewayWithGatewayParams(gwpOverrideName)
+					dep := objs.findDeployment(defaultNamespace, gw.Name)
+					Expect(dep).ToNot(BeNil())
+					// the HorizontalPodAutoscaler manages the replicas
+					Expect(dep.Spec.Replicas).To(BeNil())
+
+					hpa := objs.findHorizontalPodAutoscaler(defaultNamespace, gw.Name)
+					Expect(hpa).ToNot(BeNil())
+					Expect(hpa.Spec.ScaleTargetRef.Kind).To(Equal("Deployment"))
+					Expect(hpa.Spec.ScaleTargetRef.Name).To(Equal(dep.Name))
+					Expect(hpa.Spec.MinReplicas).To(Equal(ptr.To[int32](2)))
+					Expect(hpa.Spec.MaxReplicas).To(Equal(int32(10)))
+					Expect(hpa.Spec.Metrics).To(HaveLen(2))
+					Expect(hpa.Spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceCPU))
+					Expect(hpa.Spec.Metrics[0].Resource.Target.Type).To(Equal(autoscalingv2.UtilizationMetricType))
+					Expect(hpa.Spec.Metrics[0].Resource.Target.AverageUtilization).To(Equal(ptr.To[int32](70)))
+					Expect(hpa.Spec.Metrics[1].Pods.Metric.Name).To(Equal("requests_per_second"))
+					Expect(hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds).To(Equal(ptr.To[int32](600)))
+
+					pdb := objs.findPodDisruptionBudget(defaultNamespace, gw.Name)
+					Expect(pdb).ToNot(BeNil())
+					Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromString("25%"))))
+					Expect(pdb.Spec.MinAvailable).To(BeNil())
+					Expect(pdb.Spec.Selector.MatchLabels).To(Equal(dep.Spec.Selector.MatchLabels))
+					return nil
+				},
+			}),
 			Entry("object owner refs are set", defaultInput(), &expectedOutput{
 				validationFunc: func(objs clientObjects, inp *input) error {
 					Expect(objs).NotTo(BeEmpty())
@@ -1318,6 +1580,82 @@ var _ = Describe("Deployer", func() {
 					return nil
 				},
 			}),
+			Entry("requested addresses are set as the loadBalancerIP", &input{
+				dInputs: defaultDeployerInputs(),
+				gw: func() *api.Gateway {
+					gw := defaultGateway()
+					gw.Spec.Addresses = []api.GatewayAddress{
+						{Type: ptr.To(api.HostnameAddressType), Value: "gateway.example.com"},
+						{Type: ptr.To(api.IPAddressType), Value: "203.0.113.10"},
+						{Value: "203.0.113.11"},
+					}
+					return gw
+				}(),
+				defaultGwp: func() *gw2_v1alpha1.GatewayParameters {
+					gwp := defaultGatewayParams()
+					gwp.Spec.Kube.Service.Type = ptr.To(corev1.ServiceTypeLoadBalancer)
+					return gwp
+				}(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					svc := objs.findService(defaultNamespace, inp.gw.Name)
+					Expect(svc).ToNot(BeNil())
+					Expect(svc.Spec.LoadBalancerIP).To(Equal("203.0.113.10"))
+					Expect(svc.Spec.ExternalIPs).To(BeEmpty())
+					return nil
+				},
+			}),
+			Entry("requested addresses are set as externalIPs", &input{
+				dInputs: defaultDeployerInputs(),
+				gw: func() *api.Gateway {
+					gw := defaultGatewayWithGatewayParams(gwpOverrideName)
+					gw.Spec.Addresses = []api.GatewayAddress{
+						{Type: ptr.To(api.IPAddressType), Value: "203.0.113.10"},
+						{Type: ptr.To(api.IPAddressType), Value: "not-an-ip"},
+						{Type: ptr.To(api.IPAddressType), Value: "203.0.113.11"},
+					}
+					return gw
+				}(),
+				defaultGwp:  defaultGatewayParams(),
+				overrideGwp: defaultGatewayParamsOverride(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					svc := objs.findService(defaultNamespace, inp.gw.Name)
+					Expect(svc).ToNot(BeNil())
+					Expect(svc.Spec.Type).To(Equal(corev1.ServiceTypeClusterIP))
+					Expect(svc.Spec.LoadBalancerIP).To(BeEmpty())
+					Expect(svc.Spec.ExternalIPs).To(Equal([]string{"203.0.113.10", "203.0.113.11"}))
+					return nil
+				},
+			}),
+			Entry("infrastructure labels and annotations are set on all objects", &input{
+				dInputs: defaultDeployerInputs(),
+				gw: func() *api.Gateway {
+					gw := defaultGateway()
+					gw.Spec.Infrastructure = &api.GatewayInfrastructure{
+						Labels: map[api.LabelKey]api.LabelValue{
+							"infra-label":            "infra-label-val",
+							"app.kubernetes.io/name": "infra-name",
+						},
+						Annotations: map[api.AnnotationKey]api.AnnotationValue{
+							"infra-anno": "infra-anno-val",
+						},
+					}
+					return gw
+				}(),
+				defaultGwp: defaultGatewayParams(),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					Expect(objs).NotTo(BeEmpty())
+					for _, obj := range objs {
+						Expect(obj.GetLabels()).To(HaveKeyWithValue("infra-label", "infra-label-val"))
+						// labels set by the deployer take precedence
+						Expect(obj.GetLabels()).To(HaveKeyWithValue("app.kubernetes.io/name", inp.gw.Name))
+						Expect(obj.GetAnnotations()).To(HaveKeyWithValue("infra-anno", "infra-anno-val"))
+					}
+					return nil
+				},
+			}),
 			Entry("envoy yaml is valid", defaultInput(), &expectedOutput{
 				validationFunc: func(objs clientObjects, inp *input) error {
 					gw := defaultGateway()
@@ -1421,19 +1759,49 @@ var _ = Describe("Deployer", func() {
 				gw:         defaultGateway(),
 				defaultGwp: selfManagedGatewayParam(wellknown.DefaultGatewayParametersName),
 			}, &expectedOutput{
-				validationFunc: func(objs clientObjects, inp *input) error {
-					Expect(objs).To(BeEmpty())
-					return nil
-				},
+				validationFunc: selfManagedValidationFunc,
 			}),
 			Entry("Self-managed GatewayParameters override; should not deploy gateway", &input{
 				dInputs:     defaultDeployerInputs(),
 				gw:          defaultGatewayWithGatewayParams("self-managed"),
 				defaultGwp:  defaultGatewayParams(),
 				overrideGwp: selfManagedGatewayParam("self-managed"),
+			}, &expectedOutput{
+				validationFunc: selfManagedValidationFunc,
+			}),
+			Entry("Self-managed gateway with istio integration enabled; bootstrap has no sds cluster", &input{
+				dInputs:    istioEnabledDeployerInputs(),
+				gw:         defaultGateway(),
+				defaultGwp: selfManagedGatewayParam(wellknown.DefaultGatewayParametersName),
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					Expect(selfManagedValidationFunc(objs, inp)).To(Succeed())
+					bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, deployer.SelfManagedBootstrapName(inp.gw.Name))
+					Expect(bootstrapCfg.GetStaticResources().GetClusters()).NotTo(ContainElement(HaveField("Name", wellknown.SdsClusterName)))
+					return nil
+				},
+			}),
+			Entry("Self-managed gateway with xds and sds servers; bootstrap connects to them", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGateway(),
+				defaultGwp: func() *gw2_v1alpha1.GatewayParameters {
+					gwp := selfManagedGatewayParam(wellknown.DefaultGatewayParametersName)
+					gwp.Spec.SelfManaged.XdsServer = &gw2_v1alpha1.Host{Host: "xds.example.com", Port: 443}
+					gwp.Spec.SelfManaged.SdsServer = &gw2_v1alpha1.Host{Host: "127.0.0.1", Port: 8234}
+					return gwp
+				}(),
 			}, &expectedOutput{
 				validationFunc: func(objs clientObjects, inp *input) error {
-					Expect(objs).To(BeEmpty())
+					bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, deployer.SelfManagedBootstrapName(inp.gw.Name))
+					clusters := bootstrapCfg.GetStaticResources().GetClusters()
+					Expect(clusters).To(HaveLen(2))
+					xdsAddress := clusters[0].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress()
+					Expect(xdsAddress.GetAddress()).To(Equal("xds.example.com"))
+					Expect(xdsAddress.GetPortValue()).To(Equal(uint32(443)))
+					Expect(clusters[1].GetName()).To(Equal(wellknown.SdsClusterName))
+					sdsAddress := clusters[1].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress()
+					Expect(sdsAddress.GetAddress()).To(Equal("127.0.0.1"))
+					Expect(sdsAddress.GetPortValue()).To(Equal(uint32(8234)))
 					return nil
 				},
 			}),
@@ -1,6 +1,7 @@
 package deployer
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -93,6 +94,8 @@ func deepMergeGatewayParameters(dst, src *v1alpha1.GatewayParameters) *v1alpha1.
 	srcKube := src.Spec.Kube
 
 	dstKube.Deployment = deepMergeDeployment(dstKube.GetDeployment(), srcKube.GetDeployment())
+	dstKube.HorizontalPodAutoscaler = deepMergeHorizontalPodAutoscaler(dstKube.GetHorizontalPodAutoscaler(), srcKube.GetHorizontalPodAutoscaler())
+	dstKube.PodDisruptionBudget = deepMergePodDisruptionBudget(dstKube.GetPodDisruptionBudget(), srcKube.GetPodDisruptionBudget())
 	dstKube.EnvoyContainer = deepMergeEnvoyContainer(dstKube.GetEnvoyContainer(), srcKube.GetEnvoyContainer())
 	dstKube.SdsContainer = deepMergeSdsContainer(dstKube.GetSdsContainer(), srcKube.GetSdsContainer())
 	dstKube.PodTemplate = deepMergePodTemplate(dstKube.GetPodTemplate(), srcKube.GetPodTemplate())
@@ -704,6 +707,61 @@ func deepMergeDeployment(dst, src *v1alpha1.ProxyDeployment) *v1alpha1.ProxyDepl
 	return dst
 }
 
+func deepMergeHorizontalPodAutoscaler(dst, src *v1alpha1.HorizontalPodAutoscaler) *v1alpha1.HorizontalPodAutoscaler {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.MinReplicas = mergePointers(dst.GetMinReplicas(), src.GetMinReplicas())
+	dst.MaxReplicas = mergePointers(dst.GetMaxReplicas(), src.GetMaxReplicas())
+	dst.TargetCPUUtilizationPercentage = mergePointers(dst.GetTargetCPUUtilizationPercentage(), src.GetTargetCPUUtilizationPercentage())
+	dst.TargetMemoryUtilizationPercentage = mergePointers(dst.GetTargetMemoryUtilizationPercentage(), src.GetTargetMemoryUtilizationPercentage())
+	dst.Metrics = overrideSlices(dst.GetMetrics(), src.GetMetrics())
+	dst.Behavior = deepMergeHorizontalPodAutoscalerBehavior(dst.GetBehavior(), src.GetBehavior())
+
+	return dst
+}
+
+func deepMergeHorizontalPodAutoscalerBehavior(dst, src *autoscalingv2.HorizontalPodAutoscalerBehavior) *autoscalingv2.HorizontalPodAutoscalerBehavior {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.ScaleUp = mergePointers(dst.ScaleUp, src.ScaleUp)
+	dst.ScaleDown = mergePointers(dst.ScaleDown, src.ScaleDown)
+
+	return dst
+}
+
+func deepMergePodDisruptionBudget(dst, src *v1alpha1.PodDisruptionBudget) *v1alpha1.PodDisruptionBudget {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	// minAvailable and maxUnavailable are mutually exclusive, so setting one overrides both
+	if src.GetMinAvailable() != nil || src.GetMaxUnavailable() != nil {
+		dst.MinAvailable = src.GetMinAvailable()
+		dst.MaxUnavailable = src.GetMaxUnavailable()
+	}
+
+	return dst
+}
+
 func deepMergeAIExtension(dst, src *v1alpha1.AiExtension) *v1alpha1.AiExtension {
 	// nil src override means just use dst
 	if src == nil {
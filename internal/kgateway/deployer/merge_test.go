@@ -3,6 +3,7 @@ package deployer
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	gw2_v1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -49,6 +50,45 @@ var _ = Describe("deepMergeGatewayParameters", func() {
 		Expect(out.Spec.Kube.Deployment.Replicas).To(Equal(src.Spec.Kube.Deployment.Replicas))
 	})
 
+	It("merges autoscaling and pod disruption budget", func() {
+		dst := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+						MinReplicas:                    ptr.To[uint32](2),
+						MaxReplicas:                    ptr.To[uint32](10),
+						TargetCPUUtilizationPercentage: ptr.To[uint32](80),
+					},
+					PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+						MinAvailable: ptr.To(intstr.FromInt(1)),
+					},
+				},
+			},
+		}
+		src := &gw2_v1alpha1.GatewayParameters{
+			Spec: gw2_v1alpha1.GatewayParametersSpec{
+				Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+					HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+						MaxReplicas: ptr.To[uint32](20),
+					},
+					PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+						MaxUnavailable: ptr.To(intstr.FromString("50%")),
+					},
+				},
+			},
+		}
+		out := deepMergeGatewayParameters(dst, src)
+		Expect(out.Spec.Kube.HorizontalPodAutoscaler).To(Equal(&gw2_v1alpha1.HorizontalPodAutoscaler{
+			MinReplicas:                    ptr.To[uint32](2),
+			MaxReplicas:                    ptr.To[uint32](20),
+			TargetCPUUtilizationPercentage: ptr.To[uint32](80),
+		}))
+		// minAvailable and maxUnavailable are mutually exclusive
+		Expect(out.Spec.Kube.PodDisruptionBudget).To(Equal(&gw2_v1alpha1.PodDisruptionBudget{
+			MaxUnavailable: ptr.To(intstr.FromString("50%")),
+		}))
+	})
+
 	It("merges maps", func() {
 		dst := &gw2_v1alpha1.GatewayParameters{
 			Spec: gw2_v1alpha1.GatewayParametersSpec{
@@ -1,7 +1,9 @@
 package deployer
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 )
@@ -20,11 +22,12 @@ type helmGateway struct {
 	FullnameOverride *string `json:"fullnameOverride,omitempty"`
 
 	// deployment/service values
-	ReplicaCount   *uint32          `json:"replicaCount,omitempty"`
-	Autoscaling    *helmAutoscaling `json:"autoscaling,omitempty"`
-	Ports          []helmPort       `json:"ports,omitempty"`
-	Service        *helmService     `json:"service,omitempty"`
-	FloatingUserId *bool            `json:"floatingUserId,omitempty"`
+	ReplicaCount        *uint32                  `json:"replicaCount,omitempty"`
+	Autoscaling         *helmAutoscaling         `json:"autoscaling,omitempty"`
+	PodDisruptionBudget *helmPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	Ports               []helmPort               `json:"ports,omitempty"`
+	Service             *helmService             `json:"service,omitempty"`
+	FloatingUserId      *bool                    `json:"floatingUserId,omitempty"`
 
 	// serviceaccount values
 	ServiceAccount *helmServiceAccount `json:"serviceAccount,omitempty"`
@@ -88,6 +91,8 @@ type helmImage struct {
 type helmService struct {
 	Type             *string           `json:"type,omitempty"`
 	ClusterIP        *string           `json:"clusterIP,omitempty"`
+	LoadBalancerIP   *string           `json:"loadBalancerIP,omitempty"`
+	ExternalIPs      []string          `json:"externalIPs,omitempty"`
 	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
 	ExtraLabels      map[string]string `json:"extraLabels,omitempty"`
 }
@@ -105,11 +110,19 @@ type helmXds struct {
 }
 
 type helmAutoscaling struct {
-	Enabled                           *bool   `json:"enabled,omitempty"`
-	MinReplicas                       *uint32 `json:"minReplicas,omitempty"`
-	MaxReplicas                       *uint32 `json:"maxReplicas,omitempty"`
-	TargetCPUUtilizationPercentage    *uint32 `json:"targetCPUUtilizationPercentage,omitempty"`
-	TargetMemoryUtilizationPercentage *uint32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	Enabled                           *bool                                          `json:"enabled,omitempty"`
+	MinReplicas                       *uint32                                        `json:"minReplicas,omitempty"`
+	MaxReplicas                       *uint32                                        `json:"maxReplicas,omitempty"`
+	TargetCPUUtilizationPercentage    *uint32                                        `json:"targetCPUUtilizationPercentage,omitempty"`
+	TargetMemoryUtilizationPercentage *uint32                                        `json:"targetMemoryUtilizationPercentage,omitempty"`
+	Metrics                           []autoscalingv2.MetricSpec                     `json:"metrics,omitempty"`
+	Behavior                          *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+type helmPodDisruptionBudget struct {
+	Enabled        *bool               `json:"enabled,omitempty"`
+	MinAvailable   *intstr.IntOrString `json:"minAvailable,omitempty"`
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 type helmIstio struct {
@@ -3,11 +3,13 @@ package deployer
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"sort"
 	"strings"
 
 	"github.com/rotisserie/eris"
 	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 	api "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -29,15 +31,19 @@ func getPortsValues(gw *api.Gateway) []helmPort {
 	gwPorts := []helmPort{}
 	for _, l := range gw.Spec.Listeners {
 		listenerPort := uint16(l.Port)
+		protocol := "TCP"
+		if l.Protocol == api.UDPProtocolType {
+			protocol = "UDP"
+		}
 
-		// only process this port if we haven't already processed a listener with the same port
-		if slices.IndexFunc(gwPorts, func(p helmPort) bool { return *p.Port == listenerPort }) != -1 {
+		// only process this port if we haven't already processed a listener with the same port and
+		// transport protocol, as TCP and UDP listeners can share a port
+		if slices.IndexFunc(gwPorts, func(p helmPort) bool { return *p.Port == listenerPort && *p.Protocol == protocol }) != -1 {
 			continue
 		}
 
 		targetPort := ports.TranslatePort(listenerPort)
 		portName := string(l.Name)
-		protocol := "TCP"
 
 		gwPorts = append(gwPorts, helmPort{
 			Port:       &listenerPort,
@@ -49,26 +55,35 @@ func getPortsValues(gw *api.Gateway) []helmPort {
 	return gwPorts
 }
 
-// TODO: Removing until autoscaling is re-added.
-// See: https://github.com/solo-io/solo-projects/issues/5948
 // Convert autoscaling values from GatewayParameters into helm values to be used by the deployer.
-// func getAutoscalingValues(autoscaling *v1.Autoscaling) *helmAutoscaling {
-// 	hpaConfig := autoscaling.HorizontalPodAutoscaler
-// 	if hpaConfig == nil {
-// 		return nil
-// 	}
-
-// 	trueVal := true
-// 	autoscalingVals := &helmAutoscaling{
-// 		Enabled: &trueVal,
-// 	}
-// 	autoscalingVals.MinReplicas = hpaConfig.MinReplicas
-// 	autoscalingVals.MaxReplicas = hpaConfig.MaxReplicas
-// 	autoscalingVals.TargetCPUUtilizationPercentage = hpaConfig.TargetCpuUtilizationPercentage
-// 	autoscalingVals.TargetMemoryUtilizationPercentage = hpaConfig.TargetMemoryUtilizationPercentage
-
-// 	return autoscalingVals
-// }
+func getAutoscalingValues(hpaConfig *v1alpha1.HorizontalPodAutoscaler) *helmAutoscaling {
+	if hpaConfig == nil {
+		return nil
+	}
+
+	return &helmAutoscaling{
+		Enabled:                           ptr.To(true),
+		MinReplicas:                       hpaConfig.GetMinReplicas(),
+		MaxReplicas:                       hpaConfig.GetMaxReplicas(),
+		TargetCPUUtilizationPercentage:    hpaConfig.GetTargetCPUUtilizationPercentage(),
+		TargetMemoryUtilizationPercentage: hpaConfig.GetTargetMemoryUtilizationPercentage(),
+		Metrics:                           hpaConfig.GetMetrics(),
+		Behavior:                          hpaConfig.GetBehavior(),
+	}
+}
+
+// Convert pod disruption budget values from GatewayParameters into helm values to be used by the deployer.
+func getPodDisruptionBudgetValues(pdbConfig *v1alpha1.PodDisruptionBudget) *helmPodDisruptionBudget {
+	if pdbConfig == nil {
+		return nil
+	}
+
+	return &helmPodDisruptionBudget{
+		Enabled:        ptr.To(true),
+		MinAvailable:   pdbConfig.GetMinAvailable(),
+		MaxUnavailable: pdbConfig.GetMaxUnavailable(),
+	}
+}
 
 // Convert service values from GatewayParameters into helm values to be used by the deployer.
 func getServiceValues(svcConfig *v1alpha1.Service) *helmService {
@@ -86,6 +101,33 @@ func getServiceValues(svcConfig *v1alpha1.Service) *helmService {
 	}
 }
 
+// Set the addresses requested by the Gateway on the service values. Only IP addresses can be
+// requested: a LoadBalancer Service requests the first one as its loadBalancerIP, and other
+// Service types expose all of them as externalIPs. Addresses that can't be used aren't set, which
+// is reported in the status of the Gateway.
+func applyAddressValues(svc *helmService, addresses []api.GatewayAddress) {
+	var ips []string
+	for _, addr := range addresses {
+		if addr.Type != nil && *addr.Type != api.IPAddressType {
+			continue
+		}
+		if net.ParseIP(addr.Value) == nil {
+			continue
+		}
+		ips = append(ips, addr.Value)
+	}
+	if len(ips) == 0 {
+		return
+	}
+
+	// the service type defaults to LoadBalancer in the chart
+	if svc.Type == nil || *svc.Type == string(corev1.ServiceTypeLoadBalancer) {
+		svc.LoadBalancerIP = &ips[0]
+		return
+	}
+	svc.ExternalIPs = ips
+}
+
 // Convert service account values from GatewayParameters into helm values to be used by the deployer.
 func getServiceAccountValues(svcAccountConfig *v1alpha1.ServiceAccount) *helmServiceAccount {
 	return &helmServiceAccount{
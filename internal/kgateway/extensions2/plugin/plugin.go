@@ -35,7 +35,9 @@ type PolicyPlugin struct {
 	NewGatewayTranslationPass func(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass
 
 	GetBackendForRef GetBackendForRefPlugin
-	ProcessUpstream  func(ctx context.Context, pol ir.PolicyIR, in ir.Upstream, out *envoy_config_cluster_v3.Cluster)
+	// ProcessUpstream applies the policy to the cluster of the upstream. The cluster isn't sent to
+	// the proxies if it returns an error.
+	ProcessUpstream func(ctx context.Context, pol ir.PolicyIR, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) error
 	// TODO: consider changing PerClientProcessUpstream too look like this:
 	// PerClientProcessUpstream  func(kctx krt.HandlerContext, ctx context.Context, ucc ir.UniqlyConnectedClient, in ir.Upstream)
 	// so that it only attaches the policy to the upstream, and doesn't modify the upstream (except for attached policies) or the cluster itself.
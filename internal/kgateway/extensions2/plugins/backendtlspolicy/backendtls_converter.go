@@ -0,0 +1,49 @@
+package backendtlspolicy
+
+import (
+	"fmt"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	gwv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+)
+
+// convertValidation returns the tls transport socket validating the backend with the given CA
+// certificates, or the system CA certificates if there are none.
+func convertValidation(validation gwv1a3.BackendTLSPolicyValidation, caCerts []string) (*envoy_config_core_v3.TransportSocket, error) {
+	if len(caCerts) == 0 {
+		if validation.WellKnownCACertificates == nil {
+			return nil, fmt.Errorf("no CA certificates")
+		}
+		if *validation.WellKnownCACertificates != gwv1a3.WellKnownCACertificatesSystem {
+			return nil, fmt.Errorf("unsupported well known CA certificates %q", *validation.WellKnownCACertificates)
+		}
+	}
+
+	return pluginutils.UpstreamTlsTransportSocket(string(validation.Hostname), &envoyauth.CertificateValidationContext{
+		TrustedCa:                 pluginutils.TrustedCA(caCerts),
+		MatchTypedSubjectAltNames: subjectAltNames(validation),
+	})
+}
+
+// subjectAltNames returns the SANs the backend certificate must match, which default to the hostname.
+func subjectAltNames(validation gwv1a3.BackendTLSPolicyValidation) []*envoyauth.SubjectAltNameMatcher {
+	if len(validation.SubjectAltNames) == 0 {
+		return []*envoyauth.SubjectAltNameMatcher{
+			pluginutils.SanMatcher(envoyauth.SubjectAltNameMatcher_DNS, string(validation.Hostname)),
+		}
+	}
+
+	var out []*envoyauth.SubjectAltNameMatcher
+	for _, san := range validation.SubjectAltNames {
+		switch san.Type {
+		case gwv1a3.HostnameSubjectAltNameType:
+			out = append(out, pluginutils.SanMatcher(envoyauth.SubjectAltNameMatcher_DNS, string(san.Hostname)))
+		case gwv1a3.URISubjectAltNameType:
+			out = append(out, pluginutils.SanMatcher(envoyauth.SubjectAltNameMatcher_URI, string(san.URI)))
+		}
+	}
+	return out
+}
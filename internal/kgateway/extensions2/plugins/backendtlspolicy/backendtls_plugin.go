@@ -0,0 +1,198 @@
+package backendtlspolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/solo-io/go-utils/contextutils"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+type backendTLSPolicy struct {
+	ct time.Time
+	// tls transport socket of the clusters; nil if the policy is invalid
+	transportSocket *envoy_config_core_v3.TransportSocket
+}
+
+func (d *backendTLSPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *backendTLSPolicy) Equals(in any) bool {
+	d2, ok := in.(*backendTLSPolicy)
+	if !ok {
+		return false
+	}
+	return proto.Equal(d.transportSocket, d2.transportSocket)
+}
+
+// OldestWins marks BackendTLSPolicy as oldest-wins: only the oldest policy targeting a service is
+// applied, as specified by GEP-713.
+func (d *backendTLSPolicy) OldestWins() {}
+
+func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionsplug.Plugin {
+	col := krtutil.SetupCollectionDynamic[gwv1a3.BackendTLSPolicy](
+		ctx,
+		commoncol.Client,
+		gwv1a3.SchemeGroupVersion.WithResource("backendtlspolicies"),
+		commoncol.KrtOpts.ToOptions("BackendTLSPolicy")...,
+	)
+	configMaps := krt.WrapClient(kclient.New[*corev1.ConfigMap](commoncol.Client), commoncol.KrtOpts.ToOptions("ConfigMaps")...)
+	gk := wellknown.BackendTLSPolicyGVK.GroupKind()
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, policyCR *gwv1a3.BackendTLSPolicy) *ir.PolicyWrapper {
+		objSrc := ir.ObjectSource{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policyCR.Namespace,
+			Name:      policyCR.Name,
+		}
+
+		errors := []error{}
+		policyIR := &backendTLSPolicy{
+			ct: policyCR.CreationTimestamp.Time,
+		}
+		transportSocket, err := convertBackendTLSPolicy(krtctx, commoncol, configMaps, policyCR)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+		policyIR.transportSocket = transportSocket
+
+		var pol = &ir.PolicyWrapper{
+			ObjectSource: objSrc,
+			Policy:       policyCR,
+			PolicyIR:     policyIR,
+			TargetRefs:   convert(policyCR.Spec.TargetRefs),
+			Errors:       errors,
+		}
+		return pol
+	})
+
+	return extensionsplug.Plugin{
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			gk: {
+				Name:            "backendtlspolicy",
+				ProcessUpstream: processUpstream,
+				Policies:        policyCol,
+			},
+		},
+	}
+}
+
+func convertBackendTLSPolicy(
+	krtctx krt.HandlerContext,
+	commoncol *common.CommonCollections,
+	configMaps krt.Collection[*corev1.ConfigMap],
+	policyCR *gwv1a3.BackendTLSPolicy,
+) (*envoy_config_core_v3.TransportSocket, error) {
+	var caCerts []string
+	for _, ref := range policyCR.Spec.Validation.CACertificateRefs {
+		caCert, err := getCACert(krtctx, commoncol, configMaps, policyCR.Namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		caCerts = append(caCerts, caCert)
+	}
+	return convertValidation(policyCR.Spec.Validation, caCerts)
+}
+
+// getCACert returns the CA certificate bundle of a ConfigMap or Secret in the namespace of the policy.
+func getCACert(
+	krtctx krt.HandlerContext,
+	commoncol *common.CommonCollections,
+	configMaps krt.Collection[*corev1.ConfigMap],
+	namespace string,
+	ref gwv1.LocalObjectReference,
+) (string, error) {
+	switch {
+	case ref.Group == "" && ref.Kind == "ConfigMap":
+		configMap := krt.FetchOne(krtctx, configMaps, krt.FilterObjectName(types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}))
+		if configMap == nil {
+			return "", fmt.Errorf("CA certificate ConfigMap %s not found", ref.Name)
+		}
+		caCert, ok := (*configMap).Data[pluginutils.CACertKey]
+		if !ok {
+			return "", fmt.Errorf("CA certificate ConfigMap %s has no key %q", ref.Name, pluginutils.CACertKey)
+		}
+		return caCert, nil
+	case ref.Group == "" && ref.Kind == "Secret":
+		from := krtcollections.From{
+			GroupKind: wellknown.BackendTLSPolicyGVK.GroupKind(),
+			Namespace: namespace,
+		}
+		secret, err := commoncol.Secrets.GetSecret(krtctx, from, gwv1.SecretObjectReference{Name: ref.Name})
+		if err != nil {
+			return "", fmt.Errorf("failed to get CA certificate Secret %s: %w", ref.Name, err)
+		}
+		caCert, ok := secret.Data[pluginutils.CACertKey]
+		if !ok {
+			return "", fmt.Errorf("CA certificate Secret %s has no key %q", ref.Name, pluginutils.CACertKey)
+		}
+		return string(caCert), nil
+	default:
+		return "", fmt.Errorf("unsupported CA certificate reference kind %s/%s", ref.Group, ref.Kind)
+	}
+}
+
+func convert(targetRefs []gwv1a2.LocalPolicyTargetReferenceWithSectionName) []ir.PolicyTargetRef {
+	var out []ir.PolicyTargetRef
+	for _, targetRef := range targetRefs {
+		var sectionName string
+		if targetRef.SectionName != nil {
+			sectionName = string(*targetRef.SectionName)
+		}
+		out = append(out, ir.PolicyTargetRef{
+			Kind:        string(targetRef.Kind),
+			Name:        string(targetRef.Name),
+			Group:       string(targetRef.Group),
+			SectionName: sectionName,
+		})
+	}
+	return out
+}
+
+func (p *backendTLSPolicy) Name() string {
+	return "backendtlspolicies"
+}
+
+func processUpstream(ctx context.Context, polir ir.PolicyIR, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	policy, ok := polir.(*backendTLSPolicy)
+	if !ok {
+		return nil
+	}
+	// only the oldest policy of the upstream is applied; it replaces any transport socket set by
+	// other plugins.
+	policies := in.AttachedPolicies.Policies[wellknown.BackendTLSPolicyGVK.GroupKind()]
+	if len(policies) == 0 || policies[0].PolicyIr != polir {
+		return nil
+	}
+	// the cluster is dropped rather than sent without TLS; the error of the policy is reported on
+	// its status
+	if policy.transportSocket == nil {
+		return fmt.Errorf("backend tls policy for upstream %s is invalid", in.ClusterName())
+	}
+
+	out.TransportSocket = policy.transportSocket
+	// the cluster originates TLS itself, rather than using istio mTLS.
+	out.TransportSocketMatches = nil
+	return nil
+}
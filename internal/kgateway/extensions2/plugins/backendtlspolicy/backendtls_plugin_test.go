@@ -0,0 +1,120 @@
+package backendtlspolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+const testCACert = "-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----"
+
+func tlsContext(t *testing.T, validation gwv1a3.BackendTLSPolicyValidation, caCerts []string) *envoyauth.UpstreamTlsContext {
+	transportSocket, err := convertValidation(validation, caCerts)
+	require.NoError(t, err)
+	out := &envoyauth.UpstreamTlsContext{}
+	require.NoError(t, transportSocket.GetTypedConfig().UnmarshalTo(out))
+	return out
+}
+
+func TestConvertValidation(t *testing.T) {
+	t.Run("CA certificate refs with the hostname as SAN", func(t *testing.T) {
+		tls := tlsContext(t, gwv1a3.BackendTLSPolicyValidation{Hostname: "backend.example.com"}, []string{testCACert})
+		assert.Equal(t, "backend.example.com", tls.GetSni())
+		validation := tls.GetCommonTlsContext().GetValidationContext()
+		assert.Equal(t, testCACert, validation.GetTrustedCa().GetInlineString())
+		require.Len(t, validation.GetMatchTypedSubjectAltNames(), 1)
+		assert.Equal(t, envoyauth.SubjectAltNameMatcher_DNS, validation.GetMatchTypedSubjectAltNames()[0].GetSanType())
+		assert.Equal(t, "backend.example.com", validation.GetMatchTypedSubjectAltNames()[0].GetMatcher().GetExact())
+	})
+
+	t.Run("well known CA certificates with SANs", func(t *testing.T) {
+		system := gwv1a3.WellKnownCACertificatesSystem
+		tls := tlsContext(t, gwv1a3.BackendTLSPolicyValidation{
+			Hostname:                "backend.example.com",
+			WellKnownCACertificates: &system,
+			SubjectAltNames: []gwv1a3.SubjectAltName{
+				{Type: gwv1a3.HostnameSubjectAltNameType, Hostname: "other.example.com"},
+				{Type: gwv1a3.URISubjectAltNameType, URI: "spiffe://cluster.local/ns/default/sa/backend"},
+			},
+		}, nil)
+		validation := tls.GetCommonTlsContext().GetValidationContext()
+		assert.Equal(t, pluginutils.SystemCACertsFile, validation.GetTrustedCa().GetFilename())
+		sans := validation.GetMatchTypedSubjectAltNames()
+		require.Len(t, sans, 2)
+		assert.Equal(t, "other.example.com", sans[0].GetMatcher().GetExact())
+		assert.Equal(t, envoyauth.SubjectAltNameMatcher_URI, sans[1].GetSanType())
+		assert.Equal(t, "spiffe://cluster.local/ns/default/sa/backend", sans[1].GetMatcher().GetExact())
+	})
+
+	t.Run("no CA certificates", func(t *testing.T) {
+		_, err := convertValidation(gwv1a3.BackendTLSPolicyValidation{Hostname: "backend.example.com"}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestProcessUpstream(t *testing.T) {
+	ctx := context.Background()
+	policy := func(t *testing.T, hostname string) *backendTLSPolicy {
+		transportSocket, err := convertValidation(gwv1a3.BackendTLSPolicyValidation{Hostname: gwv1.PreciseHostname(hostname)}, []string{testCACert})
+		require.NoError(t, err)
+		return &backendTLSPolicy{transportSocket: transportSocket}
+	}
+	// processAll runs the plugin the way the upstream translator does, once per attached policy.
+	processAll := func(out *envoy_config_cluster_v3.Cluster, policies ...*backendTLSPolicy) error {
+		var atts []ir.PolicyAtt
+		for _, pol := range policies {
+			atts = append(atts, ir.PolicyAtt{PolicyIr: pol})
+		}
+		u := ir.Upstream{
+			AttachedPolicies: ir.AttachedPolicies{
+				Policies: map[schema.GroupKind][]ir.PolicyAtt{
+					wellknown.BackendTLSPolicyGVK.GroupKind(): atts,
+				},
+			},
+		}
+		var errs []error
+		for _, att := range atts {
+			errs = append(errs, processUpstream(ctx, att.PolicyIr, u, out))
+		}
+		return errors.Join(errs...)
+	}
+
+	t.Run("oldest policy wins", func(t *testing.T) {
+		out := &envoy_config_cluster_v3.Cluster{
+			TransportSocketMatches: []*envoy_config_cluster_v3.Cluster_TransportSocketMatch{{Name: "tlsMode-istio"}},
+		}
+		oldest := policy(t, "oldest.example.com")
+		require.NoError(t, processAll(out, oldest, policy(t, "newest.example.com")))
+		assert.True(t, proto.Equal(oldest.transportSocket, out.GetTransportSocket()))
+		assert.Empty(t, out.GetTransportSocketMatches())
+	})
+
+	t.Run("replaces the transport socket set by other plugins", func(t *testing.T) {
+		out := &envoy_config_cluster_v3.Cluster{
+			TransportSocket: &envoy_config_core_v3.TransportSocket{Name: "other"},
+		}
+		pol := policy(t, "example.com")
+		require.NoError(t, processAll(out, pol))
+		assert.True(t, proto.Equal(pol.transportSocket, out.GetTransportSocket()))
+	})
+
+	t.Run("invalid oldest policy fails the cluster", func(t *testing.T) {
+		out := &envoy_config_cluster_v3.Cluster{}
+		assert.Error(t, processAll(out, &backendTLSPolicy{}, policy(t, "example.com")))
+		assert.Nil(t, out.GetTransportSocket())
+	})
+}
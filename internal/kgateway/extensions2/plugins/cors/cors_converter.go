@@ -0,0 +1,58 @@
+package cors
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	envoycors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// max age of preflight responses, as documented in the API
+const defaultMaxAge = 5
+
+// convertCORSPolicy returns the per-route config of the cors filter.
+func convertCORSPolicy(spec v1alpha1.CORSPolicySpec) *envoycors.CorsPolicy {
+	maxAge := spec.MaxAge
+	if maxAge == 0 {
+		maxAge = defaultMaxAge
+	}
+
+	out := &envoycors.CorsPolicy{
+		AllowMethods:  strings.Join(spec.AllowMethods, ","),
+		AllowHeaders:  strings.Join(spec.AllowHeaders, ","),
+		ExposeHeaders: strings.Join(spec.ExposeHeaders, ","),
+		MaxAge:        fmt.Sprintf("%d", maxAge),
+	}
+	if spec.AllowCredentials {
+		out.AllowCredentials = wrapperspb.Bool(true)
+	}
+	for _, origin := range spec.AllowOrigins {
+		out.AllowOriginStringMatch = append(out.GetAllowOriginStringMatch(), originMatcher(origin))
+	}
+	return out
+}
+
+// originMatcher matches an origin exactly, unless it has wildcards. The cors filter allows any
+// origin when a matcher matches "*", so that origin is kept as is rather than turned into a regex.
+func originMatcher(origin string) *envoymatcher.StringMatcher {
+	if origin == "*" || !strings.Contains(origin, "*") {
+		return &envoymatcher.StringMatcher{
+			MatchPattern: &envoymatcher.StringMatcher_Exact{
+				Exact: origin,
+			},
+		}
+	}
+
+	return &envoymatcher.StringMatcher{
+		MatchPattern: &envoymatcher.StringMatcher_SafeRegex{
+			SafeRegex: &envoymatcher.RegexMatcher{
+				Regex: strings.ReplaceAll(regexp.QuoteMeta(origin), `\*`, ".*"),
+			},
+		},
+	}
+}
@@ -0,0 +1,204 @@
+package cors
+
+import (
+	"context"
+	"time"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/solo-io/go-utils/contextutils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	extensionplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+)
+
+const FilterName = "envoy.filters.http.cors"
+
+type corsPolicy struct {
+	ct time.Time
+	// per-route config of the cors filter; nil if the policy is invalid
+	perRoute *anypb.Any
+}
+
+func (d *corsPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *corsPolicy) Equals(in any) bool {
+	d2, ok := in.(*corsPolicy)
+	if !ok {
+		return false
+	}
+	return proto.Equal(d.perRoute, d2.perRoute)
+}
+
+type corsPluginGwPass struct {
+	// filter chains with a cors policy, which need the cors filter
+	needFilter map[string]bool
+}
+
+func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionplug.Plugin {
+	col := krtutil.SetupCollectionDynamic[v1alpha1.CORSPolicy](
+		ctx,
+		commoncol.Client,
+		v1alpha1.SchemeGroupVersion.WithResource("corspolicies"),
+		commoncol.KrtOpts.ToOptions("CORSPolicy")...,
+	)
+	gk := v1alpha1.CORSPolicyGVK.GroupKind()
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, policyCR *v1alpha1.CORSPolicy) *ir.PolicyWrapper {
+		objSrc := ir.ObjectSource{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policyCR.Namespace,
+			Name:      policyCR.Name,
+		}
+
+		errors := []error{}
+		policyIR := &corsPolicy{
+			ct: policyCR.CreationTimestamp.Time,
+		}
+		perRoute, err := convertPerRoute(policyCR.Spec)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+		policyIR.perRoute = perRoute
+
+		var pol = &ir.PolicyWrapper{
+			ObjectSource: objSrc,
+			Policy:       policyCR,
+			PolicyIR:     policyIR,
+			TargetRefs:   convert(policyCR.Spec.TargetRef),
+			Errors:       errors,
+		}
+		return pol
+	})
+
+	return extensionplug.Plugin{
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			v1alpha1.CORSPolicyGVK.GroupKind(): {
+				NewGatewayTranslationPass: NewGatewayTranslationPass,
+				Policies:                  policyCol,
+			},
+		},
+	}
+}
+
+func convertPerRoute(spec v1alpha1.CORSPolicySpec) (*anypb.Any, error) {
+	return anypb.New(convertCORSPolicy(spec))
+}
+
+func convert(targetRef v1alpha1.LocalPolicyTargetReferenceWithSectionName) []ir.PolicyTargetRef {
+	var sectionName string
+	if targetRef.SectionName != nil {
+		sectionName = string(*targetRef.SectionName)
+	}
+	return []ir.PolicyTargetRef{{
+		Kind:        string(targetRef.Kind),
+		Name:        string(targetRef.Name),
+		Group:       string(targetRef.Group),
+		SectionName: sectionName,
+	}}
+}
+
+func NewGatewayTranslationPass(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
+	return &corsPluginGwPass{
+		needFilter: make(map[string]bool),
+	}
+}
+
+func (p *corsPolicy) Name() string {
+	return "corspolicies"
+}
+
+// called 1 time for each listener
+func (p *corsPluginGwPass) ApplyListenerPlugin(ctx context.Context, pCtx *ir.ListenerContext, out *envoy_config_listener_v3.Listener) {
+}
+
+func (p *corsPluginGwPass) ApplyHCM(ctx context.Context, pCtx *ir.HcmContext, out *envoyhttp.HttpConnectionManager) error {
+	// no op
+	return nil
+}
+
+func (p *corsPluginGwPass) ApplyTcpProxy(ctx context.Context, pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	// no op
+	return nil
+}
+
+// applies policies attached to the gateway to all of its virtual hosts
+func (p *corsPluginGwPass) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
+	policy, ok := pCtx.Policy.(*corsPolicy)
+	if !ok || policy.perRoute == nil {
+		return
+	}
+
+	p.needFilter[pCtx.FilterChainName] = true
+	if out.GetTypedPerFilterConfig() == nil {
+		out.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	out.GetTypedPerFilterConfig()[FilterName] = policy.perRoute
+}
+
+// called 0 or more times
+func (p *corsPluginGwPass) ApplyForRoute(ctx context.Context, pCtx *ir.RouteContext, outputRoute *envoy_config_route_v3.Route) error {
+	policy, ok := pCtx.Policy.(*corsPolicy)
+	if !ok || policy.perRoute == nil {
+		return nil
+	}
+
+	p.needFilter[pCtx.FilterChainName] = true
+	if outputRoute.GetTypedPerFilterConfig() == nil {
+		outputRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	outputRoute.GetTypedPerFilterConfig()[FilterName] = policy.perRoute
+
+	return nil
+}
+
+func (p *corsPluginGwPass) ApplyForRouteBackend(
+	ctx context.Context,
+	policy ir.PolicyIR,
+	pCtx *ir.RouteBackendContext,
+) error {
+	return nil
+}
+
+// called 1 time per listener
+// if a plugin emits new filters, they must be with a plugin unique name.
+// any filter returned from route config must be disabled, so it doesnt impact other routes.
+func (p *corsPluginGwPass) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	if !p.needFilter[fcc.FilterChainName] {
+		return nil, nil
+	}
+	// the filter only handles requests of virtual hosts and routes with a per-route config.
+	f, err := plugins.NewStagedFilter(FilterName, &envoycors.Cors{}, plugins.DuringStage(plugins.CorsStage))
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{f}, nil
+}
+
+func (p *corsPluginGwPass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
+	return nil, nil
+}
+
+func (p *corsPluginGwPass) NetworkFilters(ctx context.Context) ([]plugins.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+// called 1 time (per envoy proxy). replaces GeneratedResources
+func (p *corsPluginGwPass) ResourcesToAdd(ctx context.Context) ir.Resources {
+	return ir.Resources{}
+}
@@ -0,0 +1,78 @@
+package cors
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestConvertCORSPolicy(t *testing.T) {
+	cors := convertCORSPolicy(v1alpha1.CORSPolicySpec{
+		AllowOrigins:     []string{"https://example.com", "https://*.example.com"},
+		AllowCredentials: true,
+		AllowMethods:     []string{"GET", "POST"},
+		AllowHeaders:     []string{"x-custom"},
+		ExposeHeaders:    []string{"x-exposed", "x-other"},
+	})
+
+	assert.Equal(t, "GET,POST", cors.GetAllowMethods())
+	assert.Equal(t, "x-custom", cors.GetAllowHeaders())
+	assert.Equal(t, "x-exposed,x-other", cors.GetExposeHeaders())
+	assert.Equal(t, "5", cors.GetMaxAge())
+	assert.True(t, cors.GetAllowCredentials().GetValue())
+
+	require.Len(t, cors.GetAllowOriginStringMatch(), 2)
+	assert.Equal(t, "https://example.com", cors.GetAllowOriginStringMatch()[0].GetExact())
+	wildcard := regexp.MustCompile("^" + cors.GetAllowOriginStringMatch()[1].GetSafeRegex().GetRegex() + "$")
+	assert.True(t, wildcard.MatchString("https://api.example.com"))
+	assert.False(t, wildcard.MatchString("https://example.org"))
+}
+
+func TestConvertCORSPolicyAnyOrigin(t *testing.T) {
+	cors := convertCORSPolicy(v1alpha1.CORSPolicySpec{
+		AllowOrigins: []string{"*"},
+	})
+
+	require.Len(t, cors.GetAllowOriginStringMatch(), 1)
+	assert.Equal(t, "*", cors.GetAllowOriginStringMatch()[0].GetExact())
+	assert.Nil(t, cors.GetAllowOriginStringMatch()[0].GetSafeRegex())
+}
+
+func TestCORSPass(t *testing.T) {
+	ctx := context.Background()
+	perRoute, err := convertPerRoute(v1alpha1.CORSPolicySpec{AllowOrigins: []string{"*"}, MaxAge: 60})
+	require.NoError(t, err)
+	policy := &corsPolicy{perRoute: perRoute}
+
+	pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+	vhost := &envoy_config_route_v3.VirtualHost{}
+	pass.ApplyVhostPlugin(ctx, &ir.VirtualHostContext{FilterChainName: "listener~80", Policy: policy}, vhost)
+	route := &envoy_config_route_v3.Route{}
+	err = pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: policy}, route)
+	require.NoError(t, err)
+
+	for _, config := range []map[string]*anypb.Any{vhost.GetTypedPerFilterConfig(), route.GetTypedPerFilterConfig()} {
+		cors := &envoycors.CorsPolicy{}
+		require.NoError(t, config[FilterName].UnmarshalTo(cors))
+		assert.Equal(t, "60", cors.GetMaxAge())
+		assert.Equal(t, "*", cors.GetAllowOriginStringMatch()[0].GetExact())
+	}
+
+	filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, FilterName, filters[0].Filter.GetName())
+
+	filters, err = pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~8080"})
+	require.NoError(t, err)
+	assert.Empty(t, filters)
+}
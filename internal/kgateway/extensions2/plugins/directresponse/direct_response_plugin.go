@@ -7,6 +7,7 @@ import (
 	"time"
 
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -53,6 +54,11 @@ func (p *directResponsePluginGwPass) ApplyHCM(ctx context.Context, pCtx *ir.HcmC
 	return nil
 }
 
+func (p *directResponsePluginGwPass) ApplyTcpProxy(ctx context.Context, pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	// no op
+	return nil
+}
+
 func registerTypes(ourCli versioned.Interface) {
 	skubeclient.Register[*v1alpha1.DirectResponse](
 		v1alpha1.DirectResponseGVK.GroupVersion().WithResource("directresponses"),
@@ -0,0 +1,117 @@
+package extauth
+
+import (
+	"fmt"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyextauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const (
+	extAuthStatPrefix = "ext_authz"
+
+	// timeout of calls to the auth service, as documented in the API
+	defaultTimeout = 200 * time.Millisecond
+)
+
+// convertExtAuthService returns the config of the ext_authz filter calling the auth service.
+func convertExtAuthService(service *v1alpha1.ExtAuthService, authServer *ir.Upstream) (*envoyextauthz.ExtAuthz, error) {
+	timeout := durationpb.New(defaultTimeout)
+	if service.Timeout != nil {
+		timeout = durationpb.New(service.Timeout.Duration)
+	}
+
+	out := &envoyextauthz.ExtAuthz{
+		TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		FailureModeAllow:    service.FailOpen,
+		StatPrefix:          extAuthStatPrefix,
+		AllowedHeaders:      listStringMatcher(service.HeadersToAuth),
+	}
+	if service.WithRequestBody != nil {
+		out.WithRequestBody = &envoyextauthz.BufferSettings{
+			MaxRequestBytes:     service.WithRequestBody.MaxRequestBytes,
+			AllowPartialMessage: service.WithRequestBody.AllowPartialMessage,
+			PackAsBytes:         service.WithRequestBody.PackAsBytes,
+		}
+	}
+
+	switch service.Protocol {
+	case v1alpha1.ExtAuthProtocolGRPC, "":
+		out.Services = &envoyextauthz.ExtAuthz_GrpcService{
+			GrpcService: &envoy_config_core_v3.GrpcService{
+				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: authServer.ClusterName(),
+					},
+				},
+				Timeout: timeout,
+			},
+		}
+	case v1alpha1.ExtAuthProtocolHTTP:
+		httpService := &envoyextauthz.HttpService{
+			ServerUri: &envoy_config_core_v3.HttpUri{
+				// only used for the host header, requests are sent to the cluster
+				Uri: fmt.Sprintf("http://%s", authServer.ClusterName()),
+				HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+					Cluster: authServer.ClusterName(),
+				},
+				Timeout: timeout,
+			},
+			PathPrefix: service.PathPrefix,
+		}
+		if len(service.HeadersToUpstream) > 0 {
+			httpService.AuthorizationResponse = &envoyextauthz.AuthorizationResponse{
+				AllowedUpstreamHeaders: listStringMatcher(service.HeadersToUpstream),
+			}
+		}
+		out.Services = &envoyextauthz.ExtAuthz_HttpService{
+			HttpService: httpService,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported ext auth protocol %q", service.Protocol)
+	}
+
+	return out, nil
+}
+
+// convertPerRoute returns the per-route config of the ext_authz filter, which also enables the
+// filter for routes of filter chains where it is disabled by default.
+func convertPerRoute(spec v1alpha1.ExtAuthPolicySpec) (*anypb.Any, error) {
+	if spec.Disable {
+		return anypb.New(&envoyextauthz.ExtAuthzPerRoute{
+			Override: &envoyextauthz.ExtAuthzPerRoute_Disabled{
+				Disabled: true,
+			},
+		})
+	}
+	return anypb.New(&envoyextauthz.ExtAuthzPerRoute{
+		Override: &envoyextauthz.ExtAuthzPerRoute_CheckSettings{
+			CheckSettings: &envoyextauthz.CheckSettings{
+				ContextExtensions: spec.ContextExtensions,
+			},
+		},
+	})
+}
+
+func listStringMatcher(headers []string) *envoymatcher.ListStringMatcher {
+	if len(headers) == 0 {
+		return nil
+	}
+	out := &envoymatcher.ListStringMatcher{}
+	for _, h := range headers {
+		out.Patterns = append(out.GetPatterns(), &envoymatcher.StringMatcher{
+			MatchPattern: &envoymatcher.StringMatcher_Exact{
+				Exact: h,
+			},
+			IgnoreCase: true,
+		})
+	}
+	return out
+}
@@ -0,0 +1,305 @@
+package extauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyextauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/solo-io/go-utils/contextutils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	extensionplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+)
+
+const FilterName = "envoy.filters.http.ext_authz"
+
+type extAuthPolicy struct {
+	ct time.Time
+	// config of the ext_authz filter; nil if the policy has no service
+	filter *envoyextauthz.ExtAuthz
+	// per-route config of the ext_authz filter
+	perRoute *anypb.Any
+	// whether the policy changes the defaults of the filter, and needs the per-route config on a
+	// virtual host
+	overridesDefaults bool
+	// whether the policy has a service that could not be translated; the requests it applies to are
+	// denied rather than let through without authorization
+	invalidService bool
+}
+
+func (d *extAuthPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *extAuthPolicy) Equals(in any) bool {
+	d2, ok := in.(*extAuthPolicy)
+	if !ok {
+		return false
+	}
+	return proto.Equal(d.filter, d2.filter) &&
+		proto.Equal(d.perRoute, d2.perRoute) &&
+		d.overridesDefaults == d2.overridesDefaults &&
+		d.invalidService == d2.invalidService
+}
+
+type extAuthFilter struct {
+	config *envoyextauthz.ExtAuthz
+	// the filter is enabled for all the routes of the filter chain when the service is attached to
+	// the gateway; otherwise only routes with a per-route config call the auth service.
+	enabledByDefault bool
+}
+
+type extAuthPluginGwPass struct {
+	// ext_authz filter of each filter chain
+	filters map[string]*extAuthFilter
+}
+
+func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionplug.Plugin {
+	col := krtutil.SetupCollectionDynamic[v1alpha1.ExtAuthPolicy](
+		ctx,
+		commoncol.Client,
+		v1alpha1.SchemeGroupVersion.WithResource("extauthpolicies"),
+		commoncol.KrtOpts.ToOptions("ExtAuthPolicy")...,
+	)
+	gk := v1alpha1.ExtAuthPolicyGVK.GroupKind()
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, policyCR *v1alpha1.ExtAuthPolicy) *ir.PolicyWrapper {
+		objSrc := ir.ObjectSource{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policyCR.Namespace,
+			Name:      policyCR.Name,
+		}
+
+		errors := []error{}
+		policyIR, err := convertExtAuthPolicy(krtctx, commoncol, objSrc, policyCR)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		var pol = &ir.PolicyWrapper{
+			ObjectSource: objSrc,
+			Policy:       policyCR,
+			PolicyIR:     policyIR,
+			TargetRefs:   convert(policyCR.Spec.TargetRef),
+			Errors:       errors,
+		}
+		return pol
+	})
+
+	return extensionplug.Plugin{
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			v1alpha1.ExtAuthPolicyGVK.GroupKind(): {
+				NewGatewayTranslationPass: NewGatewayTranslationPass,
+				Policies:                  policyCol,
+			},
+		},
+	}
+}
+
+func convertExtAuthPolicy(
+	krtctx krt.HandlerContext,
+	commoncol *common.CommonCollections,
+	objSrc ir.ObjectSource,
+	policyCR *v1alpha1.ExtAuthPolicy,
+) (*extAuthPolicy, error) {
+	spec := policyCR.Spec
+	out := &extAuthPolicy{
+		ct:                policyCR.CreationTimestamp.Time,
+		overridesDefaults: spec.Disable || len(spec.ContextExtensions) > 0,
+		// cleared once the service is translated
+		invalidService: spec.Service != nil,
+	}
+
+	perRoute, err := convertPerRoute(spec)
+	if err != nil {
+		return out, err
+	}
+	out.perRoute = perRoute
+
+	if spec.Service != nil {
+		authServer, err := commoncol.Upstreams.GetUpstreamFromRef(krtctx, objSrc, spec.Service.BackendRef.BackendObjectReference)
+		if err != nil {
+			return out, fmt.Errorf("failed to get auth service upstream from ref: %s", err.Error())
+		}
+		filter, err := convertExtAuthService(spec.Service, authServer)
+		if err != nil {
+			return out, err
+		}
+		out.filter = filter
+		out.invalidService = false
+	}
+
+	return out, nil
+}
+
+func convert(targetRef v1alpha1.LocalPolicyTargetReferenceWithSectionName) []ir.PolicyTargetRef {
+	var sectionName string
+	if targetRef.SectionName != nil {
+		sectionName = string(*targetRef.SectionName)
+	}
+	return []ir.PolicyTargetRef{{
+		Kind:        string(targetRef.Kind),
+		Name:        string(targetRef.Name),
+		Group:       string(targetRef.Group),
+		SectionName: sectionName,
+	}}
+}
+
+func NewGatewayTranslationPass(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
+	return &extAuthPluginGwPass{
+		filters: make(map[string]*extAuthFilter),
+	}
+}
+
+func (p *extAuthPolicy) Name() string {
+	return "extauthpolicies"
+}
+
+// called 1 time for each listener
+func (p *extAuthPluginGwPass) ApplyListenerPlugin(ctx context.Context, pCtx *ir.ListenerContext, out *envoy_config_listener_v3.Listener) {
+}
+
+func (p *extAuthPluginGwPass) ApplyHCM(ctx context.Context, pCtx *ir.HcmContext, out *envoyhttp.HttpConnectionManager) error {
+	// no op
+	return nil
+}
+
+func (p *extAuthPluginGwPass) ApplyTcpProxy(ctx context.Context, pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	// no op
+	return nil
+}
+
+// applies policies attached to the gateway to all of its virtual hosts
+func (p *extAuthPluginGwPass) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
+	policy, ok := pCtx.Policy.(*extAuthPolicy)
+	if !ok {
+		return
+	}
+
+	if policy.invalidService {
+		denyRoutes(out.GetRoutes())
+		return
+	}
+	if policy.filter != nil {
+		if err := p.useFilter(pCtx.FilterChainName, policy.filter, true); err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			denyRoutes(out.GetRoutes())
+			return
+		}
+	}
+	if policy.overridesDefaults {
+		if out.GetTypedPerFilterConfig() == nil {
+			out.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+		out.GetTypedPerFilterConfig()[FilterName] = policy.perRoute
+	}
+}
+
+// called 0 or more times
+func (p *extAuthPluginGwPass) ApplyForRoute(ctx context.Context, pCtx *ir.RouteContext, outputRoute *envoy_config_route_v3.Route) error {
+	policy, ok := pCtx.Policy.(*extAuthPolicy)
+	if !ok {
+		return nil
+	}
+
+	if policy.invalidService {
+		denyRoutes([]*envoy_config_route_v3.Route{outputRoute})
+		return nil
+	}
+	if policy.filter != nil {
+		if err := p.useFilter(pCtx.FilterChainName, policy.filter, false); err != nil {
+			return err
+		}
+	}
+	if outputRoute.GetTypedPerFilterConfig() == nil {
+		outputRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	outputRoute.GetTypedPerFilterConfig()[FilterName] = policy.perRoute
+
+	return nil
+}
+
+// denyRoutes makes the routes respond with a 503 without reaching their backends, for policies
+// whose auth service can't be used.
+func denyRoutes(routes []*envoy_config_route_v3.Route) {
+	for _, route := range routes {
+		route.Action = &envoy_config_route_v3.Route_DirectResponse{
+			DirectResponse: &envoy_config_route_v3.DirectResponseAction{
+				Status: http.StatusServiceUnavailable,
+			},
+		}
+	}
+}
+
+// useFilter records the ext_authz filter needed by the filter chain.
+// All the policies of a filter chain must use the same auth service, as the filter is shared by
+// all of its routes.
+func (p *extAuthPluginGwPass) useFilter(filterChainName string, config *envoyextauthz.ExtAuthz, enabledByDefault bool) error {
+	existing, ok := p.filters[filterChainName]
+	if !ok {
+		p.filters[filterChainName] = &extAuthFilter{
+			config:           config,
+			enabledByDefault: enabledByDefault,
+		}
+		return nil
+	}
+	if !proto.Equal(existing.config, config) {
+		return fmt.Errorf("conflicting ext auth service on filter chain %s", filterChainName)
+	}
+	existing.enabledByDefault = existing.enabledByDefault || enabledByDefault
+	return nil
+}
+
+func (p *extAuthPluginGwPass) ApplyForRouteBackend(
+	ctx context.Context,
+	policy ir.PolicyIR,
+	pCtx *ir.RouteBackendContext,
+) error {
+	return nil
+}
+
+// called 1 time per listener
+// if a plugin emits new filters, they must be with a plugin unique name.
+// any filter returned from route config must be disabled, so it doesnt impact other routes.
+func (p *extAuthPluginGwPass) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	filter, ok := p.filters[fcc.FilterChainName]
+	if !ok {
+		return nil, nil
+	}
+	f, err := plugins.NewStagedFilter(FilterName, filter.config, plugins.DuringStage(plugins.AuthZStage))
+	if err != nil {
+		return nil, err
+	}
+	// routes with a per-route config enable the filter.
+	f.Filter.Disabled = !filter.enabledByDefault
+	return []plugins.StagedHttpFilter{f}, nil
+}
+
+func (p *extAuthPluginGwPass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
+	return nil, nil
+}
+
+func (p *extAuthPluginGwPass) NetworkFilters(ctx context.Context) ([]plugins.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+// called 1 time (per envoy proxy). replaces GeneratedResources
+func (p *extAuthPluginGwPass) ResourcesToAdd(ctx context.Context) ir.Resources {
+	return ir.Resources{}
+}
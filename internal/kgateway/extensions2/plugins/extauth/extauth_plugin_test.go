@@ -0,0 +1,219 @@
+package extauth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyextauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+)
+
+var authServer = &ir.Upstream{
+	ObjectSource: ir.ObjectSource{
+		Kind:      "Service",
+		Namespace: "default",
+		Name:      "auth",
+	},
+	Port: 9000,
+}
+
+func TestConvertExtAuthService(t *testing.T) {
+	t.Run("GRPC", func(t *testing.T) {
+		filter, err := convertExtAuthService(&v1alpha1.ExtAuthService{
+			Protocol:      v1alpha1.ExtAuthProtocolGRPC,
+			FailOpen:      true,
+			HeadersToAuth: []string{"authorization"},
+			WithRequestBody: &v1alpha1.ExtAuthBufferSettings{
+				MaxRequestBytes: 1024,
+				PackAsBytes:     true,
+			},
+		}, authServer)
+		require.NoError(t, err)
+
+		assert.True(t, filter.GetFailureModeAllow())
+		assert.Equal(t, "service_default_auth_9000", filter.GetGrpcService().GetEnvoyGrpc().GetClusterName())
+		assert.True(t, proto.Equal(durationpb.New(defaultTimeout), filter.GetGrpcService().GetTimeout()))
+		require.Len(t, filter.GetAllowedHeaders().GetPatterns(), 1)
+		assert.Equal(t, "authorization", filter.GetAllowedHeaders().GetPatterns()[0].GetExact())
+		assert.True(t, proto.Equal(&envoyextauthz.BufferSettings{MaxRequestBytes: 1024, PackAsBytes: true}, filter.GetWithRequestBody()))
+	})
+
+	t.Run("HTTP", func(t *testing.T) {
+		filter, err := convertExtAuthService(&v1alpha1.ExtAuthService{
+			Protocol:          v1alpha1.ExtAuthProtocolHTTP,
+			PathPrefix:        "/check",
+			Timeout:           &metav1.Duration{Duration: time.Second},
+			HeadersToUpstream: []string{"x-user-id"},
+		}, authServer)
+		require.NoError(t, err)
+
+		httpService := filter.GetHttpService()
+		assert.False(t, filter.GetFailureModeAllow())
+		assert.Equal(t, "service_default_auth_9000", httpService.GetServerUri().GetCluster())
+		assert.True(t, proto.Equal(durationpb.New(time.Second), httpService.GetServerUri().GetTimeout()))
+		assert.Equal(t, "/check", httpService.GetPathPrefix())
+		require.Len(t, httpService.GetAuthorizationResponse().GetAllowedUpstreamHeaders().GetPatterns(), 1)
+		assert.Nil(t, filter.GetAllowedHeaders())
+	})
+}
+
+func TestExtAuthPass(t *testing.T) {
+	ctx := context.Background()
+	policy := func(t *testing.T, spec v1alpha1.ExtAuthPolicySpec, server *ir.Upstream) *extAuthPolicy {
+		out := &extAuthPolicy{overridesDefaults: spec.Disable || len(spec.ContextExtensions) > 0}
+		perRoute, err := convertPerRoute(spec)
+		require.NoError(t, err)
+		out.perRoute = perRoute
+		if spec.Service != nil {
+			out.filter, err = convertExtAuthService(spec.Service, server)
+			require.NoError(t, err)
+		}
+		return out
+	}
+	newRoute := func() *envoy_config_route_v3.Route {
+		return &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: &envoy_config_route_v3.RouteAction{},
+			},
+		}
+	}
+	service := &v1alpha1.ExtAuthService{}
+
+	t.Run("gateway policy enables the filter for all routes", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		vhost := &envoy_config_route_v3.VirtualHost{}
+		pass.ApplyVhostPlugin(ctx, &ir.VirtualHostContext{FilterChainName: "listener~80", Policy: policy(t, v1alpha1.ExtAuthPolicySpec{Service: service}, authServer)}, vhost)
+		assert.Empty(t, vhost.GetTypedPerFilterConfig())
+
+		// a route can opt out
+		route := newRoute()
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: policy(t, v1alpha1.ExtAuthPolicySpec{Disable: true}, nil)}, route)
+		require.NoError(t, err)
+		perRoute := &envoyextauthz.ExtAuthzPerRoute{}
+		require.NoError(t, route.GetTypedPerFilterConfig()[FilterName].UnmarshalTo(perRoute))
+		assert.True(t, perRoute.GetDisabled())
+
+		filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+		require.NoError(t, err)
+		require.Len(t, filters, 1)
+		assert.Equal(t, FilterName, filters[0].Filter.GetName())
+		assert.False(t, filters[0].Filter.GetDisabled())
+
+		filters, err = pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~8080"})
+		require.NoError(t, err)
+		assert.Empty(t, filters)
+	})
+
+	t.Run("route policy enables the filter for the route only", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		route := newRoute()
+		spec := v1alpha1.ExtAuthPolicySpec{
+			Service:           service,
+			ContextExtensions: map[string]string{"tenant": "a"},
+		}
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: policy(t, spec, authServer)}, route)
+		require.NoError(t, err)
+
+		perRoute := &envoyextauthz.ExtAuthzPerRoute{}
+		require.NoError(t, route.GetTypedPerFilterConfig()[FilterName].UnmarshalTo(perRoute))
+		assert.Equal(t, map[string]string{"tenant": "a"}, perRoute.GetCheckSettings().GetContextExtensions())
+
+		filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+		require.NoError(t, err)
+		require.Len(t, filters, 1)
+		assert.True(t, filters[0].Filter.GetDisabled())
+	})
+
+	t.Run("conflicting services on the same filter chain", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: policy(t, v1alpha1.ExtAuthPolicySpec{Service: service}, authServer)}, newRoute())
+		require.NoError(t, err)
+
+		other := *authServer
+		other.Name = "other-auth"
+		err = pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: policy(t, v1alpha1.ExtAuthPolicySpec{Service: service}, &other)}, newRoute())
+		assert.Error(t, err)
+	})
+}
+
+func TestUnresolvedExtAuthService(t *testing.T) {
+	ctx := context.Background()
+	mock := krttest.NewMock(t, nil)
+	policies := krtcollections.NewPolicyIndex(krtutil.KrtOptions{}, extensionsplug.ContributesPolicies{})
+	refgrants := krtcollections.NewRefGrantIndex(krttest.GetMockCollection[*gwv1beta1.ReferenceGrant](mock))
+	commoncol := &common.CommonCollections{
+		Upstreams: krtcollections.NewUpstreamIndex(krtutil.KrtOptions{}, nil, policies, refgrants),
+	}
+
+	policyCR := &v1alpha1.ExtAuthPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "auth"},
+		Spec: v1alpha1.ExtAuthPolicySpec{
+			Service: &v1alpha1.ExtAuthService{
+				BackendRef: gwv1.BackendRef{
+					BackendObjectReference: gwv1.BackendObjectReference{
+						Name: "missing",
+						Port: ptr.To(gwv1.PortNumber(9000)),
+					},
+				},
+			},
+		},
+	}
+	objSrc := ir.ObjectSource{
+		Group:     v1alpha1.ExtAuthPolicyGVK.Group,
+		Kind:      v1alpha1.ExtAuthPolicyGVK.Kind,
+		Namespace: policyCR.Namespace,
+		Name:      policyCR.Name,
+	}
+	policy, err := convertExtAuthPolicy(krt.TestingDummyContext{}, commoncol, objSrc, policyCR)
+	require.Error(t, err)
+	require.Nil(t, policy.filter)
+	assert.True(t, policy.invalidService)
+
+	newRoute := func() *envoy_config_route_v3.Route {
+		return &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: &envoy_config_route_v3.RouteAction{},
+			},
+		}
+	}
+
+	t.Run("gateway policy denies all routes", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		vhost := &envoy_config_route_v3.VirtualHost{Routes: []*envoy_config_route_v3.Route{newRoute(), newRoute()}}
+		pass.ApplyVhostPlugin(ctx, &ir.VirtualHostContext{FilterChainName: "listener~80", Policy: policy}, vhost)
+		for _, route := range vhost.GetRoutes() {
+			assert.Equal(t, uint32(http.StatusServiceUnavailable), route.GetDirectResponse().GetStatus())
+		}
+	})
+
+	t.Run("route policy denies the route", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		route := newRoute()
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: policy}, route)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(http.StatusServiceUnavailable), route.GetDirectResponse().GetStatus())
+
+		filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+		require.NoError(t, err)
+		assert.Empty(t, filters)
+	})
+}
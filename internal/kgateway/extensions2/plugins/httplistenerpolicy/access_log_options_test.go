@@ -0,0 +1,93 @@
+package httplistenerpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoyaccesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestAccessLogOptions(t *testing.T) {
+	ctx := context.Background()
+	pass := &httpListenerPolicyPluginGwPass{}
+	fileLog := &envoyaccesslog.AccessLog{Name: wellknown.FileAccessLog}
+
+	t.Run("adds the tcp access logs to the tcp proxy", func(t *testing.T) {
+		out := &envoytcp.TcpProxy{StatPrefix: "listener~9000"}
+		pol := &httpListenerPolicy{
+			accessLog:              []*envoyaccesslog.AccessLog{{Name: "http"}},
+			tcpAccessLog:           []*envoyaccesslog.AccessLog{fileLog},
+			accessLogFlushInterval: durationpb.New(time.Minute),
+		}
+		require.NoError(t, pass.ApplyTcpProxy(ctx, &ir.TcpProxyContext{Policy: pol}, out))
+
+		expected := &envoytcp.TcpProxy{
+			StatPrefix: "listener~9000",
+			AccessLog:  []*envoyaccesslog.AccessLog{fileLog},
+			AccessLogOptions: &envoytcp.TcpProxy_TcpAccessLogOptions{
+				AccessLogFlushInterval: durationpb.New(time.Minute),
+			},
+		}
+		assert.True(t, proto.Equal(expected, out), "expected %v, got %v", expected, out)
+	})
+
+	t.Run("adds the upstream access logs to the router filter", func(t *testing.T) {
+		routerFilter := &envoy_hcm.HttpFilter{
+			Name:       wellknown.Router,
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{TypedConfig: mustMessageToAny(t, &routerv3.Router{})},
+		}
+		out := &envoy_hcm.HttpConnectionManager{
+			HttpFilters: []*envoy_hcm.HttpFilter{{Name: "other"}, routerFilter},
+		}
+		pol := &httpListenerPolicy{
+			upstreamAccessLog:      []*envoyaccesslog.AccessLog{fileLog},
+			accessLogFlushInterval: durationpb.New(time.Second),
+		}
+		require.NoError(t, pass.ApplyHCM(ctx, &ir.HcmContext{Policy: pol}, out))
+
+		assert.True(t, proto.Equal(durationpb.New(time.Second), out.GetAccessLogOptions().GetAccessLogFlushInterval()))
+		assert.Empty(t, out.GetAccessLog())
+
+		router := &routerv3.Router{}
+		require.NoError(t, out.GetHttpFilters()[1].GetTypedConfig().UnmarshalTo(router))
+		expected := &routerv3.Router{
+			UpstreamLog: []*envoyaccesslog.AccessLog{fileLog},
+			UpstreamLogOptions: &routerv3.Router_UpstreamAccessLogOptions{
+				UpstreamLogFlushInterval: durationpb.New(time.Second),
+			},
+		}
+		assert.True(t, proto.Equal(expected, router), "expected %v, got %v", expected, router)
+	})
+
+	t.Run("upstream access logs require the router filter", func(t *testing.T) {
+		pol := &httpListenerPolicy{upstreamAccessLog: []*envoyaccesslog.AccessLog{fileLog}}
+		err := pass.ApplyHCM(ctx, &ir.HcmContext{Policy: pol}, &envoy_hcm.HttpConnectionManager{})
+		assert.Error(t, err)
+	})
+
+	t.Run("flush interval must be at least 1ms", func(t *testing.T) {
+		_, err := convertAccessLogFlushInterval(v1alpha1.HTTPListenerPolicySpec{
+			AccessLogFlushInterval: &metav1.Duration{Duration: time.Microsecond},
+		})
+		assert.Error(t, err)
+
+		interval, err := convertAccessLogFlushInterval(v1alpha1.HTTPListenerPolicySpec{
+			AccessLogFlushInterval: &metav1.Duration{Duration: 10 * time.Second},
+		})
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(durationpb.New(10*time.Second), interval))
+	})
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
 	envoyaccesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -12,16 +15,22 @@ import (
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	cel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/filters/cel/v3"
 	envoygrpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoyotel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
+	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoy_metadata_formatter "github.com/envoyproxy/go-control-plane/envoy/extensions/formatter/metadata/v3"
 	envoy_req_without_query "github.com/envoyproxy/go-control-plane/envoy/extensions/formatter/req_without_query/v3"
 	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/solo-io/go-utils/contextutils"
+	otelcommon "go.opentelemetry.io/proto/otlp/common/v1"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -31,44 +40,61 @@ import (
 	kwellknown "github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
-// convertAccessLogConfig transforms a list of AccessLog configurations into Envoy AccessLog configurations
+const otelAccessLogName = "envoy.access_loggers.open_telemetry"
+
+// convertAccessLogConfig transforms a list of AccessLog configurations of the policy into Envoy AccessLog configurations
 func convertAccessLogConfig(
 	ctx context.Context,
 	policy *v1alpha1.HTTPListenerPolicy,
+	configs []v1alpha1.AccessLog,
 	commoncol *common.CommonCollections,
 	krtctx krt.HandlerContext,
 	parentSrc ir.ObjectSource,
 ) ([]*envoyaccesslog.AccessLog, error) {
-	configs := policy.Spec.AccessLog
-
 	if configs != nil && len(configs) == 0 {
 		return nil, nil
 	}
 
-	grpcBackends := make(map[string]*ir.Upstream, len(policy.Spec.AccessLog))
+	grpcBackends := make(map[string]*ir.Upstream, len(configs))
 	for idx, log := range configs {
-		if log.GrpcService != nil && log.GrpcService.BackendRef != nil {
-			upstream, err := commoncol.Upstreams.GetUpstreamFromRef(krtctx, parentSrc, log.GrpcService.BackendRef.BackendObjectReference)
+		var (
+			logName    string
+			backendRef *gwv1.BackendRef
+		)
+		switch {
+		case log.GrpcService != nil:
+			logName, backendRef = log.GrpcService.LogName, log.GrpcService.BackendRef
+		case log.OpenTelemetry != nil:
+			logName, backendRef = log.OpenTelemetry.LogName, log.OpenTelemetry.BackendRef
+		}
+		if backendRef != nil {
+			upstream, err := commoncol.Upstreams.GetUpstreamFromRef(krtctx, parentSrc, backendRef.BackendObjectReference)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get upstream from ref: %s", err.Error())
 			}
-			grpcBackends[getLogId(log.GrpcService.LogName, idx)] = upstream
+			grpcBackends[getLogId(logName, idx)] = upstream
 		}
 	}
 
+	// the policy targets the gateway, in its namespace
+	gateway := types.NamespacedName{
+		Namespace: policy.Namespace,
+		Name:      string(policy.Spec.TargetRef.Name),
+	}
+
 	logger := contextutils.LoggerFrom(ctx).Desugar()
-	return translateAccessLogs(logger, configs, grpcBackends)
+	return translateAccessLogs(logger, configs, grpcBackends, gateway)
 }
 
 func getLogId(logName string, idx int) string {
 	return fmt.Sprintf("%s-%d", logName, idx)
 }
 
-func translateAccessLogs(logger *zap.Logger, configs []v1alpha1.AccessLog, grpcBackends map[string]*ir.Upstream) ([]*envoyaccesslog.AccessLog, error) {
+func translateAccessLogs(logger *zap.Logger, configs []v1alpha1.AccessLog, grpcBackends map[string]*ir.Upstream, gateway types.NamespacedName) ([]*envoyaccesslog.AccessLog, error) {
 	var results []*envoyaccesslog.AccessLog
 
 	for idx, logConfig := range configs {
-		accessLogCfg, err := translateAccessLog(logger, logConfig, grpcBackends, idx)
+		accessLogCfg, err := translateAccessLog(logger, logConfig, grpcBackends, idx, gateway)
 		if err != nil {
 			return nil, err
 		}
@@ -79,10 +105,16 @@ func translateAccessLogs(logger *zap.Logger, configs []v1alpha1.AccessLog, grpcB
 }
 
 // translateAccessLog creates an Envoy AccessLog configuration for a single log config
-func translateAccessLog(logger *zap.Logger, logConfig v1alpha1.AccessLog, grpcBackends map[string]*ir.Upstream, accessLogId int) (*envoyaccesslog.AccessLog, error) {
+func translateAccessLog(logger *zap.Logger, logConfig v1alpha1.AccessLog, grpcBackends map[string]*ir.Upstream, accessLogId int, gateway types.NamespacedName) (*envoyaccesslog.AccessLog, error) {
 	// Validate mutual exclusivity of sink types
-	if logConfig.FileSink != nil && logConfig.GrpcService != nil {
-		return nil, errors.New("access log config cannot have both file sink and grpc service")
+	sinks := 0
+	for _, set := range []bool{logConfig.FileSink != nil, logConfig.GrpcService != nil, logConfig.OpenTelemetry != nil} {
+		if set {
+			sinks++
+		}
+	}
+	if sinks > 1 {
+		return nil, errors.New("access log config cannot have more than one of file sink, grpc service and open telemetry")
 	}
 
 	var (
@@ -95,6 +127,8 @@ func translateAccessLog(logger *zap.Logger, logConfig v1alpha1.AccessLog, grpcBa
 		accessLogCfg, err = createFileAccessLog(logger, logConfig.FileSink)
 	case logConfig.GrpcService != nil:
 		accessLogCfg, err = createGrpcAccessLog(logger, logConfig.GrpcService, grpcBackends, accessLogId)
+	case logConfig.OpenTelemetry != nil:
+		accessLogCfg, err = createOtelAccessLog(logger, logConfig.OpenTelemetry, grpcBackends, accessLogId, gateway)
 	default:
 		return nil, errors.New("no access log sink specified")
 	}
@@ -167,6 +201,44 @@ func createGrpcAccessLog(logger *zap.Logger, grpcService *v1alpha1.GrpcService,
 	return newAccessLogWithConfig(wellknown.HTTPGRPCAccessLog, &cfg)
 }
 
+// createOtelAccessLog generates an OpenTelemetry access log configuration
+func createOtelAccessLog(logger *zap.Logger, otelService *v1alpha1.OpenTelemetryAccessLogService, grpcBackends map[string]*ir.Upstream, accessLogId int, gateway types.NamespacedName) (*envoyaccesslog.AccessLog, error) {
+	commonConfig, err := commonGrpcConfig(otelService.LogName, grpcBackends, accessLogId)
+	if err != nil {
+		wrappedErr := fmt.Errorf("error converting open telemetry access log config: %s", err.Error())
+		logger.Error(wrappedErr.Error())
+		return nil, wrappedErr
+	}
+
+	formatterExtensions, err := getFormatterExtensions()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &envoyotel.OpenTelemetryAccessLogConfig{
+		CommonConfig:         commonConfig,
+		DisableBuiltinLabels: otelService.DisableBuiltinLabels,
+		ResourceAttributes: &otelcommon.KeyValueList{
+			Values: []*otelcommon.KeyValue{
+				otelStringAttribute("k8s.gateway.name", gateway.Name),
+				otelStringAttribute("k8s.namespace.name", gateway.Namespace),
+			},
+		},
+		Attributes: toOtelKeyValueList(convertJsonFormat(otelService.Attributes)),
+		Formatters: formatterExtensions,
+	}
+	if otelService.Body != "" {
+		cfg.Body = &otelcommon.AnyValue{
+			Value: &otelcommon.AnyValue_StringValue{StringValue: otelService.Body},
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return newAccessLogWithConfig(otelAccessLogName, cfg)
+}
+
 // addAccessLogFilter adds filtering logic to an access log configuration
 func addAccessLogFilter(logger *zap.Logger, accessLogCfg *envoyaccesslog.AccessLog, filter *v1alpha1.AccessLogFilter) error {
 	var (
@@ -389,31 +461,84 @@ func copyGrpcSettings(cfg *envoygrpc.HttpGrpcAccessLogConfig, grpcService *v1alp
 		return errors.New("grpc service object cannot be nil")
 	}
 
-	if grpcService.LogName == "" {
-		return errors.New("grpc service log name cannot be empty")
+	commonConfig, err := commonGrpcConfig(grpcService.LogName, grpcBackends, accessLogId)
+	if err != nil {
+		return err
+	}
+	cfg.AdditionalRequestHeadersToLog = grpcService.AdditionalRequestHeadersToLog
+	cfg.AdditionalResponseHeadersToLog = grpcService.AdditionalResponseHeadersToLog
+	cfg.AdditionalResponseTrailersToLog = grpcService.AdditionalResponseTrailersToLog
+	cfg.CommonConfig = commonConfig
+	return cfg.Validate()
+}
+
+// commonGrpcConfig returns the settings of the log stream to the cluster of the log's backend.
+func commonGrpcConfig(logName string, grpcBackends map[string]*ir.Upstream, accessLogId int) (*envoygrpc.CommonGrpcAccessLogConfig, error) {
+	if logName == "" {
+		return nil, errors.New("grpc service log name cannot be empty")
 	}
 
-	upstream := grpcBackends[getLogId(grpcService.LogName, accessLogId)]
+	upstream := grpcBackends[getLogId(logName, accessLogId)]
 	if upstream == nil {
-		return errors.New("upstream backend ref not found")
+		return nil, errors.New("upstream backend ref not found")
 	}
 
-	svc := &envoycore.GrpcService{
-		TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
-			EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
-				ClusterName: upstream.GetName(),
+	return &envoygrpc.CommonGrpcAccessLogConfig{
+		LogName: logName,
+		GrpcService: &envoycore.GrpcService{
+			TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
+					ClusterName: upstream.ClusterName(),
+				},
 			},
 		},
-	}
-	cfg.AdditionalRequestHeadersToLog = grpcService.AdditionalRequestHeadersToLog
-	cfg.AdditionalResponseHeadersToLog = grpcService.AdditionalResponseHeadersToLog
-	cfg.AdditionalResponseTrailersToLog = grpcService.AdditionalResponseTrailersToLog
-	cfg.CommonConfig = &envoygrpc.CommonGrpcAccessLogConfig{
-		LogName:             grpcService.LogName,
-		GrpcService:         svc,
 		TransportApiVersion: envoycore.ApiVersion_V3,
+	}, nil
+}
+
+func otelStringAttribute(key, value string) *otelcommon.KeyValue {
+	return &otelcommon.KeyValue{
+		Key: key,
+		Value: &otelcommon.AnyValue{
+			Value: &otelcommon.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
+
+// toOtelKeyValueList converts a format dictionary to OpenTelemetry attributes, sorted by name.
+func toOtelKeyValueList(in *structpb.Struct) *otelcommon.KeyValueList {
+	if in == nil {
+		return nil
+	}
+	out := &otelcommon.KeyValueList{}
+	for _, key := range slices.Sorted(maps.Keys(in.GetFields())) {
+		out.Values = append(out.GetValues(), &otelcommon.KeyValue{
+			Key:   key,
+			Value: toOtelAnyValue(in.GetFields()[key]),
+		})
+	}
+	return out
+}
+
+func toOtelAnyValue(in *structpb.Value) *otelcommon.AnyValue {
+	switch v := in.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return &otelcommon.AnyValue{Value: &otelcommon.AnyValue_StringValue{StringValue: v.StringValue}}
+	case *structpb.Value_NumberValue:
+		return &otelcommon.AnyValue{Value: &otelcommon.AnyValue_DoubleValue{DoubleValue: v.NumberValue}}
+	case *structpb.Value_BoolValue:
+		return &otelcommon.AnyValue{Value: &otelcommon.AnyValue_BoolValue{BoolValue: v.BoolValue}}
+	case *structpb.Value_StructValue:
+		return &otelcommon.AnyValue{Value: &otelcommon.AnyValue_KvlistValue{KvlistValue: toOtelKeyValueList(v.StructValue)}}
+	case *structpb.Value_ListValue:
+		values := &otelcommon.ArrayValue{}
+		for _, item := range v.ListValue.GetValues() {
+			values.Values = append(values.GetValues(), toOtelAnyValue(item))
+		}
+		return &otelcommon.AnyValue{Value: &otelcommon.AnyValue_ArrayValue{ArrayValue: values}}
+	default:
+		return &otelcommon.AnyValue{}
 	}
-	return cfg.Validate()
 }
 
 func getFormatterExtensions() ([]*envoycore.TypedExtensionConfig, error) {
@@ -515,3 +640,48 @@ func toEnvoyGRPCStatusType(grpcStatus v1alpha1.GrpcStatus) (envoyaccesslog.GrpcS
 		return 0, fmt.Errorf("unknown GRPCStatus (%s)", grpcStatus)
 	}
 }
+
+// convertAccessLogFlushInterval returns the interval of the periodic flushes of the access logs,
+// which envoy requires to be at least 1ms.
+func convertAccessLogFlushInterval(spec v1alpha1.HTTPListenerPolicySpec) (*durationpb.Duration, error) {
+	if spec.AccessLogFlushInterval == nil {
+		return nil, nil
+	}
+	if spec.AccessLogFlushInterval.Duration < time.Millisecond {
+		return nil, fmt.Errorf("access log flush interval must be at least 1ms, got %s", spec.AccessLogFlushInterval.Duration)
+	}
+	return durationpb.New(spec.AccessLogFlushInterval.Duration), nil
+}
+
+// applyUpstreamAccessLogs adds the upstream access logs to the router filter of the filter chain.
+func applyUpstreamAccessLogs(filters []*envoy_hcm.HttpFilter, upstreamLogs []*envoyaccesslog.AccessLog, flushInterval *durationpb.Duration) error {
+	if len(upstreamLogs) == 0 && flushInterval == nil {
+		return nil
+	}
+
+	idx := slices.IndexFunc(filters, func(f *envoy_hcm.HttpFilter) bool {
+		return f.GetName() == wellknown.Router
+	})
+	if idx < 0 {
+		return errors.New("router filter not found, cannot configure upstream access logs")
+	}
+
+	router := &routerv3.Router{}
+	if err := filters[idx].GetTypedConfig().UnmarshalTo(router); err != nil {
+		return fmt.Errorf("failed to unmarshal router filter: %w", err)
+	}
+	router.UpstreamLog = append(router.GetUpstreamLog(), upstreamLogs...)
+	if flushInterval != nil {
+		if router.GetUpstreamLogOptions() == nil {
+			router.UpstreamLogOptions = &routerv3.Router_UpstreamAccessLogOptions{}
+		}
+		router.GetUpstreamLogOptions().UpstreamLogFlushInterval = flushInterval
+	}
+
+	typedConfig, err := utils.MessageToAny(router)
+	if err != nil {
+		return err
+	}
+	filters[idx].ConfigType = &envoy_hcm.HttpFilter_TypedConfig{TypedConfig: typedConfig}
+	return nil
+}
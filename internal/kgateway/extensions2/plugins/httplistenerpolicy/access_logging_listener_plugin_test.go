@@ -10,16 +10,19 @@ import (
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	cel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/filters/cel/v3"
 	envoygrpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoyotel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
 	envoy_metadata_formatter "github.com/envoyproxy/go-control-plane/envoy/extensions/formatter/metadata/v3"
 	envoy_req_without_query "github.com/envoyproxy/go-control-plane/envoy/extensions/formatter/req_without_query/v3"
 	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	otelcommon "go.opentelemetry.io/proto/otlp/common/v1"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -135,7 +138,7 @@ func TestConvertJsonFormat_EdgeCases(t *testing.T) {
 									GrpcService: &envoycore.GrpcService{
 										TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
 											EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
-												ClusterName: "test-service",
+												ClusterName: "kube_default_test-service_50051",
 											},
 										},
 									},
@@ -293,7 +296,7 @@ func TestConvertJsonFormat_EdgeCases(t *testing.T) {
 									GrpcService: &envoycore.GrpcService{
 										TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
 											EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
-												ClusterName: "test-service",
+												ClusterName: "kube_default_test-service_50051",
 											},
 										},
 									},
@@ -304,6 +307,74 @@ func TestConvertJsonFormat_EdgeCases(t *testing.T) {
 					},
 				},
 			},
+			{
+				name: "OpenTelemetrySink",
+				config: []v1alpha1.AccessLog{
+					{
+						OpenTelemetry: &v1alpha1.OpenTelemetryAccessLogService{
+							BackendRef: &gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name: "otel-collector",
+								},
+							},
+							LogName: "otel-log",
+							Body:    "%REQ(:METHOD)% %REQ(:PATH)% %RESPONSE_CODE%",
+							Attributes: &runtime.RawExtension{
+								Raw: []byte(`{"method": "%REQ(:METHOD)%", "upstream": {"host": "%UPSTREAM_HOST%"}}`),
+							},
+						},
+					},
+				},
+				expected: []*v33.AccessLog{
+					{
+						Name: "envoy.access_loggers.open_telemetry",
+						ConfigType: &v33.AccessLog_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &envoyotel.OpenTelemetryAccessLogConfig{
+								CommonConfig: &envoygrpc.CommonGrpcAccessLogConfig{
+									LogName: "otel-log",
+									GrpcService: &envoycore.GrpcService{
+										TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+											EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
+												ClusterName: "kube_default_otel-collector_4317",
+											},
+										},
+									},
+									TransportApiVersion: envoycore.ApiVersion_V3,
+								},
+								ResourceAttributes: &otelcommon.KeyValueList{
+									Values: []*otelcommon.KeyValue{
+										{Key: "k8s.gateway.name", Value: &otelcommon.AnyValue{Value: &otelcommon.AnyValue_StringValue{StringValue: "gw"}}},
+										{Key: "k8s.namespace.name", Value: &otelcommon.AnyValue{Value: &otelcommon.AnyValue_StringValue{StringValue: "default"}}},
+									},
+								},
+								Body: &otelcommon.AnyValue{
+									Value: &otelcommon.AnyValue_StringValue{StringValue: "%REQ(:METHOD)% %REQ(:PATH)% %RESPONSE_CODE%"},
+								},
+								Attributes: &otelcommon.KeyValueList{
+									Values: []*otelcommon.KeyValue{
+										{Key: "method", Value: &otelcommon.AnyValue{Value: &otelcommon.AnyValue_StringValue{StringValue: "%REQ(:METHOD)%"}}},
+										{Key: "upstream", Value: &otelcommon.AnyValue{Value: &otelcommon.AnyValue_KvlistValue{KvlistValue: &otelcommon.KeyValueList{
+											Values: []*otelcommon.KeyValue{
+												{Key: "host", Value: &otelcommon.AnyValue{Value: &otelcommon.AnyValue_StringValue{StringValue: "%UPSTREAM_HOST%"}}},
+											},
+										}}}},
+									},
+								},
+								Formatters: []*envoycore.TypedExtensionConfig{
+									{
+										Name:        "envoy.formatter.req_without_query",
+										TypedConfig: mustMessageToAny(t, &envoy_req_without_query.ReqWithoutQuery{}),
+									},
+									{
+										Name:        "envoy.formatter.metadata",
+										TypedConfig: mustMessageToAny(t, &envoy_metadata_formatter.Metadata{}),
+									},
+								},
+							}),
+						},
+					},
+				},
+			},
 			{
 				name: "AccessLogWithStatusCodeFilter",
 				config: []v1alpha1.AccessLog{
@@ -636,11 +707,24 @@ func TestConvertJsonFormat_EdgeCases(t *testing.T) {
 					map[string]*ir.Upstream{
 						"grpc-log-0": {
 							ObjectSource: ir.ObjectSource{
+								Kind:      "Service",
 								Name:      "test-service",
 								Namespace: "default",
 							},
+							GvPrefix: "kube",
+							Port:     50051,
+						},
+						"otel-log-0": {
+							ObjectSource: ir.ObjectSource{
+								Kind:      "Service",
+								Name:      "otel-collector",
+								Namespace: "default",
+							},
+							GvPrefix: "kube",
+							Port:     4317,
 						},
 					},
+					types.NamespacedName{Namespace: "default", Name: "gw"},
 				)
 				require.NoError(t, err, "failed to convert access log config")
 				// Perform deep equality check
@@ -0,0 +1,120 @@
+package httplistenerpolicy
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoybrotli "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoygzip "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoyzstd "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+const (
+	GzipCompressorFilterName   = "envoy.filters.http.compressor.gzip"
+	BrotliCompressorFilterName = "envoy.filters.http.compressor.brotli"
+	ZstdCompressorFilterName   = "envoy.filters.http.compressor.zstd"
+)
+
+// CompressorFilterNames are the names of the compressor filters, whose per-route config disables
+// compression on routes.
+var CompressorFilterNames = []string{
+	GzipCompressorFilterName,
+	BrotliCompressorFilterName,
+	ZstdCompressorFilterName,
+}
+
+// compressor is a compressor filter of the filter chain.
+type compressor struct {
+	filterName string
+	config     *envoycompressor.Compressor
+}
+
+func compressorsEqual(a, b []compressor) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].filterName != b[i].filterName || !proto.Equal(a[i].config, b[i].config) {
+			return false
+		}
+	}
+	return true
+}
+
+// convertCompression returns the compressor filters of the policy. The deprecated compress field
+// enables gzip with the default settings.
+func convertCompression(spec v1alpha1.HTTPListenerPolicySpec) ([]compressor, error) {
+	in := spec.Compression
+	if in == nil {
+		if !spec.Compress {
+			return nil, nil
+		}
+		in = &v1alpha1.Compression{Gzip: &v1alpha1.GzipCompressor{}}
+	}
+
+	var out []compressor
+	add := func(filterName, libraryName string, library proto.Message) error {
+		typedLibrary, err := anypb.New(library)
+		if err != nil {
+			return err
+		}
+		out = append(out, compressor{
+			filterName: filterName,
+			config: &envoycompressor.Compressor{
+				CompressorLibrary: &envoy_config_core_v3.TypedExtensionConfig{
+					Name:        libraryName,
+					TypedConfig: typedLibrary,
+				},
+				ResponseDirectionConfig: responseDirectionConfig(in),
+			},
+		})
+		return nil
+	}
+
+	if in.Gzip != nil {
+		gzip := &envoygzip.Gzip{}
+		if in.Gzip.Level != nil {
+			gzip.CompressionLevel = envoygzip.Gzip_CompressionLevel(*in.Gzip.Level)
+		}
+		if err := add(GzipCompressorFilterName, "envoy.compression.gzip.compressor", gzip); err != nil {
+			return nil, err
+		}
+	}
+	if in.Brotli != nil {
+		brotli := &envoybrotli.Brotli{}
+		if in.Brotli.Quality != nil {
+			brotli.Quality = wrapperspb.UInt32(*in.Brotli.Quality)
+		}
+		if err := add(BrotliCompressorFilterName, "envoy.compression.brotli.compressor", brotli); err != nil {
+			return nil, err
+		}
+	}
+	if in.Zstd != nil {
+		zstd := &envoyzstd.Zstd{}
+		if in.Zstd.Level != nil {
+			zstd.CompressionLevel = wrapperspb.UInt32(*in.Zstd.Level)
+		}
+		if err := add(ZstdCompressorFilterName, "envoy.compression.zstd.compressor", zstd); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func responseDirectionConfig(in *v1alpha1.Compression) *envoycompressor.Compressor_ResponseDirectionConfig {
+	out := &envoycompressor.Compressor_ResponseDirectionConfig{
+		CommonConfig: &envoycompressor.Compressor_CommonDirectionConfig{
+			ContentType: in.ContentTypes,
+		},
+		DisableOnEtagHeader:        in.DisableOnEtagHeader,
+		RemoveAcceptEncodingHeader: in.RemoveAcceptEncodingHeader,
+	}
+	if in.MinContentLength != nil {
+		out.GetCommonConfig().MinContentLength = wrapperspb.UInt32(*in.MinContentLength)
+	}
+	return out
+}
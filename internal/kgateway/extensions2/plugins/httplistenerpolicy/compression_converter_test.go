@@ -0,0 +1,106 @@
+package httplistenerpolicy
+
+import (
+	"context"
+	"testing"
+
+	envoybrotli "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoygzip "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoyzstd "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestConvertCompression(t *testing.T) {
+	t.Run("configures the compressors", func(t *testing.T) {
+		compressors, err := convertCompression(v1alpha1.HTTPListenerPolicySpec{
+			Compression: &v1alpha1.Compression{
+				Gzip:                       &v1alpha1.GzipCompressor{Level: ptr.To[uint32](9)},
+				Brotli:                     &v1alpha1.BrotliCompressor{Quality: ptr.To[uint32](5)},
+				Zstd:                       &v1alpha1.ZstdCompressor{Level: ptr.To[uint32](10)},
+				MinContentLength:           ptr.To[uint32](1024),
+				ContentTypes:               []string{"application/json"},
+				RemoveAcceptEncodingHeader: true,
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, compressors, 3)
+
+		expectedResponseConfig := &envoycompressor.Compressor_ResponseDirectionConfig{
+			CommonConfig: &envoycompressor.Compressor_CommonDirectionConfig{
+				MinContentLength: wrapperspb.UInt32(1024),
+				ContentType:      []string{"application/json"},
+			},
+			RemoveAcceptEncodingHeader: true,
+		}
+		expected := []struct {
+			filterName  string
+			libraryName string
+			library     proto.Message
+			config      proto.Message
+		}{
+			{GzipCompressorFilterName, "envoy.compression.gzip.compressor", &envoygzip.Gzip{}, &envoygzip.Gzip{CompressionLevel: envoygzip.Gzip_BEST_COMPRESSION}},
+			{BrotliCompressorFilterName, "envoy.compression.brotli.compressor", &envoybrotli.Brotli{}, &envoybrotli.Brotli{Quality: wrapperspb.UInt32(5)}},
+			{ZstdCompressorFilterName, "envoy.compression.zstd.compressor", &envoyzstd.Zstd{}, &envoyzstd.Zstd{CompressionLevel: wrapperspb.UInt32(10)}},
+		}
+		for i, e := range expected {
+			c := compressors[i]
+			assert.Equal(t, e.filterName, c.filterName)
+			assert.True(t, proto.Equal(expectedResponseConfig, c.config.GetResponseDirectionConfig()))
+
+			library := c.config.GetCompressorLibrary()
+			assert.Equal(t, e.libraryName, library.GetName())
+			require.NoError(t, library.GetTypedConfig().UnmarshalTo(e.library))
+			assert.True(t, proto.Equal(e.config, e.library), "expected %v, got %v", e.config, e.library)
+		}
+	})
+
+	t.Run("compress enables gzip", func(t *testing.T) {
+		compressors, err := convertCompression(v1alpha1.HTTPListenerPolicySpec{Compress: true})
+		require.NoError(t, err)
+		require.Len(t, compressors, 1)
+		assert.Equal(t, GzipCompressorFilterName, compressors[0].filterName)
+	})
+
+	t.Run("no compression", func(t *testing.T) {
+		compressors, err := convertCompression(v1alpha1.HTTPListenerPolicySpec{})
+		require.NoError(t, err)
+		assert.Empty(t, compressors)
+	})
+}
+
+func TestCompressionFilters(t *testing.T) {
+	older, err := convertCompression(v1alpha1.HTTPListenerPolicySpec{
+		Compression: &v1alpha1.Compression{Gzip: &v1alpha1.GzipCompressor{}},
+	})
+	require.NoError(t, err)
+	newer, err := convertCompression(v1alpha1.HTTPListenerPolicySpec{
+		Compression: &v1alpha1.Compression{Brotli: &v1alpha1.BrotliCompressor{}},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+	for _, pol := range []*httpListenerPolicy{{compressors: older}, {compressors: newer}, {}} {
+		pass.ApplyVhostPlugin(ctx, &ir.VirtualHostContext{FilterChainName: "listener~80", Policy: pol}, nil)
+	}
+
+	// the newest policy configuring compression takes precedence
+	filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, BrotliCompressorFilterName, filters[0].Filter.GetName())
+	assert.False(t, filters[0].Filter.GetDisabled())
+
+	// compression is only added to the filter chains of the policies
+	filters, err = pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~8080"})
+	require.NoError(t, err)
+	assert.Empty(t, filters)
+}
@@ -0,0 +1,182 @@
+package httplistenerpolicy
+
+import (
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+)
+
+const preserveCaseFormatterName = "envoy.http.stateful_header_formatters.preserve_case"
+
+// hcmSettings holds the HttpConnectionManager settings of a policy. Unset settings are nil, so
+// that they do not override the ones set by other policies.
+type hcmSettings struct {
+	xffNumTrustedHops          *uint32
+	useRemoteAddress           *bool
+	serverHeaderTransformation *envoy_hcm.HttpConnectionManager_ServerHeaderTransformation
+	serverName                 *string
+	maxRequestHeadersKb        *uint32
+	streamIdleTimeout          *time.Duration
+	idleTimeout                *time.Duration
+	requestTimeout             *time.Duration
+	normalizePath              *bool
+	mergeSlashes               *bool
+	preserveExternalRequestId  *bool
+	http1Options               *envoy_config_core_v3.Http1ProtocolOptions
+	http2Options               *envoy_config_core_v3.Http2ProtocolOptions
+}
+
+func (s hcmSettings) Equals(s2 hcmSettings) bool {
+	return ptr.Equal(s.xffNumTrustedHops, s2.xffNumTrustedHops) &&
+		ptr.Equal(s.useRemoteAddress, s2.useRemoteAddress) &&
+		ptr.Equal(s.serverHeaderTransformation, s2.serverHeaderTransformation) &&
+		ptr.Equal(s.serverName, s2.serverName) &&
+		ptr.Equal(s.maxRequestHeadersKb, s2.maxRequestHeadersKb) &&
+		ptr.Equal(s.streamIdleTimeout, s2.streamIdleTimeout) &&
+		ptr.Equal(s.idleTimeout, s2.idleTimeout) &&
+		ptr.Equal(s.requestTimeout, s2.requestTimeout) &&
+		ptr.Equal(s.normalizePath, s2.normalizePath) &&
+		ptr.Equal(s.mergeSlashes, s2.mergeSlashes) &&
+		ptr.Equal(s.preserveExternalRequestId, s2.preserveExternalRequestId) &&
+		proto.Equal(s.http1Options, s2.http1Options) &&
+		proto.Equal(s.http2Options, s2.http2Options)
+}
+
+func convertHcmSettings(spec v1alpha1.HTTPListenerPolicySpec) (hcmSettings, error) {
+	out := hcmSettings{
+		xffNumTrustedHops:         spec.XffNumTrustedHops,
+		useRemoteAddress:          spec.UseRemoteAddress,
+		maxRequestHeadersKb:       spec.MaxRequestHeadersKb,
+		streamIdleTimeout:         duration(spec.StreamIdleTimeout),
+		idleTimeout:               duration(spec.IdleTimeout),
+		requestTimeout:            duration(spec.RequestTimeout),
+		normalizePath:             spec.NormalizePath,
+		mergeSlashes:              spec.MergeSlashes,
+		preserveExternalRequestId: spec.PreserveExternalRequestId,
+		http2Options:              pluginutils.ToEnvoyHttp2ProtocolOptions(spec.Http2ProtocolOptions),
+	}
+	if spec.ServerName != "" {
+		out.serverName = ptr.To(spec.ServerName)
+	}
+	if spec.ServerHeaderTransformation != nil {
+		out.serverHeaderTransformation = ptr.To(convertServerHeaderTransformation(*spec.ServerHeaderTransformation))
+	}
+
+	http1Options, err := convertHttp1ProtocolOptions(spec.Http1ProtocolOptions)
+	if err != nil {
+		return out, err
+	}
+	out.http1Options = http1Options
+	return out, nil
+}
+
+func convertServerHeaderTransformation(in v1alpha1.ServerHeaderTransformation) envoy_hcm.HttpConnectionManager_ServerHeaderTransformation {
+	switch in {
+	case v1alpha1.AppendIfAbsentServerHeader:
+		return envoy_hcm.HttpConnectionManager_APPEND_IF_ABSENT
+	case v1alpha1.PassThroughServerHeader:
+		return envoy_hcm.HttpConnectionManager_PASS_THROUGH
+	default:
+		return envoy_hcm.HttpConnectionManager_OVERWRITE
+	}
+}
+
+func convertHttp1ProtocolOptions(in *v1alpha1.Http1ProtocolOptions) (*envoy_config_core_v3.Http1ProtocolOptions, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &envoy_config_core_v3.Http1ProtocolOptions{
+		AcceptHttp_10:         in.AcceptHttp10,
+		DefaultHostForHttp_10: in.DefaultHostForHttp10,
+	}
+	if in.HeaderFormat == nil {
+		return out, nil
+	}
+
+	switch *in.HeaderFormat {
+	case v1alpha1.ProperCaseHeaderFormat:
+		out.HeaderKeyFormat = &envoy_config_core_v3.Http1ProtocolOptions_HeaderKeyFormat{
+			HeaderFormat: &envoy_config_core_v3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords_{
+				ProperCaseWords: &envoy_config_core_v3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords{},
+			},
+		}
+	case v1alpha1.PreserveCaseHeaderFormat:
+		formatter, err := anypb.New(&preserve_case_v3.PreserveCaseFormatterConfig{})
+		if err != nil {
+			return nil, err
+		}
+		out.HeaderKeyFormat = &envoy_config_core_v3.Http1ProtocolOptions_HeaderKeyFormat{
+			HeaderFormat: &envoy_config_core_v3.Http1ProtocolOptions_HeaderKeyFormat_StatefulFormatter{
+				StatefulFormatter: &envoy_config_core_v3.TypedExtensionConfig{
+					Name:        preserveCaseFormatterName,
+					TypedConfig: formatter,
+				},
+			},
+		}
+	}
+	return out, nil
+}
+
+// applyHcmSettings sets the fields of the HttpConnectionManager that are set in the settings.
+func applyHcmSettings(s hcmSettings, out *envoy_hcm.HttpConnectionManager) {
+	if s.xffNumTrustedHops != nil {
+		out.XffNumTrustedHops = *s.xffNumTrustedHops
+	}
+	if s.useRemoteAddress != nil {
+		out.UseRemoteAddress = wrapperspb.Bool(*s.useRemoteAddress)
+	}
+	if s.serverHeaderTransformation != nil {
+		out.ServerHeaderTransformation = *s.serverHeaderTransformation
+	}
+	if s.serverName != nil {
+		out.ServerName = *s.serverName
+	}
+	if s.maxRequestHeadersKb != nil {
+		out.MaxRequestHeadersKb = wrapperspb.UInt32(*s.maxRequestHeadersKb)
+	}
+	if s.streamIdleTimeout != nil {
+		out.StreamIdleTimeout = durationpb.New(*s.streamIdleTimeout)
+	}
+	if s.idleTimeout != nil {
+		if out.GetCommonHttpProtocolOptions() == nil {
+			out.CommonHttpProtocolOptions = &envoy_config_core_v3.HttpProtocolOptions{}
+		}
+		out.GetCommonHttpProtocolOptions().IdleTimeout = durationpb.New(*s.idleTimeout)
+	}
+	if s.requestTimeout != nil {
+		out.RequestTimeout = durationpb.New(*s.requestTimeout)
+	}
+	if s.normalizePath != nil {
+		out.NormalizePath = wrapperspb.Bool(*s.normalizePath)
+	}
+	if s.mergeSlashes != nil {
+		out.MergeSlashes = *s.mergeSlashes
+	}
+	if s.preserveExternalRequestId != nil {
+		out.PreserveExternalRequestId = *s.preserveExternalRequestId
+	}
+	if s.http1Options != nil {
+		out.HttpProtocolOptions = proto.Clone(s.http1Options).(*envoy_config_core_v3.Http1ProtocolOptions)
+	}
+	if s.http2Options != nil {
+		out.Http2ProtocolOptions = proto.Clone(s.http2Options).(*envoy_config_core_v3.Http2ProtocolOptions)
+	}
+}
+
+func duration(in *metav1.Duration) *time.Duration {
+	if in == nil {
+		return nil
+	}
+	return ptr.To(in.Duration)
+}
@@ -0,0 +1,138 @@
+package httplistenerpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestApplyHcmSettings(t *testing.T) {
+	t.Run("sets the configured fields", func(t *testing.T) {
+		settings, err := convertHcmSettings(v1alpha1.HTTPListenerPolicySpec{
+			XffNumTrustedHops:          ptr.To[uint32](2),
+			UseRemoteAddress:           ptr.To(false),
+			ServerHeaderTransformation: ptr.To(v1alpha1.PassThroughServerHeader),
+			ServerName:                 "gateway",
+			MaxRequestHeadersKb:        ptr.To[uint32](96),
+			StreamIdleTimeout:          &metav1.Duration{Duration: time.Minute},
+			IdleTimeout:                &metav1.Duration{Duration: 10 * time.Minute},
+			RequestTimeout:             &metav1.Duration{Duration: 30 * time.Second},
+			NormalizePath:              ptr.To(false),
+			MergeSlashes:               ptr.To(false),
+			Http1ProtocolOptions: &v1alpha1.Http1ProtocolOptions{
+				AcceptHttp10:         true,
+				DefaultHostForHttp10: "example.com",
+				HeaderFormat:         ptr.To(v1alpha1.ProperCaseHeaderFormat),
+			},
+			Http2ProtocolOptions: &v1alpha1.Http2ProtocolOptions{
+				MaxConcurrentStreams: ptr.To[uint32](100),
+			},
+			PreserveExternalRequestId: ptr.To(true),
+		})
+		require.NoError(t, err)
+
+		out := &envoy_hcm.HttpConnectionManager{
+			StatPrefix:       "http",
+			NormalizePath:    wrapperspb.Bool(true),
+			MergeSlashes:     true,
+			UseRemoteAddress: wrapperspb.Bool(true),
+		}
+		applyHcmSettings(settings, out)
+
+		expected := &envoy_hcm.HttpConnectionManager{
+			StatPrefix:                 "http",
+			XffNumTrustedHops:          2,
+			UseRemoteAddress:           wrapperspb.Bool(false),
+			ServerHeaderTransformation: envoy_hcm.HttpConnectionManager_PASS_THROUGH,
+			ServerName:                 "gateway",
+			MaxRequestHeadersKb:        wrapperspb.UInt32(96),
+			StreamIdleTimeout:          durationpb.New(time.Minute),
+			CommonHttpProtocolOptions: &envoy_config_core_v3.HttpProtocolOptions{
+				IdleTimeout: durationpb.New(10 * time.Minute),
+			},
+			RequestTimeout: durationpb.New(30 * time.Second),
+			NormalizePath:  wrapperspb.Bool(false),
+			MergeSlashes:   false,
+			HttpProtocolOptions: &envoy_config_core_v3.Http1ProtocolOptions{
+				AcceptHttp_10:         true,
+				DefaultHostForHttp_10: "example.com",
+				HeaderKeyFormat: &envoy_config_core_v3.Http1ProtocolOptions_HeaderKeyFormat{
+					HeaderFormat: &envoy_config_core_v3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords_{
+						ProperCaseWords: &envoy_config_core_v3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords{},
+					},
+				},
+			},
+			Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{
+				MaxConcurrentStreams: wrapperspb.UInt32(100),
+			},
+			PreserveExternalRequestId: true,
+		}
+		assert.True(t, proto.Equal(expected, out), "expected %v, got %v", expected, out)
+	})
+
+	t.Run("preserves header case", func(t *testing.T) {
+		settings, err := convertHcmSettings(v1alpha1.HTTPListenerPolicySpec{
+			Http1ProtocolOptions: &v1alpha1.Http1ProtocolOptions{
+				HeaderFormat: ptr.To(v1alpha1.PreserveCaseHeaderFormat),
+			},
+		})
+		require.NoError(t, err)
+
+		formatter := settings.http1Options.GetHeaderKeyFormat().GetStatefulFormatter()
+		require.NotNil(t, formatter)
+		assert.Equal(t, preserveCaseFormatterName, formatter.GetName())
+		assert.True(t, formatter.GetTypedConfig().MessageIs(&preserve_case_v3.PreserveCaseFormatterConfig{}))
+	})
+
+	t.Run("newer policies override the fields they set", func(t *testing.T) {
+		older, err := convertHcmSettings(v1alpha1.HTTPListenerPolicySpec{
+			XffNumTrustedHops: ptr.To[uint32](1),
+			StreamIdleTimeout: &metav1.Duration{Duration: time.Minute},
+		})
+		require.NoError(t, err)
+		newer, err := convertHcmSettings(v1alpha1.HTTPListenerPolicySpec{
+			XffNumTrustedHops: ptr.To[uint32](3),
+		})
+		require.NoError(t, err)
+
+		out := &envoy_hcm.HttpConnectionManager{}
+		pass := &httpListenerPolicyPluginGwPass{}
+		for _, pol := range []*httpListenerPolicy{{hcm: older}, {hcm: newer}} {
+			require.NoError(t, pass.ApplyHCM(context.Background(), &ir.HcmContext{Policy: pol}, out))
+		}
+
+		assert.Equal(t, uint32(3), out.GetXffNumTrustedHops())
+		assert.True(t, proto.Equal(durationpb.New(time.Minute), out.GetStreamIdleTimeout()))
+	})
+
+	t.Run("compares settings", func(t *testing.T) {
+		spec := v1alpha1.HTTPListenerPolicySpec{
+			IdleTimeout:          &metav1.Duration{Duration: time.Minute},
+			Http2ProtocolOptions: &v1alpha1.Http2ProtocolOptions{MaxConcurrentStreams: ptr.To[uint32](10)},
+		}
+		a, err := convertHcmSettings(spec)
+		require.NoError(t, err)
+		b, err := convertHcmSettings(spec)
+		require.NoError(t, err)
+		assert.True(t, a.Equals(b))
+
+		spec.IdleTimeout = &metav1.Duration{Duration: time.Hour}
+		c, err := convertHcmSettings(spec)
+		require.NoError(t, err)
+		assert.False(t, a.Equals(c))
+	})
+}
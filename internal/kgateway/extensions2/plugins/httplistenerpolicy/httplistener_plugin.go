@@ -10,8 +10,10 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	"github.com/solo-io/go-utils/contextutils"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -25,9 +27,14 @@ import (
 )
 
 type httpListenerPolicy struct {
-	ct        time.Time
-	compress  bool
-	accessLog []*envoyaccesslog.AccessLog
+	ct                     time.Time
+	compressors            []compressor
+	accessLog              []*envoyaccesslog.AccessLog
+	tcpAccessLog           []*envoyaccesslog.AccessLog
+	upstreamAccessLog      []*envoyaccesslog.AccessLog
+	accessLogFlushInterval *durationpb.Duration
+	hcm                    hcmSettings
+	tracing                *envoy_hcm.HttpConnectionManager_Tracing
 }
 
 func (d *httpListenerPolicy) CreationTime() time.Time {
@@ -40,22 +47,49 @@ func (d *httpListenerPolicy) Equals(in any) bool {
 		return false
 	}
 
-	// Check the TargetRef and Compress fields
-	if d.compress != d2.compress {
+	if !compressorsEqual(d.compressors, d2.compressors) {
 		return false
 	}
 
-	// Check the AccessLog slice
-	if !slices.EqualFunc(d.accessLog, d2.accessLog, func(log *envoyaccesslog.AccessLog, log2 *envoyaccesslog.AccessLog) bool {
-		return proto.Equal(log, log2)
-	}) {
+	// Check the AccessLog slices
+	if !accessLogsEqual(d.accessLog, d2.accessLog) ||
+		!accessLogsEqual(d.tcpAccessLog, d2.tcpAccessLog) ||
+		!accessLogsEqual(d.upstreamAccessLog, d2.upstreamAccessLog) {
+		return false
+	}
+
+	if !proto.Equal(d.accessLogFlushInterval, d2.accessLogFlushInterval) {
+		return false
+	}
+
+	if !d.hcm.Equals(d2.hcm) {
+		return false
+	}
+
+	if !proto.Equal(d.tracing, d2.tracing) {
 		return false
 	}
 
 	return true
 }
 
+func accessLogsEqual(a, b []*envoyaccesslog.AccessLog) bool {
+	return slices.EqualFunc(a, b, func(log *envoyaccesslog.AccessLog, log2 *envoyaccesslog.AccessLog) bool {
+		return proto.Equal(log, log2)
+	})
+}
+
+// AppendedFields returns the access log fields: the access logs of all the policies are added to
+// the listener.
+func (d *httpListenerPolicy) AppendedFields() []string {
+	return []string{"accessLog", "tcpAccessLog", "upstreamAccessLog"}
+}
+
+var _ ir.AppendedFieldsPolicyIR = &httpListenerPolicy{}
+
 type httpListenerPolicyPluginGwPass struct {
+	// compressor filters of each filter chain
+	compressors map[string][]compressor
 }
 
 func (p *httpListenerPolicyPluginGwPass) ApplyListenerPlugin(ctx context.Context, pCtx *ir.ListenerContext, out *envoy_config_listener_v3.Listener) {
@@ -80,7 +114,43 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 		}
 
 		errors := []error{}
-		accessLog, err := convertAccessLogConfig(ctx, i, commoncol, krtctx, objSrc)
+		accessLog, err := convertAccessLogConfig(ctx, i, i.Spec.AccessLog, commoncol, krtctx, objSrc)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		tcpAccessLog, err := convertAccessLogConfig(ctx, i, i.Spec.TcpAccessLog, commoncol, krtctx, objSrc)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		upstreamAccessLog, err := convertAccessLogConfig(ctx, i, i.Spec.UpstreamAccessLog, commoncol, krtctx, objSrc)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		accessLogFlushInterval, err := convertAccessLogFlushInterval(i.Spec)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		compressors, err := convertCompression(i.Spec)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		hcm, err := convertHcmSettings(i.Spec)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		tracing, err := convertTracingConfig(i, commoncol, krtctx, objSrc)
 		if err != nil {
 			contextutils.LoggerFrom(ctx).Error(err)
 			errors = append(errors, err)
@@ -90,9 +160,14 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 			ObjectSource: objSrc,
 			Policy:       i,
 			PolicyIR: &httpListenerPolicy{
-				ct:        i.CreationTimestamp.Time,
-				compress:  i.Spec.Compress,
-				accessLog: accessLog,
+				ct:                     i.CreationTimestamp.Time,
+				compressors:            compressors,
+				accessLog:              accessLog,
+				tcpAccessLog:           tcpAccessLog,
+				upstreamAccessLog:      upstreamAccessLog,
+				accessLogFlushInterval: accessLogFlushInterval,
+				hcm:                    hcm,
+				tracing:                tracing,
 			},
 			TargetRefs: convert(i.Spec.TargetRef),
 			Errors:     errors,
@@ -121,7 +196,9 @@ func convert(targetRef v1alpha1.LocalPolicyTargetReference) []ir.PolicyTargetRef
 }
 
 func NewGatewayTranslationPass(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
-	return &httpListenerPolicyPluginGwPass{}
+	return &httpListenerPolicyPluginGwPass{
+		compressors: make(map[string][]compressor),
+	}
 }
 func (p *httpListenerPolicyPluginGwPass) Name() string {
 	return "httplistenerpolicies"
@@ -138,10 +215,55 @@ func (p *httpListenerPolicyPluginGwPass) ApplyHCM(
 
 	// translate access logging configuration
 	out.AccessLog = append(out.GetAccessLog(), policy.accessLog...)
+	if policy.accessLogFlushInterval != nil {
+		out.AccessLogOptions = &envoy_hcm.HttpConnectionManager_HcmAccessLogOptions{
+			AccessLogFlushInterval: policy.accessLogFlushInterval,
+		}
+	}
+	if err := applyUpstreamAccessLogs(out.GetHttpFilters(), policy.upstreamAccessLog, policy.accessLogFlushInterval); err != nil {
+		return err
+	}
+
+	applyHcmSettings(policy.hcm, out)
+
+	if policy.tracing != nil {
+		tracing, err := tracingForGateway(policy.tracing, pCtx.Gateway)
+		if err != nil {
+			return err
+		}
+		out.Tracing = tracing
+	}
 	return nil
 }
 
+func (p *httpListenerPolicyPluginGwPass) ApplyTcpProxy(
+	ctx context.Context,
+	pCtx *ir.TcpProxyContext,
+	out *envoytcp.TcpProxy) error {
+	policy, ok := pCtx.Policy.(*httpListenerPolicy)
+	if !ok {
+		return fmt.Errorf("internal error: expected httplistener policy, got %T", pCtx.Policy)
+	}
+
+	out.AccessLog = append(out.GetAccessLog(), policy.tcpAccessLog...)
+	if policy.accessLogFlushInterval != nil {
+		out.AccessLogOptions = &envoytcp.TcpProxy_TcpAccessLogOptions{
+			AccessLogFlushInterval: policy.accessLogFlushInterval,
+		}
+	}
+	return nil
+}
+
+// records the compressor filters of the filter chain; the newest policy that configures
+// compression takes precedence, as policies are applied from oldest to newest.
 func (p *httpListenerPolicyPluginGwPass) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
+	policy, ok := pCtx.Policy.(*httpListenerPolicy)
+	if !ok {
+		return
+	}
+	if len(policy.compressors) > 0 {
+		p.compressors[pCtx.FilterChainName] = policy.compressors
+	}
 }
 
 // called 0 or more times
@@ -161,7 +283,16 @@ func (p *httpListenerPolicyPluginGwPass) ApplyForRouteBackend(
 // if a plugin emits new filters, they must be with a plugin unique name.
 // any filter returned from listener config must be disabled, so it doesnt impact other listeners.
 func (p *httpListenerPolicyPluginGwPass) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
-	return nil, nil
+	var filters []plugins.StagedHttpFilter
+	for _, c := range p.compressors[fcc.FilterChainName] {
+		// compress responses before the other filters process them.
+		f, err := plugins.NewStagedFilter(c.filterName, c.config, plugins.BeforeStage(plugins.RouteStage))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
 }
 
 func (p *httpListenerPolicyPluginGwPass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
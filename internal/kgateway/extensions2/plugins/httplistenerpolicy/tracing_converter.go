@@ -0,0 +1,187 @@
+package httplistenerpolicy
+
+import (
+	"errors"
+	"fmt"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoytrace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytracing "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const (
+	openTelemetryTracerName        = "envoy.tracers.opentelemetry"
+	zipkinTracerName               = "envoy.tracers.zipkin"
+	defaultZipkinCollectorEndpoint = "/api/v2/spans"
+)
+
+// convertTracingConfig resolves the collector of the tracing provider and returns the tracing
+// configuration of the HttpConnectionManager.
+func convertTracingConfig(
+	policy *v1alpha1.HTTPListenerPolicy,
+	commoncol *common.CommonCollections,
+	krtctx krt.HandlerContext,
+	parentSrc ir.ObjectSource,
+) (*envoy_hcm.HttpConnectionManager_Tracing, error) {
+	tracing := policy.Spec.Tracing
+	if tracing == nil {
+		return nil, nil
+	}
+
+	var backendRef *gwv1.BackendRef
+	switch {
+	case tracing.OpenTelemetry != nil:
+		backendRef = tracing.OpenTelemetry.BackendRef
+	case tracing.Zipkin != nil:
+		backendRef = tracing.Zipkin.BackendRef
+	}
+	if backendRef == nil {
+		return nil, errors.New("tracing provider backend ref is required")
+	}
+	upstream, err := commoncol.Upstreams.GetUpstreamFromRef(krtctx, parentSrc, backendRef.BackendObjectReference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tracing collector upstream from ref: %s", err.Error())
+	}
+
+	return translateTracing(tracing, upstream.ClusterName())
+}
+
+// translateTracing creates the tracing configuration, sending the spans to the cluster of the collector.
+func translateTracing(tracing *v1alpha1.Tracing, collectorCluster string) (*envoy_hcm.HttpConnectionManager_Tracing, error) {
+	provider, err := translateTracingProvider(tracing, collectorCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &envoy_hcm.HttpConnectionManager_Tracing{
+		Provider:        provider,
+		ClientSampling:  tracingPercent(tracing.ClientSampling),
+		RandomSampling:  tracingPercent(tracing.RandomSampling),
+		OverallSampling: tracingPercent(tracing.OverallSampling),
+		CustomTags:      translateCustomTags(tracing.CustomTags),
+	}
+	if tracing.SpawnUpstreamSpan {
+		out.SpawnUpstreamSpan = wrapperspb.Bool(true)
+	}
+	return out, nil
+}
+
+func translateTracingProvider(tracing *v1alpha1.Tracing, collectorCluster string) (*envoytrace.Tracing_Http, error) {
+	var (
+		name   string
+		config proto.Message
+	)
+	switch {
+	case tracing.OpenTelemetry != nil:
+		name = openTelemetryTracerName
+		config = &envoytrace.OpenTelemetryConfig{
+			GrpcService: &envoycore.GrpcService{
+				TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
+						ClusterName: collectorCluster,
+					},
+				},
+			},
+			ServiceName: tracing.OpenTelemetry.ServiceName,
+		}
+	case tracing.Zipkin != nil:
+		endpoint := tracing.Zipkin.CollectorEndpoint
+		if endpoint == "" {
+			endpoint = defaultZipkinCollectorEndpoint
+		}
+		name = zipkinTracerName
+		config = &envoytrace.ZipkinConfig{
+			CollectorCluster:         collectorCluster,
+			CollectorEndpoint:        endpoint,
+			CollectorEndpointVersion: envoytrace.ZipkinConfig_HTTP_JSON,
+			TraceId_128Bit:           tracing.Zipkin.TraceId128Bit,
+		}
+	default:
+		return nil, errors.New("no tracing provider specified")
+	}
+
+	typedConfig, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoytrace.Tracing_Http{
+		Name: name,
+		ConfigType: &envoytrace.Tracing_Http_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
+
+// tracingForGateway returns the tracing configuration of the proxies of the gateway. The service name
+// of OpenTelemetry spans defaults to the envoy cluster of the proxies, <gateway>.<namespace>, which
+// is only known once the policy is applied to a gateway.
+func tracingForGateway(tracing *envoy_hcm.HttpConnectionManager_Tracing, gw *gwv1.Gateway) (*envoy_hcm.HttpConnectionManager_Tracing, error) {
+	provider := tracing.GetProvider()
+	if gw == nil || provider.GetName() != openTelemetryTracerName {
+		return tracing, nil
+	}
+	config := &envoytrace.OpenTelemetryConfig{}
+	if err := provider.GetTypedConfig().UnmarshalTo(config); err != nil {
+		return nil, err
+	}
+	if config.GetServiceName() != "" {
+		return tracing, nil
+	}
+	config.ServiceName = fmt.Sprintf("%s.%s", gw.GetName(), gw.GetNamespace())
+	typedConfig, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+	out := proto.Clone(tracing).(*envoy_hcm.HttpConnectionManager_Tracing)
+	out.Provider.ConfigType = &envoytrace.Tracing_Http_TypedConfig{TypedConfig: typedConfig}
+	return out, nil
+}
+
+func translateCustomTags(tags []v1alpha1.CustomTag) []*envoytracing.CustomTag {
+	var out []*envoytracing.CustomTag
+	for _, tag := range tags {
+		customTag := &envoytracing.CustomTag{Tag: tag.Tag}
+		switch {
+		case tag.Literal != nil:
+			customTag.Type = &envoytracing.CustomTag_Literal_{
+				Literal: &envoytracing.CustomTag_Literal{Value: *tag.Literal},
+			}
+		case tag.RequestHeader != nil:
+			customTag.Type = &envoytracing.CustomTag_RequestHeader{
+				RequestHeader: &envoytracing.CustomTag_Header{
+					Name:         tag.RequestHeader.Name,
+					DefaultValue: tag.RequestHeader.DefaultValue,
+				},
+			}
+		case tag.Environment != nil:
+			customTag.Type = &envoytracing.CustomTag_Environment_{
+				Environment: &envoytracing.CustomTag_Environment{
+					Name:         tag.Environment.Name,
+					DefaultValue: tag.Environment.DefaultValue,
+				},
+			}
+		default:
+			continue
+		}
+		out = append(out, customTag)
+	}
+	return out
+}
+
+func tracingPercent(percentage *uint32) *envoytype.Percent {
+	if percentage == nil {
+		return nil
+	}
+	return &envoytype.Percent{Value: float64(*percentage)}
+}
@@ -0,0 +1,167 @@
+package httplistenerpolicy
+
+import (
+	"context"
+	"testing"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoytrace "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytracing "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestTranslateTracing(t *testing.T) {
+	backendRef := &gwv1.BackendRef{
+		BackendObjectReference: gwv1.BackendObjectReference{Name: "collector"},
+	}
+
+	t.Run("OpenTelemetry", func(t *testing.T) {
+		tracing, err := translateTracing(&v1alpha1.Tracing{
+			OpenTelemetry: &v1alpha1.OpenTelemetryTracingProvider{
+				BackendRef:  backendRef,
+				ServiceName: "gateway",
+			},
+			ClientSampling:  ptr.To[uint32](100),
+			RandomSampling:  ptr.To[uint32](10),
+			OverallSampling: ptr.To[uint32](50),
+			CustomTags: []v1alpha1.CustomTag{
+				{Tag: "team", Literal: ptr.To("edge")},
+				{Tag: "user", RequestHeader: &v1alpha1.CustomTagSource{Name: "x-user", DefaultValue: "anonymous"}},
+				{Tag: "pod", Environment: &v1alpha1.CustomTagSource{Name: "POD_NAME"}},
+			},
+			SpawnUpstreamSpan: true,
+		}, "kube_default_collector_4317")
+		require.NoError(t, err)
+
+		assert.True(t, proto.Equal(&envoytype.Percent{Value: 100}, tracing.GetClientSampling()))
+		assert.True(t, proto.Equal(&envoytype.Percent{Value: 10}, tracing.GetRandomSampling()))
+		assert.True(t, proto.Equal(&envoytype.Percent{Value: 50}, tracing.GetOverallSampling()))
+		assert.True(t, proto.Equal(wrapperspb.Bool(true), tracing.GetSpawnUpstreamSpan()))
+
+		expectedTags := []*envoytracing.CustomTag{
+			{
+				Tag:  "team",
+				Type: &envoytracing.CustomTag_Literal_{Literal: &envoytracing.CustomTag_Literal{Value: "edge"}},
+			},
+			{
+				Tag:  "user",
+				Type: &envoytracing.CustomTag_RequestHeader{RequestHeader: &envoytracing.CustomTag_Header{Name: "x-user", DefaultValue: "anonymous"}},
+			},
+			{
+				Tag:  "pod",
+				Type: &envoytracing.CustomTag_Environment_{Environment: &envoytracing.CustomTag_Environment{Name: "POD_NAME"}},
+			},
+		}
+		require.Len(t, tracing.GetCustomTags(), len(expectedTags))
+		for i, tag := range expectedTags {
+			assert.True(t, proto.Equal(tag, tracing.GetCustomTags()[i]), "expected %v, got %v", tag, tracing.GetCustomTags()[i])
+		}
+
+		provider := tracing.GetProvider()
+		assert.Equal(t, openTelemetryTracerName, provider.GetName())
+		otel := &envoytrace.OpenTelemetryConfig{}
+		require.NoError(t, provider.GetTypedConfig().UnmarshalTo(otel))
+		expected := &envoytrace.OpenTelemetryConfig{
+			GrpcService: &envoycore.GrpcService{
+				TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{ClusterName: "kube_default_collector_4317"},
+				},
+			},
+			ServiceName: "gateway",
+		}
+		assert.True(t, proto.Equal(expected, otel), "expected %v, got %v", expected, otel)
+	})
+
+	t.Run("Zipkin", func(t *testing.T) {
+		tracing, err := translateTracing(&v1alpha1.Tracing{
+			Zipkin: &v1alpha1.ZipkinTracingProvider{
+				BackendRef:    backendRef,
+				TraceId128Bit: true,
+			},
+		}, "kube_default_collector_9411")
+		require.NoError(t, err)
+
+		assert.Nil(t, tracing.GetRandomSampling())
+		assert.Nil(t, tracing.GetSpawnUpstreamSpan())
+
+		provider := tracing.GetProvider()
+		assert.Equal(t, zipkinTracerName, provider.GetName())
+		zipkin := &envoytrace.ZipkinConfig{}
+		require.NoError(t, provider.GetTypedConfig().UnmarshalTo(zipkin))
+		expected := &envoytrace.ZipkinConfig{
+			CollectorCluster:         "kube_default_collector_9411",
+			CollectorEndpoint:        defaultZipkinCollectorEndpoint,
+			CollectorEndpointVersion: envoytrace.ZipkinConfig_HTTP_JSON,
+			TraceId_128Bit:           true,
+		}
+		assert.True(t, proto.Equal(expected, zipkin), "expected %v, got %v", expected, zipkin)
+	})
+
+	t.Run("no provider", func(t *testing.T) {
+		_, err := translateTracing(&v1alpha1.Tracing{}, "cluster")
+		assert.Error(t, err)
+	})
+
+	t.Run("newest policy sets the tracing of the HCM", func(t *testing.T) {
+		older, err := translateTracing(&v1alpha1.Tracing{
+			Zipkin: &v1alpha1.ZipkinTracingProvider{BackendRef: backendRef},
+		}, "zipkin")
+		require.NoError(t, err)
+		newer, err := translateTracing(&v1alpha1.Tracing{
+			OpenTelemetry: &v1alpha1.OpenTelemetryTracingProvider{BackendRef: backendRef},
+		}, "otel")
+		require.NoError(t, err)
+
+		out := &envoy_hcm.HttpConnectionManager{}
+		pass := &httpListenerPolicyPluginGwPass{}
+		for _, pol := range []*httpListenerPolicy{{tracing: older}, {tracing: newer}, {}} {
+			require.NoError(t, pass.ApplyHCM(context.Background(), &ir.HcmContext{Policy: pol}, out))
+		}
+		assert.Equal(t, openTelemetryTracerName, out.GetTracing().GetProvider().GetName())
+	})
+
+	t.Run("OpenTelemetry service name defaults to the envoy cluster of the gateway", func(t *testing.T) {
+		gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "http", Namespace: "infra"}}
+		serviceName := func(tracing *envoy_hcm.HttpConnectionManager_Tracing) string {
+			otel := &envoytrace.OpenTelemetryConfig{}
+			require.NoError(t, tracing.GetProvider().GetTypedConfig().UnmarshalTo(otel))
+			return otel.GetServiceName()
+		}
+
+		defaulted, err := translateTracing(&v1alpha1.Tracing{
+			OpenTelemetry: &v1alpha1.OpenTelemetryTracingProvider{BackendRef: backendRef},
+		}, "otel")
+		require.NoError(t, err)
+		out, err := tracingForGateway(defaulted, gw)
+		require.NoError(t, err)
+		assert.Equal(t, "http.infra", serviceName(out))
+		assert.Empty(t, serviceName(defaulted), "the policy tracing must not be mutated")
+
+		named, err := translateTracing(&v1alpha1.Tracing{
+			OpenTelemetry: &v1alpha1.OpenTelemetryTracingProvider{BackendRef: backendRef, ServiceName: "edge"},
+		}, "otel")
+		require.NoError(t, err)
+		out, err = tracingForGateway(named, gw)
+		require.NoError(t, err)
+		assert.Equal(t, "edge", serviceName(out))
+
+		zipkin, err := translateTracing(&v1alpha1.Tracing{
+			Zipkin: &v1alpha1.ZipkinTracingProvider{BackendRef: backendRef},
+		}, "zipkin")
+		require.NoError(t, err)
+		out, err = tracingForGateway(zipkin, gw)
+		require.NoError(t, err)
+		assert.Same(t, zipkin, out)
+	})
+}
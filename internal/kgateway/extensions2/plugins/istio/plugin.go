@@ -98,17 +98,18 @@ func isDisabledForUpstream(_ ir.Upstream) bool {
 // we don't have a good way of know if we have ssl on the upstream, so check cluster instead
 // this could be a problem if the policy that adds ssl runs after this one.
 // so we need to think about how's best to handle this.
-func doesClusterHaveSslConfigPresent(_ *envoy_config_cluster_v3.Cluster) bool {
-	// TODO: implement this
-	return false
+// doesClusterHaveSslConfigPresent returns true if another plugin, e.g. BackendTLSPolicy, configured
+// TLS on the cluster.
+func doesClusterHaveSslConfigPresent(out *envoy_config_cluster_v3.Cluster) bool {
+	return out.GetTransportSocket() != nil
 }
 
-func (p istioPlugin) processUpstream(ctx context.Context, ir ir.PolicyIR, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) {
+func (p istioPlugin) processUpstream(ctx context.Context, ir ir.PolicyIR, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) error {
 	var socketmatches []*envoy_config_cluster_v3.Cluster_TransportSocketMatch
 
 	st, ok := ir.(IstioSettings)
 	if !ok {
-		return
+		return nil
 	}
 	// Istio automtls will only be applied when:
 	// 1) automtls is enabled on the settings
@@ -138,6 +139,7 @@ func (p istioPlugin) processUpstream(ctx context.Context, ir ir.PolicyIR, in ir.
 		}
 		out.TransportSocketMatches = socketmatches
 	}
+	return nil
 }
 
 func createIstioMatch(sni string) *envoy_config_cluster_v3.Cluster_TransportSocketMatch {
@@ -0,0 +1,181 @@
+package jwt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyjwtauthn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const (
+	// timeout of JWKS fetches, as documented in the API
+	defaultJWKSTimeout = 5 * time.Second
+
+	defaultJWKSKey = "jwks"
+)
+
+// providerName returns the name of a provider in the jwt_authn filter, which is shared by all the
+// policies of a filter chain.
+func providerName(objSrc ir.ObjectSource, name string) string {
+	return fmt.Sprintf("%s/%s/%s", objSrc.Namespace, objSrc.Name, name)
+}
+
+// requirementName returns the name of the requirement of a policy in the jwt_authn filter.
+func requirementName(objSrc ir.ObjectSource) string {
+	return fmt.Sprintf("%s/%s", objSrc.Namespace, objSrc.Name)
+}
+
+// convertRemoteJWKS returns the provider config fetching the JWKS from the upstream.
+func convertRemoteJWKS(remote *v1alpha1.RemoteJWKS, jwksServer *ir.Upstream) *envoyjwtauthn.JwtProvider_RemoteJwks {
+	timeout := durationpb.New(defaultJWKSTimeout)
+	if remote.Timeout != nil {
+		timeout = durationpb.New(remote.Timeout.Duration)
+	}
+	out := &envoyjwtauthn.RemoteJwks{
+		HttpUri: &envoy_config_core_v3.HttpUri{
+			Uri: remote.URL,
+			HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+				Cluster: jwksServer.ClusterName(),
+			},
+			Timeout: timeout,
+		},
+	}
+	if remote.CacheDuration != nil {
+		out.CacheDuration = durationpb.New(remote.CacheDuration.Duration)
+	}
+	return &envoyjwtauthn.JwtProvider_RemoteJwks{
+		RemoteJwks: out,
+	}
+}
+
+// convertLocalJWKS returns the provider config with the JWKS read from the secret.
+func convertLocalJWKS(local *v1alpha1.LocalJWKS, secret *ir.Secret) (*envoyjwtauthn.JwtProvider_LocalJwks, error) {
+	key := local.Key
+	if key == "" {
+		key = defaultJWKSKey
+	}
+	jwks, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s has no key %q", secret.Namespace, secret.Name, key)
+	}
+	if err := validateJWKS(jwks); err != nil {
+		return nil, fmt.Errorf("invalid JWKS in secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+	return &envoyjwtauthn.JwtProvider_LocalJwks{
+		LocalJwks: &envoy_config_core_v3.DataSource{
+			Specifier: &envoy_config_core_v3.DataSource_InlineString{
+				InlineString: string(jwks),
+			},
+		},
+	}, nil
+}
+
+// validateJWKS checks the JWKS is a JSON object with keys, so that envoy doesn't reject the filter
+// config of the whole listener.
+func validateJWKS(jwks []byte) error {
+	var keySet struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(jwks, &keySet); err != nil {
+		return err
+	}
+	if len(keySet.Keys) == 0 {
+		return errors.New("no keys")
+	}
+	return nil
+}
+
+// convertProvider returns the config of a provider, without its JWKS which is resolved separately.
+func convertProvider(provider v1alpha1.JWTProvider) *envoyjwtauthn.JwtProvider {
+	out := &envoyjwtauthn.JwtProvider{
+		Issuer:    provider.Issuer,
+		Audiences: provider.Audiences,
+		Forward:   provider.Forward,
+	}
+	for _, c := range provider.ClaimsToHeaders {
+		out.ClaimToHeaders = append(out.GetClaimToHeaders(), &envoyjwtauthn.JwtClaimToHeader{
+			ClaimName:  c.Claim,
+			HeaderName: c.Header,
+		})
+	}
+	return out
+}
+
+// convertRequirement returns the requirement of a policy, which refers to its providers by name.
+func convertRequirement(objSrc ir.ObjectSource, spec v1alpha1.JWTPolicySpec) (*envoyjwtauthn.JwtRequirement, error) {
+	names := make([]string, 0, len(spec.Providers))
+	for _, p := range spec.Providers {
+		names = append(names, p.Name)
+	}
+
+	requirement := &v1alpha1.JWTRequirement{}
+	if spec.Requirement != nil {
+		requirement = spec.Requirement
+	}
+	if len(requirement.Providers) > 0 {
+		for _, name := range requirement.Providers {
+			if !slices.Contains(names, name) {
+				return nil, fmt.Errorf("requirement refers to unknown provider %q", name)
+			}
+		}
+		names = requirement.Providers
+	}
+
+	requirements := make([]*envoyjwtauthn.JwtRequirement, 0, len(names))
+	for _, name := range names {
+		requirements = append(requirements, &envoyjwtauthn.JwtRequirement{
+			RequiresType: &envoyjwtauthn.JwtRequirement_ProviderName{
+				ProviderName: providerName(objSrc, name),
+			},
+		})
+	}
+
+	var out *envoyjwtauthn.JwtRequirement
+	switch {
+	case len(requirements) == 1:
+		out = requirements[0]
+	case requirement.RequireAll:
+		out = &envoyjwtauthn.JwtRequirement{
+			RequiresType: &envoyjwtauthn.JwtRequirement_RequiresAll{
+				RequiresAll: &envoyjwtauthn.JwtRequirementAndList{
+					Requirements: requirements,
+				},
+			},
+		}
+	default:
+		out = &envoyjwtauthn.JwtRequirement{
+			RequiresType: &envoyjwtauthn.JwtRequirement_RequiresAny{
+				RequiresAny: &envoyjwtauthn.JwtRequirementOrList{
+					Requirements: requirements,
+				},
+			},
+		}
+	}
+
+	if requirement.AllowMissing {
+		out = &envoyjwtauthn.JwtRequirement{
+			RequiresType: &envoyjwtauthn.JwtRequirement_RequiresAny{
+				RequiresAny: &envoyjwtauthn.JwtRequirementOrList{
+					Requirements: []*envoyjwtauthn.JwtRequirement{
+						out,
+						{
+							RequiresType: &envoyjwtauthn.JwtRequirement_AllowMissing{
+								AllowMissing: &emptypb.Empty{},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,305 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwtauthn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/solo-io/go-utils/contextutils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	extensionplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+)
+
+const FilterName = "envoy.filters.http.jwt_authn"
+
+type jwtPolicy struct {
+	ct time.Time
+	// providers of the policy, by their name in the jwt_authn filter
+	providers map[string]*envoyjwtauthn.JwtProvider
+	// requirement of the policy, by its name in the jwt_authn filter
+	requirementName string
+	requirement     *envoyjwtauthn.JwtRequirement
+	// per-route config of the jwt_authn filter selecting the requirement; nil if the policy is invalid
+	perRoute *anypb.Any
+}
+
+func (d *jwtPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *jwtPolicy) Equals(in any) bool {
+	d2, ok := in.(*jwtPolicy)
+	if !ok {
+		return false
+	}
+	if len(d.providers) != len(d2.providers) {
+		return false
+	}
+	for name, p := range d.providers {
+		if !proto.Equal(p, d2.providers[name]) {
+			return false
+		}
+	}
+	return d.requirementName == d2.requirementName &&
+		proto.Equal(d.requirement, d2.requirement) &&
+		proto.Equal(d.perRoute, d2.perRoute)
+}
+
+type jwtPluginGwPass struct {
+	// jwt_authn filter of each filter chain, with the providers and requirements of all of its policies
+	filters map[string]*envoyjwtauthn.JwtAuthentication
+}
+
+func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionplug.Plugin {
+	col := krtutil.SetupCollectionDynamic[v1alpha1.JWTPolicy](
+		ctx,
+		commoncol.Client,
+		v1alpha1.SchemeGroupVersion.WithResource("jwtpolicies"),
+		commoncol.KrtOpts.ToOptions("JWTPolicy")...,
+	)
+	gk := v1alpha1.JWTPolicyGVK.GroupKind()
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, policyCR *v1alpha1.JWTPolicy) *ir.PolicyWrapper {
+		objSrc := ir.ObjectSource{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policyCR.Namespace,
+			Name:      policyCR.Name,
+		}
+
+		errors := []error{}
+		policyIR, err := convertJWTPolicy(krtctx, commoncol, objSrc, policyCR)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		var pol = &ir.PolicyWrapper{
+			ObjectSource: objSrc,
+			Policy:       policyCR,
+			PolicyIR:     policyIR,
+			TargetRefs:   convert(policyCR.Spec.TargetRef),
+			Errors:       errors,
+		}
+		return pol
+	})
+
+	return extensionplug.Plugin{
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			v1alpha1.JWTPolicyGVK.GroupKind(): {
+				NewGatewayTranslationPass: NewGatewayTranslationPass,
+				Policies:                  policyCol,
+			},
+		},
+	}
+}
+
+func convertJWTPolicy(
+	krtctx krt.HandlerContext,
+	commoncol *common.CommonCollections,
+	objSrc ir.ObjectSource,
+	policyCR *v1alpha1.JWTPolicy,
+) (*jwtPolicy, error) {
+	out := &jwtPolicy{
+		ct:              policyCR.CreationTimestamp.Time,
+		providers:       make(map[string]*envoyjwtauthn.JwtProvider, len(policyCR.Spec.Providers)),
+		requirementName: requirementName(objSrc),
+	}
+
+	for _, provider := range policyCR.Spec.Providers {
+		config := convertProvider(provider)
+		switch {
+		case provider.JWKS.Remote != nil:
+			jwksServer, err := commoncol.Upstreams.GetUpstreamFromRef(krtctx, objSrc, provider.JWKS.Remote.BackendRef)
+			if err != nil {
+				return out, fmt.Errorf("provider %s: failed to get JWKS upstream from ref: %s", provider.Name, err.Error())
+			}
+			config.JwksSourceSpecifier = convertRemoteJWKS(provider.JWKS.Remote, jwksServer)
+		case provider.JWKS.Local != nil:
+			from := krtcollections.From{
+				GroupKind: v1alpha1.JWTPolicyGVK.GroupKind(),
+				Namespace: objSrc.Namespace,
+			}
+			secret, err := commoncol.Secrets.GetSecret(krtctx, from, provider.JWKS.Local.SecretRef)
+			if err != nil {
+				return out, fmt.Errorf("provider %s: failed to get JWKS secret: %s", provider.Name, err.Error())
+			}
+			jwks, err := convertLocalJWKS(provider.JWKS.Local, secret)
+			if err != nil {
+				return out, fmt.Errorf("provider %s: %w", provider.Name, err)
+			}
+			config.JwksSourceSpecifier = jwks
+		default:
+			return out, fmt.Errorf("provider %s: no JWKS", provider.Name)
+		}
+		out.providers[providerName(objSrc, provider.Name)] = config
+	}
+
+	requirement, err := convertRequirement(objSrc, policyCR.Spec)
+	if err != nil {
+		return out, err
+	}
+	out.requirement = requirement
+
+	perRoute, err := anypb.New(&envoyjwtauthn.PerRouteConfig{
+		RequirementSpecifier: &envoyjwtauthn.PerRouteConfig_RequirementName{
+			RequirementName: out.requirementName,
+		},
+	})
+	if err != nil {
+		return out, err
+	}
+	out.perRoute = perRoute
+
+	return out, nil
+}
+
+func convert(targetRef v1alpha1.LocalPolicyTargetReference) []ir.PolicyTargetRef {
+	return []ir.PolicyTargetRef{{
+		Kind:  string(targetRef.Kind),
+		Name:  string(targetRef.Name),
+		Group: string(targetRef.Group),
+	}}
+}
+
+func NewGatewayTranslationPass(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
+	return &jwtPluginGwPass{
+		filters: make(map[string]*envoyjwtauthn.JwtAuthentication),
+	}
+}
+
+func (p *jwtPolicy) Name() string {
+	return "jwtpolicies"
+}
+
+// called 1 time for each listener
+func (p *jwtPluginGwPass) ApplyListenerPlugin(ctx context.Context, pCtx *ir.ListenerContext, out *envoy_config_listener_v3.Listener) {
+}
+
+func (p *jwtPluginGwPass) ApplyHCM(ctx context.Context, pCtx *ir.HcmContext, out *envoyhttp.HttpConnectionManager) error {
+	// no op
+	return nil
+}
+
+func (p *jwtPluginGwPass) ApplyTcpProxy(ctx context.Context, pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	// no op
+	return nil
+}
+
+// applies policies attached to the gateway to all of its virtual hosts
+func (p *jwtPluginGwPass) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
+	policy, ok := pCtx.Policy.(*jwtPolicy)
+	if !ok {
+		return
+	}
+	// the routes are denied rather than served without authentication; the error of the policy is
+	// reported on its status
+	if policy.perRoute == nil {
+		contextutils.LoggerFrom(ctx).Errorf("jwt policy %s is invalid, denying all the routes of virtual host %s", policy.requirementName, out.GetName())
+		for _, route := range out.GetRoutes() {
+			route.Action = &envoy_config_route_v3.Route_DirectResponse{
+				DirectResponse: &envoy_config_route_v3.DirectResponseAction{
+					Status: http.StatusUnauthorized,
+				},
+			}
+		}
+		return
+	}
+
+	p.useFilter(pCtx.FilterChainName, policy)
+	if out.GetTypedPerFilterConfig() == nil {
+		out.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	out.GetTypedPerFilterConfig()[FilterName] = policy.perRoute
+}
+
+// called 0 or more times
+func (p *jwtPluginGwPass) ApplyForRoute(ctx context.Context, pCtx *ir.RouteContext, outputRoute *envoy_config_route_v3.Route) error {
+	policy, ok := pCtx.Policy.(*jwtPolicy)
+	if !ok {
+		return nil
+	}
+	// the route is dropped rather than served without authentication
+	if policy.perRoute == nil {
+		return fmt.Errorf("jwt policy %s is invalid", policy.requirementName)
+	}
+
+	p.useFilter(pCtx.FilterChainName, policy)
+	if outputRoute.GetTypedPerFilterConfig() == nil {
+		outputRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	outputRoute.GetTypedPerFilterConfig()[FilterName] = policy.perRoute
+
+	return nil
+}
+
+// useFilter adds the providers and the requirement of the policy to the jwt_authn filter of the
+// filter chain. Their names are scoped to the policy, so policies never conflict.
+func (p *jwtPluginGwPass) useFilter(filterChainName string, policy *jwtPolicy) {
+	filter, ok := p.filters[filterChainName]
+	if !ok {
+		filter = &envoyjwtauthn.JwtAuthentication{
+			Providers:      map[string]*envoyjwtauthn.JwtProvider{},
+			RequirementMap: map[string]*envoyjwtauthn.JwtRequirement{},
+		}
+		p.filters[filterChainName] = filter
+	}
+	for name, provider := range policy.providers {
+		filter.GetProviders()[name] = provider
+	}
+	filter.GetRequirementMap()[policy.requirementName] = policy.requirement
+}
+
+func (p *jwtPluginGwPass) ApplyForRouteBackend(
+	ctx context.Context,
+	policy ir.PolicyIR,
+	pCtx *ir.RouteBackendContext,
+) error {
+	return nil
+}
+
+// called 1 time per listener
+// if a plugin emits new filters, they must be with a plugin unique name.
+// any filter returned from route config must be disabled, so it doesnt impact other routes.
+func (p *jwtPluginGwPass) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	filter, ok := p.filters[fcc.FilterChainName]
+	if !ok {
+		return nil, nil
+	}
+	// the filter has no rules, so only routes with a per-route config selecting a requirement are
+	// authenticated.
+	f, err := plugins.NewStagedFilter(FilterName, filter, plugins.DuringStage(plugins.AuthNStage))
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{f}, nil
+}
+
+func (p *jwtPluginGwPass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
+	return nil, nil
+}
+
+func (p *jwtPluginGwPass) NetworkFilters(ctx context.Context) ([]plugins.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+// called 1 time (per envoy proxy). replaces GeneratedResources
+func (p *jwtPluginGwPass) ResourcesToAdd(ctx context.Context) ir.Resources {
+	return ir.Resources{}
+}
@@ -0,0 +1,223 @@
+package jwt
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwtauthn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const testJWKS = `{"keys":[{"kty":"oct","alg":"HS256","k":"c2VjcmV0"}]}`
+
+var policySrc = ir.ObjectSource{
+	Group:     v1alpha1.GroupName,
+	Kind:      v1alpha1.JWTPolicyKind,
+	Namespace: "default",
+	Name:      "jwt",
+}
+
+func TestConvertJWKS(t *testing.T) {
+	t.Run("remote", func(t *testing.T) {
+		jwksServer := &ir.Upstream{
+			ObjectSource: ir.ObjectSource{
+				Kind:      "Service",
+				Namespace: "default",
+				Name:      "idp",
+			},
+			Port: 8080,
+		}
+		jwks := convertRemoteJWKS(&v1alpha1.RemoteJWKS{
+			URL:           "https://idp.example.com/jwks.json",
+			CacheDuration: &metav1.Duration{Duration: time.Minute},
+		}, jwksServer)
+
+		httpUri := jwks.RemoteJwks.GetHttpUri()
+		assert.Equal(t, "https://idp.example.com/jwks.json", httpUri.GetUri())
+		assert.Equal(t, "service_default_idp_8080", httpUri.GetCluster())
+		assert.True(t, proto.Equal(durationpb.New(defaultJWKSTimeout), httpUri.GetTimeout()))
+		assert.True(t, proto.Equal(durationpb.New(time.Minute), jwks.RemoteJwks.GetCacheDuration()))
+	})
+
+	t.Run("local", func(t *testing.T) {
+		secret := &ir.Secret{
+			ObjectSource: ir.ObjectSource{Namespace: "default", Name: "jwks"},
+			Data:         map[string][]byte{"jwks": []byte(testJWKS)},
+		}
+		jwks, err := convertLocalJWKS(&v1alpha1.LocalJWKS{}, secret)
+		require.NoError(t, err)
+		assert.Equal(t, testJWKS, jwks.LocalJwks.GetInlineString())
+
+		_, err = convertLocalJWKS(&v1alpha1.LocalJWKS{Key: "other"}, secret)
+		assert.ErrorContains(t, err, `no key "other"`)
+
+		secret.Data["jwks"] = []byte(`{"keys":[]}`)
+		_, err = convertLocalJWKS(&v1alpha1.LocalJWKS{}, secret)
+		assert.ErrorContains(t, err, "invalid JWKS")
+	})
+}
+
+func TestConvertProvider(t *testing.T) {
+	provider := convertProvider(v1alpha1.JWTProvider{
+		Issuer:    "https://idp.example.com",
+		Audiences: []string{"api"},
+		ClaimsToHeaders: []v1alpha1.JWTClaimToHeader{{
+			Claim:  "sub",
+			Header: "x-user-id",
+		}},
+	})
+	assert.Equal(t, "https://idp.example.com", provider.GetIssuer())
+	assert.Equal(t, []string{"api"}, provider.GetAudiences())
+	assert.False(t, provider.GetForward())
+	require.Len(t, provider.GetClaimToHeaders(), 1)
+	assert.Equal(t, "sub", provider.GetClaimToHeaders()[0].GetClaimName())
+	assert.Equal(t, "x-user-id", provider.GetClaimToHeaders()[0].GetHeaderName())
+}
+
+func TestConvertRequirement(t *testing.T) {
+	providers := []v1alpha1.JWTProvider{{Name: "a"}, {Name: "b"}}
+	providerReq := func(name string) *envoyjwtauthn.JwtRequirement {
+		return &envoyjwtauthn.JwtRequirement{
+			RequiresType: &envoyjwtauthn.JwtRequirement_ProviderName{
+				ProviderName: "default/jwt/" + name,
+			},
+		}
+	}
+
+	t.Run("any provider by default", func(t *testing.T) {
+		req, err := convertRequirement(policySrc, v1alpha1.JWTPolicySpec{Providers: providers})
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&envoyjwtauthn.JwtRequirement{
+			RequiresType: &envoyjwtauthn.JwtRequirement_RequiresAny{
+				RequiresAny: &envoyjwtauthn.JwtRequirementOrList{
+					Requirements: []*envoyjwtauthn.JwtRequirement{providerReq("a"), providerReq("b")},
+				},
+			},
+		}, req))
+	})
+
+	t.Run("all providers", func(t *testing.T) {
+		req, err := convertRequirement(policySrc, v1alpha1.JWTPolicySpec{
+			Providers:   providers,
+			Requirement: &v1alpha1.JWTRequirement{RequireAll: true},
+		})
+		require.NoError(t, err)
+		assert.Len(t, req.GetRequiresAll().GetRequirements(), 2)
+	})
+
+	t.Run("single provider allowing missing JWTs", func(t *testing.T) {
+		req, err := convertRequirement(policySrc, v1alpha1.JWTPolicySpec{
+			Providers: providers,
+			Requirement: &v1alpha1.JWTRequirement{
+				Providers:    []string{"b"},
+				AllowMissing: true,
+			},
+		})
+		require.NoError(t, err)
+		anyOf := req.GetRequiresAny().GetRequirements()
+		require.Len(t, anyOf, 2)
+		assert.True(t, proto.Equal(providerReq("b"), anyOf[0]))
+		assert.NotNil(t, anyOf[1].GetAllowMissing())
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := convertRequirement(policySrc, v1alpha1.JWTPolicySpec{
+			Providers:   providers,
+			Requirement: &v1alpha1.JWTRequirement{Providers: []string{"c"}},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestJWTPass(t *testing.T) {
+	ctx := context.Background()
+	policy := func(t *testing.T, name string) *jwtPolicy {
+		objSrc := policySrc
+		objSrc.Name = name
+		perRoute, err := anypb.New(&envoyjwtauthn.PerRouteConfig{
+			RequirementSpecifier: &envoyjwtauthn.PerRouteConfig_RequirementName{
+				RequirementName: requirementName(objSrc),
+			},
+		})
+		require.NoError(t, err)
+		return &jwtPolicy{
+			providers: map[string]*envoyjwtauthn.JwtProvider{
+				providerName(objSrc, "idp"): convertProvider(v1alpha1.JWTProvider{Name: "idp"}),
+			},
+			requirementName: requirementName(objSrc),
+			requirement:     &envoyjwtauthn.JwtRequirement{},
+			perRoute:        perRoute,
+		}
+	}
+
+	t.Run("policies share the filter of the filter chain", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		vhost := &envoy_config_route_v3.VirtualHost{}
+		pass.ApplyVhostPlugin(ctx, &ir.VirtualHostContext{FilterChainName: "listener~80", Policy: policy(t, "gw")}, vhost)
+		route := &envoy_config_route_v3.Route{}
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: policy(t, "route")}, route)
+		require.NoError(t, err)
+
+		perRoute := &envoyjwtauthn.PerRouteConfig{}
+		require.NoError(t, vhost.GetTypedPerFilterConfig()[FilterName].UnmarshalTo(perRoute))
+		assert.Equal(t, "default/gw", perRoute.GetRequirementName())
+		require.NoError(t, route.GetTypedPerFilterConfig()[FilterName].UnmarshalTo(perRoute))
+		assert.Equal(t, "default/route", perRoute.GetRequirementName())
+
+		filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+		require.NoError(t, err)
+		require.Len(t, filters, 1)
+		config := &envoyjwtauthn.JwtAuthentication{}
+		require.NoError(t, filters[0].Filter.GetTypedConfig().UnmarshalTo(config))
+		assert.Len(t, config.GetProviders(), 2)
+		assert.Contains(t, config.GetProviders(), "default/gw/idp")
+		assert.Len(t, config.GetRequirementMap(), 2)
+		assert.Contains(t, config.GetRequirementMap(), "default/route")
+
+		filters, err = pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~8080"})
+		require.NoError(t, err)
+		assert.Empty(t, filters)
+	})
+
+	t.Run("invalid policy drops the route", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: &jwtPolicy{requirementName: "default/invalid"}}, &envoy_config_route_v3.Route{})
+		assert.Error(t, err)
+
+		filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+		require.NoError(t, err)
+		assert.Empty(t, filters)
+	})
+
+	t.Run("invalid gateway policy denies all routes", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		newRoute := func() *envoy_config_route_v3.Route {
+			return &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{},
+				},
+			}
+		}
+		vhost := &envoy_config_route_v3.VirtualHost{Routes: []*envoy_config_route_v3.Route{newRoute(), newRoute()}}
+		pass.ApplyVhostPlugin(ctx, &ir.VirtualHostContext{FilterChainName: "listener~80", Policy: &jwtPolicy{requirementName: "default/invalid"}}, vhost)
+		for _, route := range vhost.GetRoutes() {
+			assert.Equal(t, uint32(http.StatusUnauthorized), route.GetDirectResponse().GetStatus())
+		}
+		assert.Empty(t, vhost.GetTypedPerFilterConfig())
+
+		filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+		require.NoError(t, err)
+		assert.Empty(t, filters)
+	})
+}
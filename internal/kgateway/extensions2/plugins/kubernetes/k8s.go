@@ -56,8 +56,10 @@ func NewPluginFromCollections(
 				},
 				Obj:               svc,
 				Port:              port.Port,
+				PortName:          port.Name,
 				GvPrefix:          "kube",
 				CanonicalHostname: fmt.Sprintf("%s.%s.svc.%s", svc.Name, svc.Namespace, clusterDomain),
+				AppProtocol:       ir.ParseAppProtocol(port.AppProtocol),
 			})
 		}
 		return uss
@@ -79,7 +81,7 @@ func NewPluginFromCollections(
 	}
 }
 
-func processUpstream(ctx context.Context, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) {
+func processUpstream(ctx context.Context, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) error {
 	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
 		Type: envoy_config_cluster_v3.Cluster_EDS,
 	}
@@ -91,4 +93,5 @@ func processUpstream(ctx context.Context, in ir.Upstream, out *envoy_config_clus
 			},
 		},
 	}
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
@@ -113,6 +114,11 @@ func (p *listenerPolicyPluginGwPass) ApplyHCM(
 	return nil
 }
 
+func (p *listenerPolicyPluginGwPass) ApplyTcpProxy(ctx context.Context, pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	// no-op
+	return nil
+}
+
 func (p *listenerPolicyPluginGwPass) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
 }
 
@@ -0,0 +1,140 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyratelimitconfig "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoylocalratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoyratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const (
+	localRateLimitStatPrefix  = "http_local_rate_limiter"
+	globalRateLimitStatPrefix = "http_rate_limiter"
+
+	pathDescriptorKey = "path"
+)
+
+// convertLocalRateLimit returns the per-route config of the local rate limit filter.
+func convertLocalRateLimit(local *v1alpha1.LocalRateLimitPolicy) (*anypb.Any, error) {
+	if local == nil {
+		return nil, nil
+	}
+
+	tokenBucket := &envoytype.TokenBucket{
+		MaxTokens:    local.TokenBucket.MaxTokens,
+		FillInterval: durationpb.New(local.TokenBucket.FillInterval.Duration),
+	}
+	if local.TokenBucket.TokensPerFill != nil {
+		tokenBucket.TokensPerFill = wrapperspb.UInt32(*local.TokenBucket.TokensPerFill)
+	}
+
+	return anypb.New(&envoylocalratelimit.LocalRateLimit{
+		StatPrefix:     localRateLimitStatPrefix,
+		TokenBucket:    tokenBucket,
+		FilterEnabled:  fullRuntimeFraction("local_rate_limit_enabled"),
+		FilterEnforced: fullRuntimeFraction("local_rate_limit_enforced"),
+	})
+}
+
+func fullRuntimeFraction(runtimeKey string) *envoy_config_core_v3.RuntimeFractionalPercent {
+	return &envoy_config_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoytype.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoytype.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: runtimeKey,
+	}
+}
+
+// convertGlobalRateLimit returns the config of the rate limit filter, which calls the rate limit
+// service, and the rate limit actions which compute the descriptors of each request.
+func convertGlobalRateLimit(global *v1alpha1.GlobalRateLimitPolicy, rls *ir.Upstream) (*envoyratelimit.RateLimit, []*envoy_config_route_v3.RateLimit, error) {
+	filter := &envoyratelimit.RateLimit{
+		Domain:          global.Domain,
+		FailureModeDeny: !global.FailOpen,
+		RateLimitService: &envoyratelimitconfig.RateLimitServiceConfig{
+			GrpcService: &envoy_config_core_v3.GrpcService{
+				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+						ClusterName: rls.ClusterName(),
+					},
+				},
+			},
+			TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		},
+		StatPrefix: globalRateLimitStatPrefix,
+	}
+	if global.Timeout != nil {
+		filter.Timeout = durationpb.New(global.Timeout.Duration)
+	}
+
+	rateLimits := make([]*envoy_config_route_v3.RateLimit, 0, len(global.Descriptors))
+	for _, descriptor := range global.Descriptors {
+		actions := make([]*envoy_config_route_v3.RateLimit_Action, 0, len(descriptor.Entries))
+		for _, entry := range descriptor.Entries {
+			action, err := convertDescriptorEntry(entry)
+			if err != nil {
+				return nil, nil, err
+			}
+			actions = append(actions, action)
+		}
+		rateLimits = append(rateLimits, &envoy_config_route_v3.RateLimit{
+			Actions: actions,
+		})
+	}
+
+	return filter, rateLimits, nil
+}
+
+func convertDescriptorEntry(entry v1alpha1.RateLimitDescriptorEntry) (*envoy_config_route_v3.RateLimit_Action, error) {
+	switch entry.Type {
+	case v1alpha1.RateLimitDescriptorEntryTypeGeneric:
+		if entry.Generic == nil {
+			return nil, fmt.Errorf("generic descriptor entry is missing 'generic'")
+		}
+		return &envoy_config_route_v3.RateLimit_Action{
+			ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+				GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{
+					DescriptorKey:   entry.Generic.Key,
+					DescriptorValue: entry.Generic.Value,
+				},
+			},
+		}, nil
+	case v1alpha1.RateLimitDescriptorEntryTypeHeader:
+		if entry.Header == nil || *entry.Header == "" {
+			return nil, fmt.Errorf("header descriptor entry is missing 'header'")
+		}
+		return requestHeaderAction(*entry.Header, *entry.Header), nil
+	case v1alpha1.RateLimitDescriptorEntryTypeRemoteAddress:
+		return &envoy_config_route_v3.RateLimit_Action{
+			ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RemoteAddress_{
+				RemoteAddress: &envoy_config_route_v3.RateLimit_Action_RemoteAddress{},
+			},
+		}, nil
+	case v1alpha1.RateLimitDescriptorEntryTypePath:
+		return requestHeaderAction(":path", pathDescriptorKey), nil
+	default:
+		return nil, fmt.Errorf("unsupported descriptor entry type %q", entry.Type)
+	}
+}
+
+func requestHeaderAction(header, descriptorKey string) *envoy_config_route_v3.RateLimit_Action {
+	return &envoy_config_route_v3.RateLimit_Action{
+		ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{
+			RequestHeaders: &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+				HeaderName:    header,
+				DescriptorKey: descriptorKey,
+			},
+		},
+	}
+}
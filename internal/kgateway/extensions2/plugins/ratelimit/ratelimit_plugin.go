@@ -0,0 +1,302 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoylocalratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoyratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/solo-io/go-utils/contextutils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	extensionplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+)
+
+const (
+	LocalRateLimitFilterName  = "envoy.filters.http.local_ratelimit"
+	GlobalRateLimitFilterName = "envoy.filters.http.ratelimit"
+)
+
+type rateLimitPolicy struct {
+	ct time.Time
+	// per-route config of the local rate limit filter
+	local  *anypb.Any
+	global *globalRateLimit
+}
+
+type globalRateLimit struct {
+	// config of the rate limit filter; shared by all the routes of a filter chain
+	filter     *envoyratelimit.RateLimit
+	rateLimits []*envoy_config_route_v3.RateLimit
+}
+
+func (d *rateLimitPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *rateLimitPolicy) Equals(in any) bool {
+	d2, ok := in.(*rateLimitPolicy)
+	if !ok {
+		return false
+	}
+	if !proto.Equal(d.local, d2.local) {
+		return false
+	}
+	if (d.global == nil) != (d2.global == nil) {
+		return false
+	}
+	if d.global == nil {
+		return true
+	}
+	return proto.Equal(d.global.filter, d2.global.filter) &&
+		slices.EqualFunc(d.global.rateLimits, d2.global.rateLimits, func(a, b *envoy_config_route_v3.RateLimit) bool {
+			return proto.Equal(a, b)
+		})
+}
+
+type rateLimitPluginGwPass struct {
+	needLocalFilter map[string]bool
+	// rate limit filter config of each filter chain
+	globalFilter map[string]*envoyratelimit.RateLimit
+}
+
+func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionplug.Plugin {
+	col := krtutil.SetupCollectionDynamic[v1alpha1.RateLimitPolicy](
+		ctx,
+		commoncol.Client,
+		v1alpha1.SchemeGroupVersion.WithResource("ratelimitpolicies"),
+		commoncol.KrtOpts.ToOptions("RateLimitPolicy")...,
+	)
+	gk := v1alpha1.RateLimitPolicyGVK.GroupKind()
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, policyCR *v1alpha1.RateLimitPolicy) *ir.PolicyWrapper {
+		objSrc := ir.ObjectSource{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policyCR.Namespace,
+			Name:      policyCR.Name,
+		}
+
+		errors := []error{}
+		policyIR, err := convertRateLimitPolicy(krtctx, commoncol, objSrc, policyCR)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
+		var pol = &ir.PolicyWrapper{
+			ObjectSource: objSrc,
+			Policy:       policyCR,
+			PolicyIR:     policyIR,
+			TargetRefs:   convert(policyCR.Spec.TargetRef),
+			Errors:       errors,
+		}
+		return pol
+	})
+
+	return extensionplug.Plugin{
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			v1alpha1.RateLimitPolicyGVK.GroupKind(): {
+				NewGatewayTranslationPass: NewGatewayTranslationPass,
+				Policies:                  policyCol,
+			},
+		},
+	}
+}
+
+func convertRateLimitPolicy(
+	krtctx krt.HandlerContext,
+	commoncol *common.CommonCollections,
+	objSrc ir.ObjectSource,
+	policyCR *v1alpha1.RateLimitPolicy,
+) (*rateLimitPolicy, error) {
+	out := &rateLimitPolicy{
+		ct: policyCR.CreationTimestamp.Time,
+	}
+
+	local, err := convertLocalRateLimit(policyCR.Spec.Local)
+	if err != nil {
+		return out, err
+	}
+	out.local = local
+
+	if global := policyCR.Spec.Global; global != nil {
+		rls, err := commoncol.Upstreams.GetUpstreamFromRef(krtctx, objSrc, global.BackendRef)
+		if err != nil {
+			return out, fmt.Errorf("failed to get rate limit service upstream from ref: %s", err.Error())
+		}
+		filter, rateLimits, err := convertGlobalRateLimit(global, rls)
+		if err != nil {
+			return out, err
+		}
+		out.global = &globalRateLimit{
+			filter:     filter,
+			rateLimits: rateLimits,
+		}
+	}
+
+	return out, nil
+}
+
+func convert(targetRef v1alpha1.LocalPolicyTargetReferenceWithSectionName) []ir.PolicyTargetRef {
+	var sectionName string
+	if targetRef.SectionName != nil {
+		sectionName = string(*targetRef.SectionName)
+	}
+	return []ir.PolicyTargetRef{{
+		Kind:        string(targetRef.Kind),
+		Name:        string(targetRef.Name),
+		Group:       string(targetRef.Group),
+		SectionName: sectionName,
+	}}
+}
+
+func NewGatewayTranslationPass(ctx context.Context, tctx ir.GwTranslationCtx) ir.ProxyTranslationPass {
+	return &rateLimitPluginGwPass{
+		needLocalFilter: make(map[string]bool),
+		globalFilter:    make(map[string]*envoyratelimit.RateLimit),
+	}
+}
+
+func (p *rateLimitPolicy) Name() string {
+	return "ratelimitpolicies"
+}
+
+// called 1 time for each listener
+func (p *rateLimitPluginGwPass) ApplyListenerPlugin(ctx context.Context, pCtx *ir.ListenerContext, out *envoy_config_listener_v3.Listener) {
+}
+
+func (p *rateLimitPluginGwPass) ApplyHCM(ctx context.Context, pCtx *ir.HcmContext, out *envoyhttp.HttpConnectionManager) error {
+	// no op
+	return nil
+}
+
+func (p *rateLimitPluginGwPass) ApplyTcpProxy(ctx context.Context, pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	// no op
+	return nil
+}
+
+// applies policies attached to the gateway to all of its virtual hosts
+func (p *rateLimitPluginGwPass) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
+	policy, ok := pCtx.Policy.(*rateLimitPolicy)
+	if !ok {
+		return
+	}
+
+	if policy.local != nil {
+		if out.GetTypedPerFilterConfig() == nil {
+			out.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+		out.GetTypedPerFilterConfig()[LocalRateLimitFilterName] = policy.local
+		p.needLocalFilter[pCtx.FilterChainName] = true
+	}
+
+	if policy.global != nil {
+		if err := p.useGlobalFilter(pCtx.FilterChainName, policy.global.filter); err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			return
+		}
+		out.RateLimits = policy.global.rateLimits
+	}
+}
+
+// called 0 or more times
+func (p *rateLimitPluginGwPass) ApplyForRoute(ctx context.Context, pCtx *ir.RouteContext, outputRoute *envoy_config_route_v3.Route) error {
+	policy, ok := pCtx.Policy.(*rateLimitPolicy)
+	if !ok {
+		return nil
+	}
+
+	if policy.local != nil {
+		if outputRoute.GetTypedPerFilterConfig() == nil {
+			outputRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+		outputRoute.GetTypedPerFilterConfig()[LocalRateLimitFilterName] = policy.local
+		p.needLocalFilter[pCtx.FilterChainName] = true
+	}
+
+	// the rate limits of a route replace the ones of its virtual host
+	if policy.global != nil && outputRoute.GetRoute() != nil {
+		if err := p.useGlobalFilter(pCtx.FilterChainName, policy.global.filter); err != nil {
+			return err
+		}
+		outputRoute.GetRoute().RateLimits = policy.global.rateLimits
+	}
+
+	return nil
+}
+
+// useGlobalFilter records the rate limit filter config needed by the filter chain.
+// All the policies of a filter chain must use the same rate limit service and domain, as the
+// filter is shared by all of its routes.
+func (p *rateLimitPluginGwPass) useGlobalFilter(filterChainName string, filter *envoyratelimit.RateLimit) error {
+	if existing, ok := p.globalFilter[filterChainName]; ok {
+		if !proto.Equal(existing, filter) {
+			return fmt.Errorf("conflicting global rate limit service or domain on filter chain %s", filterChainName)
+		}
+		return nil
+	}
+	p.globalFilter[filterChainName] = filter
+	return nil
+}
+
+func (p *rateLimitPluginGwPass) ApplyForRouteBackend(
+	ctx context.Context,
+	policy ir.PolicyIR,
+	pCtx *ir.RouteBackendContext,
+) error {
+	return nil
+}
+
+// called 1 time per listener
+// if a plugin emits new filters, they must be with a plugin unique name.
+// any filter returned from route config must be disabled, so it doesnt impact other routes.
+func (p *rateLimitPluginGwPass) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
+	var filters []plugins.StagedHttpFilter
+	if p.needLocalFilter[fcc.FilterChainName] {
+		// the token bucket is configured per route; the filter is disabled for other routes.
+		f, err := plugins.NewStagedFilter(LocalRateLimitFilterName, &envoylocalratelimit.LocalRateLimit{
+			StatPrefix: localRateLimitStatPrefix,
+		}, plugins.DuringStage(plugins.RateLimitStage))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	if filter, ok := p.globalFilter[fcc.FilterChainName]; ok {
+		// only routes with rate limit actions call the rate limit service.
+		f, err := plugins.NewStagedFilter(GlobalRateLimitFilterName, filter, plugins.DuringStage(plugins.RateLimitStage))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+func (p *rateLimitPluginGwPass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
+	return nil, nil
+}
+
+func (p *rateLimitPluginGwPass) NetworkFilters(ctx context.Context) ([]plugins.StagedNetworkFilter, error) {
+	return nil, nil
+}
+
+// called 1 time (per envoy proxy). replaces GeneratedResources
+func (p *rateLimitPluginGwPass) ResourcesToAdd(ctx context.Context) ir.Resources {
+	return ir.Resources{}
+}
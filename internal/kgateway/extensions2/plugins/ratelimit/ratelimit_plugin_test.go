@@ -0,0 +1,226 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoylocalratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoyratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+var rls = &ir.Upstream{
+	ObjectSource: ir.ObjectSource{
+		Kind:      "Service",
+		Namespace: "default",
+		Name:      "ratelimit",
+	},
+	Port: 8081,
+}
+
+func TestConvertLocalRateLimit(t *testing.T) {
+	local, err := convertLocalRateLimit(&v1alpha1.LocalRateLimitPolicy{
+		TokenBucket: v1alpha1.TokenBucket{
+			MaxTokens:     10,
+			TokensPerFill: ptr.To[uint32](2),
+			FillInterval:  metav1.Duration{Duration: time.Second},
+		},
+	})
+	require.NoError(t, err)
+
+	actual := &envoylocalratelimit.LocalRateLimit{}
+	require.NoError(t, local.UnmarshalTo(actual))
+	expected := &envoylocalratelimit.LocalRateLimit{
+		StatPrefix: localRateLimitStatPrefix,
+		TokenBucket: &envoytype.TokenBucket{
+			MaxTokens:     10,
+			TokensPerFill: wrapperspb.UInt32(2),
+			FillInterval:  durationpb.New(time.Second),
+		},
+		FilterEnabled:  fullRuntimeFraction("local_rate_limit_enabled"),
+		FilterEnforced: fullRuntimeFraction("local_rate_limit_enforced"),
+	}
+	assert.True(t, proto.Equal(expected, actual), "expected %v, got %v", expected, actual)
+}
+
+func TestConvertGlobalRateLimit(t *testing.T) {
+	t.Run("Descriptors", func(t *testing.T) {
+		filter, rateLimits, err := convertGlobalRateLimit(&v1alpha1.GlobalRateLimitPolicy{
+			Domain:  "api",
+			Timeout: &metav1.Duration{Duration: 100 * time.Millisecond},
+			Descriptors: []v1alpha1.RateLimitDescriptor{
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{
+							Type:    v1alpha1.RateLimitDescriptorEntryTypeGeneric,
+							Generic: &v1alpha1.RateLimitDescriptorEntryGeneric{Key: "tier", Value: "free"},
+						},
+						{Type: v1alpha1.RateLimitDescriptorEntryTypeRemoteAddress},
+					},
+				},
+				{
+					Entries: []v1alpha1.RateLimitDescriptorEntry{
+						{Type: v1alpha1.RateLimitDescriptorEntryTypeHeader, Header: ptr.To("x-user-id")},
+						{Type: v1alpha1.RateLimitDescriptorEntryTypePath},
+					},
+				},
+			},
+		}, rls)
+		require.NoError(t, err)
+
+		assert.Equal(t, "api", filter.GetDomain())
+		assert.True(t, filter.GetFailureModeDeny())
+		assert.True(t, proto.Equal(durationpb.New(100*time.Millisecond), filter.GetTimeout()))
+		assert.Equal(t, "service_default_ratelimit_8081", filter.GetRateLimitService().GetGrpcService().GetEnvoyGrpc().GetClusterName())
+		assert.Equal(t, envoy_config_core_v3.ApiVersion_V3, filter.GetRateLimitService().GetTransportApiVersion())
+
+		expected := []*envoy_config_route_v3.RateLimit{
+			{
+				Actions: []*envoy_config_route_v3.RateLimit_Action{
+					{
+						ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+							GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{
+								DescriptorKey:   "tier",
+								DescriptorValue: "free",
+							},
+						},
+					},
+					{
+						ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RemoteAddress_{
+							RemoteAddress: &envoy_config_route_v3.RateLimit_Action_RemoteAddress{},
+						},
+					},
+				},
+			},
+			{
+				Actions: []*envoy_config_route_v3.RateLimit_Action{
+					requestHeaderAction("x-user-id", "x-user-id"),
+					requestHeaderAction(":path", "path"),
+				},
+			},
+		}
+		require.Len(t, rateLimits, len(expected))
+		for i := range expected {
+			assert.True(t, proto.Equal(expected[i], rateLimits[i]), "expected %v, got %v", expected[i], rateLimits[i])
+		}
+	})
+
+	t.Run("Header entry without header", func(t *testing.T) {
+		_, _, err := convertGlobalRateLimit(&v1alpha1.GlobalRateLimitPolicy{
+			Domain: "api",
+			Descriptors: []v1alpha1.RateLimitDescriptor{{
+				Entries: []v1alpha1.RateLimitDescriptorEntry{{Type: v1alpha1.RateLimitDescriptorEntryTypeHeader}},
+			}},
+		}, rls)
+		assert.Error(t, err)
+	})
+}
+
+func TestRateLimitPass(t *testing.T) {
+	ctx := context.Background()
+	global := func(domain string) *globalRateLimit {
+		filter, rateLimits, err := convertGlobalRateLimit(&v1alpha1.GlobalRateLimitPolicy{
+			Domain: domain,
+			Descriptors: []v1alpha1.RateLimitDescriptor{{
+				Entries: []v1alpha1.RateLimitDescriptorEntry{{Type: v1alpha1.RateLimitDescriptorEntryTypeRemoteAddress}},
+			}},
+		}, rls)
+		require.NoError(t, err)
+		return &globalRateLimit{filter: filter, rateLimits: rateLimits}
+	}
+	local, err := convertLocalRateLimit(&v1alpha1.LocalRateLimitPolicy{
+		TokenBucket: v1alpha1.TokenBucket{MaxTokens: 1, FillInterval: metav1.Duration{Duration: time.Second}},
+	})
+	require.NoError(t, err)
+	newRoute := func() *envoy_config_route_v3.Route {
+		return &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: &envoy_config_route_v3.RouteAction{},
+			},
+		}
+	}
+
+	t.Run("gateway policy applies to the virtual host", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		vhost := &envoy_config_route_v3.VirtualHost{}
+		pol := &rateLimitPolicy{local: local, global: global("api")}
+		pass.ApplyVhostPlugin(ctx, &ir.VirtualHostContext{FilterChainName: "listener~80", Policy: pol}, vhost)
+
+		assert.True(t, proto.Equal(local, vhost.GetTypedPerFilterConfig()[LocalRateLimitFilterName]))
+		assert.Len(t, vhost.GetRateLimits(), 1)
+
+		filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+		require.NoError(t, err)
+		require.Len(t, filters, 2)
+		assert.Equal(t, LocalRateLimitFilterName, filters[0].Filter.GetName())
+		assert.Equal(t, GlobalRateLimitFilterName, filters[1].Filter.GetName())
+		globalFilter := &envoyratelimit.RateLimit{}
+		require.NoError(t, filters[1].Filter.GetTypedConfig().UnmarshalTo(globalFilter))
+		assert.Equal(t, "api", globalFilter.GetDomain())
+
+		filters, err = pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~8080"})
+		require.NoError(t, err)
+		assert.Empty(t, filters)
+	})
+
+	t.Run("route policy applies to the route", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		route := newRoute()
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: &rateLimitPolicy{global: global("api")}}, route)
+		require.NoError(t, err)
+
+		assert.Empty(t, route.GetTypedPerFilterConfig())
+		assert.Len(t, route.GetRoute().GetRateLimits(), 1)
+
+		filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+		require.NoError(t, err)
+		require.Len(t, filters, 1)
+		assert.Equal(t, GlobalRateLimitFilterName, filters[0].Filter.GetName())
+	})
+
+	t.Run("conflicting global rate limits on the same filter chain", func(t *testing.T) {
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: &rateLimitPolicy{global: global("api")}}, newRoute())
+		require.NoError(t, err)
+
+		route := newRoute()
+		err = pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: &rateLimitPolicy{global: global("other")}}, route)
+		assert.Error(t, err)
+		assert.Empty(t, route.GetRoute().GetRateLimits())
+
+		// a different filter chain can use another domain
+		err = pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~8080", Policy: &rateLimitPolicy{global: global("other")}}, newRoute())
+		assert.NoError(t, err)
+	})
+}
+
+func TestConvertTargetRef(t *testing.T) {
+	refs := convert(v1alpha1.LocalPolicyTargetReferenceWithSectionName{
+		LocalPolicyTargetReference: v1alpha1.LocalPolicyTargetReference{
+			Group: gwv1.GroupName,
+			Kind:  "HTTPRoute",
+			Name:  "route",
+		},
+		SectionName: ptr.To[gwv1.SectionName]("rule"),
+	})
+	assert.Equal(t, []ir.PolicyTargetRef{{
+		Group:       gwv1.GroupName,
+		Kind:        "HTTPRoute",
+		Name:        "route",
+		SectionName: "rule",
+	}}, refs)
+}
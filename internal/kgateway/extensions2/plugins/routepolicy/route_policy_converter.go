@@ -0,0 +1,204 @@
+package routepolicy
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyfaultcommon "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoyfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+const retriableStatusCodes = "retriable-status-codes"
+
+// convertRoutePolicy translates the RoutePolicy spec to the envoy config applied to each route it targets.
+func convertRoutePolicy(policyCR *v1alpha1.RoutePolicy) (*routePolicy, error) {
+	spec := policyCR.Spec
+	out := &routePolicy{
+		ct:    policyCR.CreationTimestamp.Time,
+		retry: convertRetryPolicy(spec.Retry),
+	}
+
+	if spec.Timeout > 0 {
+		out.timeout = durationpb.New(time.Second * time.Duration(spec.Timeout))
+	}
+	if spec.Timeouts != nil {
+		if spec.Timeouts.Request != nil {
+			out.timeout = durationpb.New(spec.Timeouts.Request.Duration)
+		}
+		if spec.Timeouts.Idle != nil {
+			out.idleTimeout = durationpb.New(spec.Timeouts.Idle.Duration)
+		}
+	}
+
+	out.hashPolicies = convertHashPolicies(spec.HashPolicies)
+	out.tracing, out.decorator = convertTracing(spec.Tracing)
+
+	fault, err := convertFault(spec.Fault)
+	if err != nil {
+		return out, err
+	}
+	out.fault = fault
+
+	if spec.DisableCompression {
+		disableCompression, err := anypb.New(&envoycompressor.CompressorPerRoute{
+			Override: &envoycompressor.CompressorPerRoute_Disabled{
+				Disabled: true,
+			},
+		})
+		if err != nil {
+			return out, err
+		}
+		out.disableCompression = disableCompression
+	}
+
+	return out, nil
+}
+
+func convertHashPolicies(in []v1alpha1.HashPolicy) []*envoy_config_route_v3.RouteAction_HashPolicy {
+	var out []*envoy_config_route_v3.RouteAction_HashPolicy
+	for _, hp := range in {
+		policy := &envoy_config_route_v3.RouteAction_HashPolicy{
+			Terminal: hp.Terminal,
+		}
+		switch {
+		case hp.Header != nil:
+			policy.PolicySpecifier = &envoy_config_route_v3.RouteAction_HashPolicy_Header_{
+				Header: &envoy_config_route_v3.RouteAction_HashPolicy_Header{
+					HeaderName: hp.Header.Name,
+				},
+			}
+		case hp.Cookie != nil:
+			cookie := &envoy_config_route_v3.RouteAction_HashPolicy_Cookie{
+				Name: hp.Cookie.Name,
+				Path: hp.Cookie.Path,
+			}
+			if hp.Cookie.TTL != nil {
+				cookie.Ttl = durationpb.New(hp.Cookie.TTL.Duration)
+			}
+			policy.PolicySpecifier = &envoy_config_route_v3.RouteAction_HashPolicy_Cookie_{
+				Cookie: cookie,
+			}
+		case hp.SourceIP != nil:
+			policy.PolicySpecifier = &envoy_config_route_v3.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &envoy_config_route_v3.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			}
+		case hp.QueryParameter != nil:
+			policy.PolicySpecifier = &envoy_config_route_v3.RouteAction_HashPolicy_QueryParameter_{
+				QueryParameter: &envoy_config_route_v3.RouteAction_HashPolicy_QueryParameter{
+					Name: hp.QueryParameter.Name,
+				},
+			}
+		default:
+			continue
+		}
+		out = append(out, policy)
+	}
+	return out
+}
+
+func convertRetryPolicy(retry *v1alpha1.RetryPolicy) *envoy_config_route_v3.RetryPolicy {
+	if retry == nil {
+		return nil
+	}
+
+	retryOn := make([]string, 0, len(retry.RetryOn)+1)
+	for _, c := range retry.RetryOn {
+		retryOn = append(retryOn, string(c))
+	}
+	if len(retry.RetriableStatusCodes) > 0 && !slices.Contains(retryOn, retriableStatusCodes) {
+		retryOn = append(retryOn, retriableStatusCodes)
+	}
+
+	out := &envoy_config_route_v3.RetryPolicy{
+		RetryOn: strings.Join(retryOn, ","),
+	}
+	if retry.NumRetries != nil {
+		out.NumRetries = wrapperspb.UInt32(*retry.NumRetries)
+	}
+	if retry.PerTryTimeout != nil {
+		out.PerTryTimeout = durationpb.New(retry.PerTryTimeout.Duration)
+	}
+	if retry.Backoff != nil {
+		out.RetryBackOff = &envoy_config_route_v3.RetryPolicy_RetryBackOff{
+			BaseInterval: durationpb.New(retry.Backoff.BaseInterval.Duration),
+		}
+		if retry.Backoff.MaxInterval != nil {
+			out.GetRetryBackOff().MaxInterval = durationpb.New(retry.Backoff.MaxInterval.Duration)
+		}
+	}
+	for _, code := range retry.RetriableStatusCodes {
+		out.RetriableStatusCodes = append(out.GetRetriableStatusCodes(), uint32(code))
+	}
+	return out
+}
+
+// convertFault returns the per-route config of the fault filter.
+func convertFault(fault *v1alpha1.FaultInjection) (*anypb.Any, error) {
+	if fault == nil {
+		return nil, nil
+	}
+
+	out := &envoyfault.HTTPFault{}
+	if fault.Delay != nil {
+		out.Delay = &envoyfaultcommon.FaultDelay{
+			FaultDelaySecifier: &envoyfaultcommon.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(fault.Delay.FixedDelay.Duration),
+			},
+			Percentage: fractionalPercent(fault.Delay.Percentage),
+		}
+	}
+	if fault.Abort != nil {
+		out.Abort = &envoyfault.FaultAbort{
+			ErrorType: &envoyfault.FaultAbort_HttpStatus{
+				HttpStatus: fault.Abort.HttpStatus,
+			},
+			Percentage: fractionalPercent(fault.Abort.Percentage),
+		}
+	}
+	return anypb.New(out)
+}
+
+// convertTracing returns the tracing overrides and the decorator naming the spans of the route.
+func convertTracing(in *v1alpha1.RouteTracing) (*envoy_config_route_v3.Tracing, *envoy_config_route_v3.Decorator) {
+	if in == nil {
+		return nil, nil
+	}
+	var tracing *envoy_config_route_v3.Tracing
+	if in.ClientSampling != nil || in.RandomSampling != nil || in.OverallSampling != nil {
+		tracing = &envoy_config_route_v3.Tracing{
+			ClientSampling:  fractionalPercent(in.ClientSampling),
+			RandomSampling:  fractionalPercent(in.RandomSampling),
+			OverallSampling: fractionalPercent(in.OverallSampling),
+		}
+	}
+	var decorator *envoy_config_route_v3.Decorator
+	if in.SpanName != "" {
+		decorator = &envoy_config_route_v3.Decorator{
+			Operation: in.SpanName,
+		}
+	}
+	return tracing, decorator
+}
+
+// fractionalPercent converts an optional percentage, defaulting to all requests.
+func fractionalPercent(percentage *uint32) *envoytype.FractionalPercent {
+	numerator := uint32(100)
+	if percentage != nil {
+		numerator = *percentage
+	}
+	return &envoytype.FractionalPercent{
+		Numerator:   numerator,
+		Denominator: envoytype.FractionalPercent_HUNDRED,
+	}
+}
@@ -2,12 +2,21 @@ package routepolicy
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	envoyfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/solo-io/go-utils/contextutils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"istio.io/istio/pkg/kube/krt"
@@ -16,14 +25,26 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	extensionplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/httplistenerpolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
 )
 
+const FaultFilterName = "envoy.filters.http.fault"
+
 type routePolicy struct {
-	ct   time.Time
-	spec v1alpha1.RoutePolicySpec
+	ct          time.Time
+	timeout     *durationpb.Duration
+	idleTimeout *durationpb.Duration
+	retry       *envoy_config_route_v3.RetryPolicy
+	// per-route config of the fault filter
+	fault        *anypb.Any
+	hashPolicies []*envoy_config_route_v3.RouteAction_HashPolicy
+	// per-route config of the compressor filters, set to disable compression
+	disableCompression *anypb.Any
+	tracing            *envoy_config_route_v3.Tracing
+	decorator          *envoy_config_route_v3.Decorator
 }
 
 func (d *routePolicy) CreationTime() time.Time {
@@ -35,10 +56,20 @@ func (d *routePolicy) Equals(in any) bool {
 	if !ok {
 		return false
 	}
-	return d.spec == d2.spec
+	return proto.Equal(d.timeout, d2.timeout) &&
+		proto.Equal(d.idleTimeout, d2.idleTimeout) &&
+		proto.Equal(d.retry, d2.retry) &&
+		proto.Equal(d.fault, d2.fault) &&
+		proto.Equal(d.disableCompression, d2.disableCompression) &&
+		proto.Equal(d.tracing, d2.tracing) &&
+		proto.Equal(d.decorator, d2.decorator) &&
+		slices.EqualFunc(d.hashPolicies, d2.hashPolicies, func(a, b *envoy_config_route_v3.RouteAction_HashPolicy) bool {
+			return proto.Equal(a, b)
+		})
 }
 
 type routePolicyPluginGwPass struct {
+	needFilter map[string]bool
 }
 
 func (p *routePolicyPluginGwPass) ApplyHCM(ctx context.Context, pCtx *ir.HcmContext, out *envoyhttp.HttpConnectionManager) error {
@@ -46,6 +77,11 @@ func (p *routePolicyPluginGwPass) ApplyHCM(ctx context.Context, pCtx *ir.HcmCont
 	return nil
 }
 
+func (p *routePolicyPluginGwPass) ApplyTcpProxy(ctx context.Context, pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	// no op
+	return nil
+}
+
 func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionplug.Plugin {
 	col := krtutil.SetupCollectionDynamic[v1alpha1.RoutePolicy](
 		ctx,
@@ -55,6 +91,13 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 	)
 	gk := v1alpha1.RoutePolicyGVK.GroupKind()
 	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, policyCR *v1alpha1.RoutePolicy) *ir.PolicyWrapper {
+		errors := []error{}
+		policyIR, err := convertRoutePolicy(policyCR)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			errors = append(errors, err)
+		}
+
 		var pol = &ir.PolicyWrapper{
 			ObjectSource: ir.ObjectSource{
 				Group:     gk.Group,
@@ -63,8 +106,9 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 				Name:      policyCR.Name,
 			},
 			Policy:     policyCR,
-			PolicyIR:   &routePolicy{ct: policyCR.CreationTimestamp.Time, spec: policyCR.Spec},
+			PolicyIR:   policyIR,
 			TargetRefs: convert(policyCR.Spec.TargetRef),
+			Errors:     errors,
 		}
 		return pol
 	})
@@ -109,13 +153,77 @@ func (p *routePolicyPluginGwPass) ApplyForRoute(ctx context.Context, pCtx *ir.Ro
 		return nil
 	}
 
-	if policy.spec.Timeout > 0 && outputRoute.GetRoute() != nil {
-		outputRoute.GetRoute().Timeout = durationpb.New(time.Second * time.Duration(policy.spec.Timeout))
+	if action := outputRoute.GetRoute(); action != nil {
+		if policy.timeout != nil {
+			action.Timeout = policy.timeout
+		}
+		if policy.idleTimeout != nil {
+			action.IdleTimeout = policy.idleTimeout
+		}
+		if policy.retry != nil {
+			action.RetryPolicy = policy.retry
+		}
+		if len(policy.hashPolicies) > 0 {
+			action.HashPolicy = policy.hashPolicies
+		}
+	}
+
+	if policy.fault != nil {
+		if outputRoute.GetTypedPerFilterConfig() == nil {
+			outputRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+		outputRoute.GetTypedPerFilterConfig()[FaultFilterName] = policy.fault
+		if p.needFilter == nil {
+			p.needFilter = make(map[string]bool)
+		}
+		p.needFilter[pCtx.FilterChainName] = true
+	}
+
+	if policy.tracing != nil {
+		outputRoute.Tracing = policy.tracing
+	}
+	if policy.decorator != nil {
+		outputRoute.Decorator = policy.decorator
+	}
+
+	if policy.disableCompression != nil {
+		if outputRoute.GetTypedPerFilterConfig() == nil {
+			outputRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+		}
+		for _, name := range httplistenerpolicy.CompressorFilterNames {
+			outputRoute.GetTypedPerFilterConfig()[name] = policy.disableCompression
+		}
 	}
 
+	if len(policy.hashPolicies) > 0 {
+		return validateHashing(pCtx.In.Backends)
+	}
 	return nil
 }
 
+// validateHashing warns when a backend doesn't use a consistent hashing load balancer, in which
+// case the hash policies have no effect.
+func validateHashing(backends []ir.HttpBackend) error {
+	var unhashed []string
+	for _, backend := range backends {
+		upstream := backend.Backend.Upstream
+		if upstream == nil {
+			continue
+		}
+		switch upstream.LbPolicy() {
+		case envoy_config_cluster_v3.Cluster_RING_HASH, envoy_config_cluster_v3.Cluster_MAGLEV:
+		default:
+			unhashed = append(unhashed, upstream.Namespace+"/"+upstream.Name)
+		}
+	}
+	if len(unhashed) == 0 {
+		return nil
+	}
+	return &ir.PolicyWarning{
+		Message: fmt.Sprintf("hash policies have no effect, backends %s are not configured for ring hash or maglev load balancing", strings.Join(unhashed, ", ")),
+	}
+}
+
 func (p *routePolicyPluginGwPass) ApplyForRouteBackend(
 	ctx context.Context,
 	policy ir.PolicyIR,
@@ -128,7 +236,15 @@ func (p *routePolicyPluginGwPass) ApplyForRouteBackend(
 // if a plugin emits new filters, they must be with a plugin unique name.
 // any filter returned from route config must be disabled, so it doesnt impact other routes.
 func (p *routePolicyPluginGwPass) HttpFilters(ctx context.Context, fcc ir.FilterChainCommon) ([]plugins.StagedHttpFilter, error) {
-	return nil, nil
+	if !p.needFilter[fcc.FilterChainName] {
+		return nil, nil
+	}
+	// faults are configured per route; the listener level filter is a no-op.
+	f, err := plugins.NewStagedFilter(FaultFilterName, &envoyfault.HTTPFault{}, plugins.DuringStage(plugins.FaultStage))
+	if err != nil {
+		return nil, err
+	}
+	return []plugins.StagedHttpFilter{f}, nil
 }
 
 func (p *routePolicyPluginGwPass) UpstreamHttpFilters(ctx context.Context) ([]plugins.StagedUpstreamHttpFilter, error) {
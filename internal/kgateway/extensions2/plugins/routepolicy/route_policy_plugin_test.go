@@ -0,0 +1,307 @@
+package routepolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyfaultcommon "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoyfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/httplistenerpolicy"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func TestConvertRetryPolicy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		retry    *v1alpha1.RetryPolicy
+		expected *envoy_config_route_v3.RetryPolicy
+	}{
+		{
+			name:     "Nil",
+			retry:    nil,
+			expected: nil,
+		},
+		{
+			name: "Full",
+			retry: &v1alpha1.RetryPolicy{
+				RetryOn:       []v1alpha1.RetryOnCondition{"5xx", "connect-failure"},
+				NumRetries:    ptr.To[uint32](3),
+				PerTryTimeout: &metav1.Duration{Duration: 2 * time.Second},
+				Backoff: &v1alpha1.RetryBackoff{
+					BaseInterval: metav1.Duration{Duration: 100 * time.Millisecond},
+					MaxInterval:  &metav1.Duration{Duration: time.Second},
+				},
+			},
+			expected: &envoy_config_route_v3.RetryPolicy{
+				RetryOn:       "5xx,connect-failure",
+				NumRetries:    wrapperspb.UInt32(3),
+				PerTryTimeout: durationpb.New(2 * time.Second),
+				RetryBackOff: &envoy_config_route_v3.RetryPolicy_RetryBackOff{
+					BaseInterval: durationpb.New(100 * time.Millisecond),
+					MaxInterval:  durationpb.New(time.Second),
+				},
+			},
+		},
+		{
+			name: "RetriableStatusCodes",
+			retry: &v1alpha1.RetryPolicy{
+				RetryOn:              []v1alpha1.RetryOnCondition{"reset"},
+				RetriableStatusCodes: []v1alpha1.HTTPStatusCode{503, 504},
+			},
+			expected: &envoy_config_route_v3.RetryPolicy{
+				RetryOn:              "reset,retriable-status-codes",
+				RetriableStatusCodes: []uint32{503, 504},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := convertRetryPolicy(tc.retry)
+			assert.True(t, proto.Equal(tc.expected, actual), "expected %v, got %v", tc.expected, actual)
+		})
+	}
+}
+
+func TestConvertRoutePolicyTimeouts(t *testing.T) {
+	t.Run("Timeouts.Request takes precedence over Timeout", func(t *testing.T) {
+		pol, err := convertRoutePolicy(&v1alpha1.RoutePolicy{
+			Spec: v1alpha1.RoutePolicySpec{
+				Timeout: 5,
+				Timeouts: &v1alpha1.Timeouts{
+					Request: &metav1.Duration{Duration: 1500 * time.Millisecond},
+					Idle:    &metav1.Duration{Duration: time.Minute},
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(durationpb.New(1500*time.Millisecond), pol.timeout))
+		assert.True(t, proto.Equal(durationpb.New(time.Minute), pol.idleTimeout))
+	})
+
+	t.Run("Timeout in seconds", func(t *testing.T) {
+		pol, err := convertRoutePolicy(&v1alpha1.RoutePolicy{
+			Spec: v1alpha1.RoutePolicySpec{Timeout: 5},
+		})
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(durationpb.New(5*time.Second), pol.timeout))
+		assert.Nil(t, pol.idleTimeout)
+	})
+}
+
+func TestApplyFault(t *testing.T) {
+	pol, err := convertRoutePolicy(&v1alpha1.RoutePolicy{
+		Spec: v1alpha1.RoutePolicySpec{
+			Fault: &v1alpha1.FaultInjection{
+				Delay: &v1alpha1.FaultDelay{
+					FixedDelay: metav1.Duration{Duration: time.Second},
+				},
+				Abort: &v1alpha1.FaultAbort{
+					HttpStatus: 503,
+					Percentage: ptr.To[uint32](10),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+	route := &envoy_config_route_v3.Route{
+		Action: &envoy_config_route_v3.Route_Route{
+			Route: &envoy_config_route_v3.RouteAction{},
+		},
+	}
+	err = pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: pol}, route)
+	require.NoError(t, err)
+
+	perRoute := &envoyfault.HTTPFault{}
+	require.Contains(t, route.GetTypedPerFilterConfig(), FaultFilterName)
+	require.NoError(t, route.GetTypedPerFilterConfig()[FaultFilterName].UnmarshalTo(perRoute))
+	expected := &envoyfault.HTTPFault{
+		Delay: &envoyfaultcommon.FaultDelay{
+			FaultDelaySecifier: &envoyfaultcommon.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(time.Second),
+			},
+			Percentage: &envoytype.FractionalPercent{
+				Numerator:   100,
+				Denominator: envoytype.FractionalPercent_HUNDRED,
+			},
+		},
+		Abort: &envoyfault.FaultAbort{
+			ErrorType: &envoyfault.FaultAbort_HttpStatus{HttpStatus: 503},
+			Percentage: &envoytype.FractionalPercent{
+				Numerator:   10,
+				Denominator: envoytype.FractionalPercent_HUNDRED,
+			},
+		},
+	}
+	assert.True(t, proto.Equal(expected, perRoute), "expected %v, got %v", expected, perRoute)
+
+	// the fault filter is only added to the filter chain of the route
+	filters, err := pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~80"})
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, FaultFilterName, filters[0].Filter.GetName())
+
+	filters, err = pass.HttpFilters(ctx, ir.FilterChainCommon{FilterChainName: "listener~8080"})
+	require.NoError(t, err)
+	assert.Empty(t, filters)
+}
+
+// lbPolicy is an upstream policy setting the load balancing policy of the cluster.
+type lbPolicy struct {
+	lbPolicy envoy_config_cluster_v3.Cluster_LbPolicy
+}
+
+func (p *lbPolicy) CreationTime() time.Time { return time.Time{} }
+func (p *lbPolicy) Equals(in any) bool      { return false }
+func (p *lbPolicy) LbPolicy() (envoy_config_cluster_v3.Cluster_LbPolicy, bool) {
+	return p.lbPolicy, true
+}
+
+func backendWithLbPolicy(name string, lb envoy_config_cluster_v3.Cluster_LbPolicy) ir.HttpBackend {
+	return ir.HttpBackend{
+		Backend: ir.Backend{
+			Upstream: &ir.Upstream{
+				ObjectSource: ir.ObjectSource{Kind: "Service", Namespace: "default", Name: name},
+				Port:         80,
+				AttachedPolicies: ir.AttachedPolicies{
+					Policies: map[schema.GroupKind][]ir.PolicyAtt{
+						{Group: "gateway.kgateway.dev", Kind: "UpstreamPolicy"}: {{PolicyIr: &lbPolicy{lbPolicy: lb}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyHashPolicies(t *testing.T) {
+	pol, err := convertRoutePolicy(&v1alpha1.RoutePolicy{
+		Spec: v1alpha1.RoutePolicySpec{
+			HashPolicies: []v1alpha1.HashPolicy{
+				{Cookie: &v1alpha1.CookieHashPolicy{Name: "session", TTL: &metav1.Duration{Duration: time.Hour}, Path: "/"}},
+				{Header: &v1alpha1.HeaderHashPolicy{Name: "x-user"}, Terminal: true},
+				{SourceIP: &v1alpha1.SourceIPHashPolicy{}},
+				{QueryParameter: &v1alpha1.QueryParameterHashPolicy{Name: "user"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	apply := func(backends ...ir.HttpBackend) (*envoy_config_route_v3.Route, error) {
+		ctx := context.Background()
+		pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+		route := &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: &envoy_config_route_v3.RouteAction{},
+			},
+		}
+		err := pass.ApplyForRoute(ctx, &ir.RouteContext{Policy: pol, In: ir.HttpRouteRuleMatchIR{Backends: backends}}, route)
+		return route, err
+	}
+
+	t.Run("hash policies", func(t *testing.T) {
+		route, err := apply(backendWithLbPolicy("ring", envoy_config_cluster_v3.Cluster_RING_HASH))
+		require.NoError(t, err)
+		hashPolicies := route.GetRoute().GetHashPolicy()
+		require.Len(t, hashPolicies, 4)
+		assert.Equal(t, "session", hashPolicies[0].GetCookie().GetName())
+		assert.Equal(t, time.Hour, hashPolicies[0].GetCookie().GetTtl().AsDuration())
+		assert.Equal(t, "/", hashPolicies[0].GetCookie().GetPath())
+		assert.Equal(t, "x-user", hashPolicies[1].GetHeader().GetHeaderName())
+		assert.True(t, hashPolicies[1].GetTerminal())
+		assert.True(t, hashPolicies[2].GetConnectionProperties().GetSourceIp())
+		assert.Equal(t, "user", hashPolicies[3].GetQueryParameter().GetName())
+	})
+
+	t.Run("warns about backends without consistent hashing", func(t *testing.T) {
+		route, err := apply(
+			backendWithLbPolicy("hashed", envoy_config_cluster_v3.Cluster_MAGLEV),
+			backendWithLbPolicy("rr", envoy_config_cluster_v3.Cluster_ROUND_ROBIN),
+		)
+		var warning *ir.PolicyWarning
+		require.ErrorAs(t, err, &warning)
+		assert.Contains(t, warning.Message, "default/rr")
+		assert.NotContains(t, warning.Message, "default/hashed")
+		// the hash policies are still applied
+		assert.Len(t, route.GetRoute().GetHashPolicy(), 4)
+	})
+}
+
+func TestApplyDisableCompression(t *testing.T) {
+	pol, err := convertRoutePolicy(&v1alpha1.RoutePolicy{
+		Spec: v1alpha1.RoutePolicySpec{
+			DisableCompression: true,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+	route := &envoy_config_route_v3.Route{}
+	err = pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: pol}, route)
+	require.NoError(t, err)
+
+	for _, name := range httplistenerpolicy.CompressorFilterNames {
+		perRoute := &envoycompressor.CompressorPerRoute{}
+		require.Contains(t, route.GetTypedPerFilterConfig(), name)
+		require.NoError(t, route.GetTypedPerFilterConfig()[name].UnmarshalTo(perRoute))
+		assert.True(t, perRoute.GetDisabled())
+	}
+}
+
+func TestApplyTracing(t *testing.T) {
+	pol, err := convertRoutePolicy(&v1alpha1.RoutePolicy{
+		Spec: v1alpha1.RoutePolicySpec{
+			Tracing: &v1alpha1.RouteTracing{
+				SpanName:       "checkout",
+				RandomSampling: ptr.To[uint32](5),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	pass := NewGatewayTranslationPass(ctx, ir.GwTranslationCtx{})
+	route := &envoy_config_route_v3.Route{}
+	err = pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: pol}, route)
+	require.NoError(t, err)
+
+	all := &envoytype.FractionalPercent{Numerator: 100, Denominator: envoytype.FractionalPercent_HUNDRED}
+	expected := &envoy_config_route_v3.Tracing{
+		ClientSampling:  all,
+		RandomSampling:  &envoytype.FractionalPercent{Numerator: 5, Denominator: envoytype.FractionalPercent_HUNDRED},
+		OverallSampling: all,
+	}
+	assert.True(t, proto.Equal(expected, route.GetTracing()), "expected %v, got %v", expected, route.GetTracing())
+	assert.Equal(t, "checkout", route.GetDecorator().GetOperation())
+
+	// the span name alone doesn't override the sampling of the listener
+	pol, err = convertRoutePolicy(&v1alpha1.RoutePolicy{
+		Spec: v1alpha1.RoutePolicySpec{
+			Tracing: &v1alpha1.RouteTracing{SpanName: "checkout"},
+		},
+	})
+	require.NoError(t, err)
+	route = &envoy_config_route_v3.Route{}
+	err = pass.ApplyForRoute(ctx, &ir.RouteContext{FilterChainName: "listener~80", Policy: pol}, route)
+	require.NoError(t, err)
+	assert.Nil(t, route.GetTracing())
+	assert.Equal(t, "checkout", route.GetDecorator().GetOperation())
+}
@@ -0,0 +1,116 @@
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+)
+
+const defaultDnsRefreshRate = 5 * time.Second
+
+func processDns(ctx context.Context, in *v1alpha1.DnsUpstream, ir *UpstreamIr, out *envoy_config_cluster_v3.Cluster) error {
+	clusterType := envoy_config_cluster_v3.Cluster_STRICT_DNS
+	if in.LookupType == v1alpha1.DnsLookupTypeLogical {
+		// envoy rejects a logical dns cluster with more than one host, which fails the whole CDS
+		// update of the gateway, so the upstream is reported rather than translated
+		if len(in.Hosts) != 1 {
+			return fmt.Errorf("logical DNS upstreams must have exactly one host, got %d", len(in.Hosts))
+		}
+		clusterType = envoy_config_cluster_v3.Cluster_LOGICAL_DNS
+	}
+	out.ClusterDiscoveryType = &envoy_config_cluster_v3.Cluster_Type{
+		Type: clusterType,
+	}
+
+	refreshRate := defaultDnsRefreshRate
+	if in.RefreshRate != nil {
+		refreshRate = in.RefreshRate.Duration
+	}
+	out.DnsRefreshRate = durationpb.New(refreshRate)
+	out.RespectDnsTtl = in.RespectDnsTtl
+
+	localityEndpoints := &envoy_config_endpoint_v3.LocalityLbEndpoints{}
+	for _, host := range in.Hosts {
+		endpoint := pluginutils.EnvoyEndpoint(host.Host, uint32(host.Port))
+		endpoint.Hostname = host.Host
+		localityEndpoints.LbEndpoints = append(localityEndpoints.LbEndpoints, &envoy_config_endpoint_v3.LbEndpoint{
+			HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+				Endpoint: endpoint,
+			},
+		})
+	}
+	out.LoadAssignment = &envoy_config_endpoint_v3.ClusterLoadAssignment{
+		ClusterName: out.GetName(),
+		Endpoints:   []*envoy_config_endpoint_v3.LocalityLbEndpoints{localityEndpoints},
+	}
+
+	if in.Tls != nil {
+		transportSocket, err := dnsTransportSocket(in, ir)
+		if err != nil {
+			return err
+		}
+		out.TransportSocket = transportSocket
+	}
+
+	if in.Http2 {
+		if err := utils.SetHttp2options(out); err != nil {
+			return err
+		}
+	}
+
+	if hc := pluginutils.ToEnvoyHealthCheck(in.HealthCheck); hc != nil {
+		out.HealthChecks = []*envoy_config_core_v3.HealthCheck{hc}
+	}
+	return nil
+}
+
+// dnsTransportSocket returns the tls transport socket originating TLS to the hosts of the upstream.
+func dnsTransportSocket(in *v1alpha1.DnsUpstream, ir *UpstreamIr) (*envoy_config_core_v3.TransportSocket, error) {
+	sni := in.Tls.Sni
+	if sni == "" {
+		sni = in.Hosts[0].Host
+	}
+
+	var validation *envoyauth.CertificateValidationContext
+	if !in.Tls.InsecureSkipVerify {
+		caCerts := ir.dnsCACerts()
+		if len(caCerts) != len(in.Tls.CACertificateRefs) {
+			// never fall back to the system bundle when the referenced CA certificates are missing
+			return nil, fmt.Errorf("found %d of the %d referenced CA certificates", len(caCerts), len(in.Tls.CACertificateRefs))
+		}
+		validation = &envoyauth.CertificateValidationContext{
+			TrustedCa:                 pluginutils.TrustedCA(caCerts),
+			MatchTypedSubjectAltNames: subjectAltNames(in.Tls, sni),
+		}
+	}
+	return pluginutils.UpstreamTlsTransportSocket(sni, validation)
+}
+
+// subjectAltNames returns the SANs the backend certificate must match, which default to the SNI.
+func subjectAltNames(in *v1alpha1.UpstreamTls, sni string) []*envoyauth.SubjectAltNameMatcher {
+	names := in.SubjectAltNames
+	if len(names) == 0 {
+		names = []string{sni}
+	}
+
+	out := make([]*envoyauth.SubjectAltNameMatcher, 0, len(names))
+	for _, name := range names {
+		out = append(out, pluginutils.SanMatcher(envoyauth.SubjectAltNameMatcher_DNS, name))
+	}
+	return out
+}
+
+func processEndpointsDns(in *v1alpha1.DnsUpstream) *ir.EndpointsForUpstream {
+	return nil
+}
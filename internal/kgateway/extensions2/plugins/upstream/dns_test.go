@@ -0,0 +1,129 @@
+package upstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const testCACert = "-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----"
+
+func dnsCluster(t *testing.T, in *v1alpha1.DnsUpstream, upIr *UpstreamIr) *envoy_config_cluster_v3.Cluster {
+	out := &envoy_config_cluster_v3.Cluster{Name: "upstream_default_example_0"}
+	require.NoError(t, processDns(context.Background(), in, upIr, out))
+	return out
+}
+
+func upstreamTlsContext(t *testing.T, out *envoy_config_cluster_v3.Cluster) *envoyauth.UpstreamTlsContext {
+	require.NotNil(t, out.GetTransportSocket())
+	tls := &envoyauth.UpstreamTlsContext{}
+	require.NoError(t, out.GetTransportSocket().GetTypedConfig().UnmarshalTo(tls))
+	return tls
+}
+
+func TestProcessDns(t *testing.T) {
+	hosts := []v1alpha1.Host{{Host: "api.example.com", Port: 443}, {Host: "api2.example.com", Port: 443}}
+
+	t.Run("strict dns with default refresh rate", func(t *testing.T) {
+		out := dnsCluster(t, &v1alpha1.DnsUpstream{Hosts: hosts}, &UpstreamIr{})
+		assert.Equal(t, envoy_config_cluster_v3.Cluster_STRICT_DNS, out.GetType())
+		assert.Equal(t, defaultDnsRefreshRate, out.GetDnsRefreshRate().AsDuration())
+		require.Len(t, out.GetLoadAssignment().GetEndpoints(), 1)
+		endpoints := out.GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()
+		require.Len(t, endpoints, 2)
+		assert.Equal(t, "api2.example.com", endpoints[1].GetEndpoint().GetAddress().GetSocketAddress().GetAddress())
+		assert.Nil(t, out.GetTransportSocket())
+		assert.Empty(t, out.GetHealthChecks())
+	})
+
+	t.Run("logical dns respecting the ttl", func(t *testing.T) {
+		out := dnsCluster(t, &v1alpha1.DnsUpstream{
+			Hosts:         hosts[:1],
+			LookupType:    v1alpha1.DnsLookupTypeLogical,
+			RefreshRate:   &metav1.Duration{Duration: time.Minute},
+			RespectDnsTtl: true,
+		}, &UpstreamIr{})
+		assert.Equal(t, envoy_config_cluster_v3.Cluster_LOGICAL_DNS, out.GetType())
+		assert.Equal(t, time.Minute, out.GetDnsRefreshRate().AsDuration())
+		assert.True(t, out.GetRespectDnsTtl())
+	})
+
+	t.Run("logical dns with several hosts", func(t *testing.T) {
+		out := &envoy_config_cluster_v3.Cluster{Name: "upstream_default_example_0"}
+		err := processDns(context.Background(), &v1alpha1.DnsUpstream{
+			Hosts:      hosts,
+			LookupType: v1alpha1.DnsLookupTypeLogical,
+		}, &UpstreamIr{}, out)
+		assert.ErrorContains(t, err, "exactly one host")
+		assert.Nil(t, out.GetLoadAssignment())
+	})
+
+	t.Run("tls with system CA certificates and the first host as sni", func(t *testing.T) {
+		out := dnsCluster(t, &v1alpha1.DnsUpstream{Hosts: hosts, Tls: &v1alpha1.UpstreamTls{}}, &UpstreamIr{})
+		tls := upstreamTlsContext(t, out)
+		assert.Equal(t, "api.example.com", tls.GetSni())
+		validation := tls.GetCommonTlsContext().GetValidationContext()
+		assert.Equal(t, pluginutils.SystemCACertsFile, validation.GetTrustedCa().GetFilename())
+		require.Len(t, validation.GetMatchTypedSubjectAltNames(), 1)
+		assert.Equal(t, "api.example.com", validation.GetMatchTypedSubjectAltNames()[0].GetMatcher().GetExact())
+	})
+
+	t.Run("tls with CA certificate refs and SANs", func(t *testing.T) {
+		upIr := &UpstreamIr{DnsCASecrets: []*ir.Secret{{Data: map[string][]byte{pluginutils.CACertKey: []byte(testCACert)}}}}
+		out := dnsCluster(t, &v1alpha1.DnsUpstream{Hosts: hosts, Tls: &v1alpha1.UpstreamTls{
+			Sni:               "sni.example.com",
+			SubjectAltNames:   []string{"a.example.com", "b.example.com"},
+			CACertificateRefs: []corev1.LocalObjectReference{{Name: "ca"}},
+		}}, upIr)
+		tls := upstreamTlsContext(t, out)
+		assert.Equal(t, "sni.example.com", tls.GetSni())
+		validation := tls.GetCommonTlsContext().GetValidationContext()
+		assert.Equal(t, testCACert, validation.GetTrustedCa().GetInlineString())
+		assert.Len(t, validation.GetMatchTypedSubjectAltNames(), 2)
+	})
+
+	t.Run("tls with missing CA certificates is not plaintext", func(t *testing.T) {
+		out := &envoy_config_cluster_v3.Cluster{Name: "upstream_default_example_0"}
+		err := processDns(context.Background(), &v1alpha1.DnsUpstream{Hosts: hosts, Tls: &v1alpha1.UpstreamTls{
+			CACertificateRefs: []corev1.LocalObjectReference{{Name: "missing"}},
+		}}, &UpstreamIr{}, out)
+		require.Error(t, err)
+		assert.Nil(t, out.GetTransportSocket())
+	})
+
+	t.Run("tls skipping verification", func(t *testing.T) {
+		out := dnsCluster(t, &v1alpha1.DnsUpstream{Hosts: hosts, Tls: &v1alpha1.UpstreamTls{InsecureSkipVerify: true}}, &UpstreamIr{})
+		tls := upstreamTlsContext(t, out)
+		assert.Nil(t, tls.GetCommonTlsContext().GetValidationContext())
+	})
+
+	t.Run("http2 and health checks", func(t *testing.T) {
+		out := dnsCluster(t, &v1alpha1.DnsUpstream{
+			Hosts: hosts,
+			Http2: true,
+			HealthCheck: &v1alpha1.HealthCheck{
+				Http: &v1alpha1.HttpHealthCheck{
+					Path:             "/healthz",
+					ExpectedStatuses: []v1alpha1.HTTPStatusRange{{Start: 200, End: 299}},
+				},
+			},
+		}, &UpstreamIr{})
+		assert.Contains(t, out.GetTypedExtensionProtocolOptions(), "envoy.extensions.upstreams.http.v3.HttpProtocolOptions")
+		require.Len(t, out.GetHealthChecks(), 1)
+		hc := out.GetHealthChecks()[0]
+		assert.Equal(t, "/healthz", hc.GetHttpHealthCheck().GetPath())
+		assert.Equal(t, int64(300), hc.GetHttpHealthCheck().GetExpectedStatuses()[0].GetEnd())
+		assert.Equal(t, uint32(2), hc.GetUnhealthyThreshold().GetValue())
+	})
+}
@@ -3,7 +3,9 @@ package upstream
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"maps"
+	"slices"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -14,6 +16,7 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	awspb "github.com/solo-io/envoy-gloo/go/config/filter/http/aws_lambda/v2"
 	skubeclient "istio.io/istio/pkg/config/schema/kubeclient"
 	"istio.io/istio/pkg/kube/kclient"
@@ -24,6 +27,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
@@ -64,6 +68,8 @@ func (d *upstreamDestination) Equals(in any) bool {
 
 type UpstreamIr struct {
 	AwsSecret *ir.Secret
+	// DnsCASecrets are the secrets holding the CA certificates of a dns upstream with tls.
+	DnsCASecrets []*ir.Secret
 }
 
 func (u *UpstreamIr) data() map[string][]byte {
@@ -73,6 +79,16 @@ func (u *UpstreamIr) data() map[string][]byte {
 	return u.AwsSecret.Data
 }
 
+func (u *UpstreamIr) dnsCACerts() []string {
+	var out []string
+	for _, secret := range u.DnsCASecrets {
+		if ca, ok := secret.Data[pluginutils.CACertKey]; ok {
+			out = append(out, string(ca))
+		}
+	}
+	return out
+}
+
 func (u *UpstreamIr) Equals(other any) bool {
 	otherUpstream, ok := other.(*UpstreamIr)
 	if !ok {
@@ -80,7 +96,7 @@ func (u *UpstreamIr) Equals(other any) bool {
 	}
 	return maps.EqualFunc(u.data(), otherUpstream.data(), func(a, b []byte) bool {
 		return bytes.Equal(a, b)
-	})
+	}) && slices.Equal(u.dnsCACerts(), otherUpstream.dnsCACerts())
 }
 
 type upstreamPlugin struct {
@@ -109,6 +125,7 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 	translate := buildTranslateFunc(commoncol.Secrets)
 	ucol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) *ir.Upstream {
 		// resolve secrets
+		objIr, errs := translate(krtctx, i)
 		return &ir.Upstream{
 			ObjectSource: ir.ObjectSource{
 				Kind:      gk.Kind,
@@ -119,7 +136,8 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 			GvPrefix:          "upstream",
 			CanonicalHostname: hostname(i),
 			Obj:               i,
-			ObjIr:             translate(krtctx, i),
+			ObjIr:             objIr,
+			Errors:            errs,
 		}
 	})
 
@@ -152,38 +170,55 @@ func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensi
 	}
 }
 
-func buildTranslateFunc(secrets *krtcollections.SecretIndex) func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) *UpstreamIr {
-	return func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) *UpstreamIr {
+func buildTranslateFunc(secrets *krtcollections.SecretIndex) func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) (*UpstreamIr, []error) {
+	return func(krtctx krt.HandlerContext, i *v1alpha1.Upstream) (*UpstreamIr, []error) {
 		// resolve secrets
 		var ir UpstreamIr
-		if i.Spec.Aws != nil {
-			ns := i.GetNamespace()
+		var errs []error
+		from := krtcollections.From{GroupKind: v1alpha1.UpstreamGVK.GroupKind(), Namespace: i.GetNamespace()}
+		if i.Spec.Aws != nil && i.Spec.Aws.SecretRef.Name != "" {
 			secretRef := gwv1.SecretObjectReference{
 				Name: gwv1.ObjectName(i.Spec.Aws.SecretRef.Name),
 			}
-			secret, _ := secrets.GetSecret(krtctx, krtcollections.From{GroupKind: v1alpha1.UpstreamGVK.GroupKind(), Namespace: ns}, secretRef)
-			if secret != nil {
-				ir.AwsSecret = secret
+			secret, err := secrets.GetSecret(krtctx, from, secretRef)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to resolve the aws secret %s: %w", secretRef.Name, err))
 			} else {
-				// TODO: handle error and write it to status
-				// return error
+				ir.AwsSecret = secret
+			}
+		}
+		if i.Spec.Dns != nil && i.Spec.Dns.Tls != nil {
+			for _, ref := range i.Spec.Dns.Tls.CACertificateRefs {
+				secretRef := gwv1.SecretObjectReference{
+					Name: gwv1.ObjectName(ref.Name),
+				}
+				secret, err := secrets.GetSecret(krtctx, from, secretRef)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to resolve the CA certificate secret %s: %w", secretRef.Name, err))
+					continue
+				}
+				if _, ok := secret.Data[pluginutils.CACertKey]; !ok {
+					errs = append(errs, fmt.Errorf("CA certificate secret %s has no %s key", secretRef.Name, pluginutils.CACertKey))
+					continue
+				}
+				ir.DnsCASecrets = append(ir.DnsCASecrets, secret)
 			}
 		}
-		return &ir
+		return &ir, errs
 	}
 }
 
-func processUpstream(ctx context.Context, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) {
+func processUpstream(ctx context.Context, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) error {
 	up, ok := in.Obj.(*v1alpha1.Upstream)
 	if !ok {
 		// log - should never happen
-		return
+		return nil
 	}
 
 	ir, ok := in.ObjIr.(*UpstreamIr)
 	if !ok {
 		// log - should never happen
-		return
+		return nil
 	}
 
 	spec := up.Spec
@@ -193,7 +228,10 @@ func processUpstream(ctx context.Context, in ir.Upstream, out *envoy_config_clus
 		processStatic(ctx, spec.Static, out)
 	case spec.Aws != nil:
 		processAws(ctx, spec.Aws, ir, out)
+	case spec.Dns != nil:
+		return processDns(ctx, spec.Dns, ir, out)
 	}
+	return nil
 }
 
 func hostname(in *v1alpha1.Upstream) string {
@@ -202,6 +240,11 @@ func hostname(in *v1alpha1.Upstream) string {
 			return string(in.Spec.Static.Hosts[0].Host)
 		}
 	}
+	if in.Spec.Dns != nil {
+		if len(in.Spec.Dns.Hosts) > 0 {
+			return in.Spec.Dns.Hosts[0].Host
+		}
+	}
 	return ""
 }
 
@@ -212,6 +255,8 @@ func processEndpoints(up *v1alpha1.Upstream) *ir.EndpointsForUpstream {
 		return processEndpointsStatic(spec.Static)
 	case spec.Aws != nil:
 		return processEndpointsAws(spec.Aws)
+	case spec.Dns != nil:
+		return processEndpointsDns(spec.Dns)
 	}
 	return nil
 }
@@ -234,6 +279,11 @@ func (p *upstreamPlugin) ApplyHCM(ctx context.Context,
 	return nil
 }
 
+func (p *upstreamPlugin) ApplyTcpProxy(ctx context.Context, pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	// no-op
+	return nil
+}
+
 func (p *upstreamPlugin) ApplyVhostPlugin(ctx context.Context, pCtx *ir.VirtualHostContext, out *envoy_config_route_v3.VirtualHost) {
 }
 
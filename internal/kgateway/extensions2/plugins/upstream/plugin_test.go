@@ -0,0 +1,67 @@
+package upstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+)
+
+func TestTranslateDnsCACertificates(t *testing.T) {
+	secretGK := schema.GroupKind{Kind: "Secret"}
+	secret := func(name string, data map[string][]byte) ir.Secret {
+		return ir.Secret{
+			ObjectSource: ir.ObjectSource{Kind: "Secret", Namespace: "default", Name: name},
+			Data:         data,
+		}
+	}
+	secretsCol := krt.NewStaticCollection([]ir.Secret{
+		secret("ca", map[string][]byte{pluginutils.CACertKey: []byte(testCACert)}),
+		secret("no-ca", map[string][]byte{"tls.crt": []byte(testCACert)}),
+	})
+	refgrants := krtcollections.NewRefGrantIndex(krttest.GetMockCollection[*gwv1beta1.ReferenceGrant](krttest.NewMock(t, nil)))
+	translate := buildTranslateFunc(krtcollections.NewSecretIndex(map[schema.GroupKind]krt.Collection[ir.Secret]{secretGK: secretsCol}, refgrants))
+
+	upstream := func(refs ...string) *v1alpha1.Upstream {
+		tls := &v1alpha1.UpstreamTls{}
+		for _, ref := range refs {
+			tls.CACertificateRefs = append(tls.CACertificateRefs, corev1.LocalObjectReference{Name: ref})
+		}
+		return &v1alpha1.Upstream{
+			ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+			Spec: v1alpha1.UpstreamSpec{
+				Dns: &v1alpha1.DnsUpstream{Hosts: []v1alpha1.Host{{Host: "api.example.com", Port: 443}}, Tls: tls},
+			},
+		}
+	}
+
+	t.Run("resolved CA certificates", func(t *testing.T) {
+		upIr, errs := translate(krt.TestingDummyContext{}, upstream("ca"))
+		assert.Empty(t, errs)
+		assert.Equal(t, []string{testCACert}, upIr.dnsCACerts())
+	})
+
+	t.Run("missing CA certificate secret", func(t *testing.T) {
+		upIr, errs := translate(krt.TestingDummyContext{}, upstream("ca", "missing"))
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "missing")
+		assert.Len(t, upIr.dnsCACerts(), 1)
+	})
+
+	t.Run("CA certificate secret without the CA key", func(t *testing.T) {
+		_, errs := translate(krt.TestingDummyContext{}, upstream("no-ca"))
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], pluginutils.CACertKey)
+	})
+}
@@ -0,0 +1,175 @@
+package upstreampolicy
+
+import (
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+)
+
+// convertUpstreamPolicy returns the policy with the cluster fields it sets.
+func convertUpstreamPolicy(spec v1alpha1.UpstreamPolicySpec) *upstreamPolicy {
+	cluster := &envoy_config_cluster_v3.Cluster{
+		ConnectTimeout:   duration(spec.ConnectTimeout),
+		CircuitBreakers:  convertCircuitBreakers(spec.CircuitBreakers),
+		OutlierDetection: convertOutlierDetection(spec.OutlierDetection),
+	}
+	if hc := pluginutils.ToEnvoyHealthCheck(spec.HealthCheck); hc != nil {
+		cluster.HealthChecks = []*envoy_config_core_v3.HealthCheck{hc}
+	}
+	if keepalive := convertTcpKeepalive(spec.TcpKeepalive); keepalive != nil {
+		cluster.UpstreamConnectionOptions = &envoy_config_cluster_v3.UpstreamConnectionOptions{
+			TcpKeepalive: keepalive,
+		}
+	}
+	return &upstreamPolicy{
+		cluster:         cluster,
+		hasLoadBalancer: convertLoadBalancer(spec.LoadBalancer, cluster),
+		http2Options:    pluginutils.ToEnvoyHttp2ProtocolOptions(spec.Http2ProtocolOptions),
+	}
+}
+
+func convertTcpKeepalive(in *v1alpha1.TcpKeepalive) *envoy_config_core_v3.TcpKeepalive {
+	if in == nil {
+		return nil
+	}
+	out := &envoy_config_core_v3.TcpKeepalive{
+		KeepaliveTime:     seconds(in.Time),
+		KeepaliveInterval: seconds(in.Interval),
+	}
+	if in.Probes != nil {
+		out.KeepaliveProbes = wrapperspb.UInt32(*in.Probes)
+	}
+	return out
+}
+
+// seconds rounds the duration up to whole seconds, the granularity of the keepalive settings.
+func seconds(d *metav1.Duration) *wrapperspb.UInt32Value {
+	if d == nil {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32((d.Duration + time.Second - 1) / time.Second))
+}
+
+func convertCircuitBreakers(in *v1alpha1.CircuitBreakers) *envoy_config_cluster_v3.CircuitBreakers {
+	if in == nil {
+		return nil
+	}
+	return &envoy_config_cluster_v3.CircuitBreakers{
+		Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{{
+			MaxConnections:     uint32Value(in.MaxConnections),
+			MaxPendingRequests: uint32Value(in.MaxPendingRequests),
+			MaxRequests:        uint32Value(in.MaxRequests),
+			MaxRetries:         uint32Value(in.MaxRetries),
+		}},
+	}
+}
+
+func convertOutlierDetection(in *v1alpha1.OutlierDetection) *envoy_config_cluster_v3.OutlierDetection {
+	if in == nil {
+		return nil
+	}
+	out := &envoy_config_cluster_v3.OutlierDetection{
+		Consecutive_5Xx:    uint32Value(in.Consecutive5xx),
+		Interval:           duration(in.Interval),
+		BaseEjectionTime:   duration(in.BaseEjectionTime),
+		MaxEjectionPercent: uint32Value(in.MaxEjectionPercent),
+	}
+	if in.ConsecutiveGatewayErrors != nil {
+		out.ConsecutiveGatewayFailure = wrapperspb.UInt32(*in.ConsecutiveGatewayErrors)
+		// gateway failure detection is disabled by default
+		out.EnforcingConsecutiveGatewayFailure = wrapperspb.UInt32(100)
+	}
+	return out
+}
+
+// convertLoadBalancer sets the load balancing fields of the cluster. It returns false if the load
+// balancer is unset.
+func convertLoadBalancer(in *v1alpha1.LoadBalancer, out *envoy_config_cluster_v3.Cluster) bool {
+	if in == nil {
+		return false
+	}
+	var useHostnameForHashing bool
+	switch {
+	case in.RoundRobin != nil:
+		out.LbPolicy = envoy_config_cluster_v3.Cluster_ROUND_ROBIN
+		if slowStart := slowStartConfig(in.RoundRobin.SlowStartWindow); slowStart != nil {
+			out.LbConfig = &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig_{
+				RoundRobinLbConfig: &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig{
+					SlowStartConfig: slowStart,
+				},
+			}
+		}
+	case in.LeastRequest != nil:
+		out.LbPolicy = envoy_config_cluster_v3.Cluster_LEAST_REQUEST
+		out.LbConfig = &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig_{
+			LeastRequestLbConfig: &envoy_config_cluster_v3.Cluster_LeastRequestLbConfig{
+				ChoiceCount:     uint32Value(in.LeastRequest.ChoiceCount),
+				SlowStartConfig: slowStartConfig(in.LeastRequest.SlowStartWindow),
+			},
+		}
+	case in.RingHash != nil:
+		out.LbPolicy = envoy_config_cluster_v3.Cluster_RING_HASH
+		out.LbConfig = &envoy_config_cluster_v3.Cluster_RingHashLbConfig_{
+			RingHashLbConfig: &envoy_config_cluster_v3.Cluster_RingHashLbConfig{
+				MinimumRingSize: uint64Value(in.RingHash.MinimumRingSize),
+				MaximumRingSize: uint64Value(in.RingHash.MaximumRingSize),
+			},
+		}
+		useHostnameForHashing = in.RingHash.UseHostnameForHashing
+	case in.Maglev != nil:
+		out.LbPolicy = envoy_config_cluster_v3.Cluster_MAGLEV
+		out.LbConfig = &envoy_config_cluster_v3.Cluster_MaglevLbConfig_{
+			MaglevLbConfig: &envoy_config_cluster_v3.Cluster_MaglevLbConfig{
+				TableSize: uint64Value(in.Maglev.TableSize),
+			},
+		}
+		useHostnameForHashing = in.Maglev.UseHostnameForHashing
+	default:
+		return false
+	}
+	if useHostnameForHashing {
+		out.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{
+			ConsistentHashingLbConfig: &envoy_config_cluster_v3.Cluster_CommonLbConfig_ConsistentHashingLbConfig{
+				UseHostnameForHashing: true,
+			},
+		}
+	}
+	return true
+}
+
+func slowStartConfig(window *metav1.Duration) *envoy_config_cluster_v3.Cluster_SlowStartConfig {
+	if window == nil {
+		return nil
+	}
+	return &envoy_config_cluster_v3.Cluster_SlowStartConfig{
+		SlowStartWindow: durationpb.New(window.Duration),
+	}
+}
+
+func uint32Value(in *uint32) *wrapperspb.UInt32Value {
+	if in == nil {
+		return nil
+	}
+	return wrapperspb.UInt32(*in)
+}
+
+func uint64Value(in *uint64) *wrapperspb.UInt64Value {
+	if in == nil {
+		return nil
+	}
+	return wrapperspb.UInt64(*in)
+}
+
+func duration(in *metav1.Duration) *durationpb.Duration {
+	if in == nil {
+		return nil
+	}
+	return durationpb.New(in.Duration)
+}
@@ -0,0 +1,175 @@
+package upstreampolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
+	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+)
+
+type upstreamPolicy struct {
+	ct time.Time
+	// cluster holds the cluster fields set by the policy
+	cluster *envoy_config_cluster_v3.Cluster
+	// the round robin load balancer has no cluster fields, so whether it is set is tracked here
+	hasLoadBalancer bool
+	http2Options    *envoy_config_core_v3.Http2ProtocolOptions
+}
+
+func (d *upstreamPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *upstreamPolicy) Equals(in any) bool {
+	d2, ok := in.(*upstreamPolicy)
+	if !ok {
+		return false
+	}
+	return d.hasLoadBalancer == d2.hasLoadBalancer &&
+		proto.Equal(d.cluster, d2.cluster) &&
+		proto.Equal(d.http2Options, d2.http2Options)
+}
+
+func (d *upstreamPolicy) LbPolicy() (envoy_config_cluster_v3.Cluster_LbPolicy, bool) {
+	return d.cluster.GetLbPolicy(), d.hasLoadBalancer
+}
+
+// OldestWins marks UpstreamPolicy as oldest-wins: the fields set by the oldest policy targeting an
+// upstream win over the ones of newer policies.
+func (d *upstreamPolicy) OldestWins() {}
+
+var (
+	_ ir.LoadBalancerPolicyIR = &upstreamPolicy{}
+	_ ir.OldestWinsPolicyIR   = &upstreamPolicy{}
+)
+
+func NewPlugin(ctx context.Context, commoncol *common.CommonCollections) extensionsplug.Plugin {
+	col := krtutil.SetupCollectionDynamic[v1alpha1.UpstreamPolicy](
+		ctx,
+		commoncol.Client,
+		v1alpha1.SchemeGroupVersion.WithResource("upstreampolicies"),
+		commoncol.KrtOpts.ToOptions("UpstreamPolicy")...,
+	)
+	gk := v1alpha1.UpstreamPolicyGVK.GroupKind()
+	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, policyCR *v1alpha1.UpstreamPolicy) *ir.PolicyWrapper {
+		policyIR := convertUpstreamPolicy(policyCR.Spec)
+		policyIR.ct = policyCR.CreationTimestamp.Time
+
+		var pol = &ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{
+				Group:     gk.Group,
+				Kind:      gk.Kind,
+				Namespace: policyCR.Namespace,
+				Name:      policyCR.Name,
+			},
+			Policy:     policyCR,
+			PolicyIR:   policyIR,
+			TargetRefs: convert(policyCR.Spec.TargetRef),
+		}
+		return pol
+	})
+
+	return extensionsplug.Plugin{
+		ContributesPolicies: map[schema.GroupKind]extensionsplug.PolicyPlugin{
+			gk: {
+				Name:            "upstreampolicy",
+				ProcessUpstream: processUpstream,
+				Policies:        policyCol,
+			},
+		},
+	}
+}
+
+func convert(targetRef v1alpha1.LocalPolicyTargetReferenceWithSectionName) []ir.PolicyTargetRef {
+	var sectionName string
+	if targetRef.SectionName != nil {
+		sectionName = string(*targetRef.SectionName)
+	}
+	return []ir.PolicyTargetRef{{
+		Kind:        string(targetRef.Kind),
+		Name:        string(targetRef.Name),
+		Group:       string(targetRef.Group),
+		SectionName: sectionName,
+	}}
+}
+
+func (p *upstreamPolicy) Name() string {
+	return "upstreampolicies"
+}
+
+func processUpstream(ctx context.Context, polir ir.PolicyIR, in ir.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	// all the policies of the upstream are merged when processing the oldest one, so that the
+	// fields it sets win over the ones of newer policies.
+	policies := in.AttachedPolicies.Policies[v1alpha1.UpstreamPolicyGVK.GroupKind()]
+	if len(policies) == 0 || policies[0].PolicyIr != polir {
+		return nil
+	}
+	var errs []error
+	for i := len(policies) - 1; i >= 0; i-- {
+		policy, ok := policies[i].PolicyIr.(*upstreamPolicy)
+		if !ok {
+			continue
+		}
+		if err := applyPolicy(policy, out); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply upstream policy to upstream %s: %w", in.ClusterName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyPolicy sets the cluster fields set by the policy.
+func applyPolicy(policy *upstreamPolicy, out *envoy_config_cluster_v3.Cluster) error {
+	c := policy.cluster
+	if c.GetConnectTimeout() != nil {
+		out.ConnectTimeout = c.GetConnectTimeout()
+	}
+	if c.GetUpstreamConnectionOptions() != nil {
+		out.UpstreamConnectionOptions = c.GetUpstreamConnectionOptions()
+	}
+	if c.GetCircuitBreakers() != nil {
+		out.CircuitBreakers = c.GetCircuitBreakers()
+	}
+	if c.GetOutlierDetection() != nil {
+		out.OutlierDetection = c.GetOutlierDetection()
+	}
+	if len(c.GetHealthChecks()) > 0 {
+		out.HealthChecks = c.GetHealthChecks()
+	}
+	if policy.hasLoadBalancer {
+		out.LbPolicy = c.GetLbPolicy()
+		out.LbConfig = c.LbConfig
+		consistentHashing := c.GetCommonLbConfig().GetConsistentHashingLbConfig()
+		if consistentHashing != nil && out.GetCommonLbConfig() == nil {
+			out.CommonLbConfig = &envoy_config_cluster_v3.Cluster_CommonLbConfig{}
+		}
+		if out.GetCommonLbConfig() != nil {
+			out.GetCommonLbConfig().ConsistentHashingLbConfig = consistentHashing
+		}
+	}
+	if policy.http2Options != nil {
+		return utils.MutateHttpOptions(out, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+			opts.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+				ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+					ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+						Http2ProtocolOptions: policy.http2Options,
+					},
+				},
+			}
+		})
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package upstreampolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+func upstreamWithPolicies(specs ...v1alpha1.UpstreamPolicySpec) ir.Upstream {
+	var atts []ir.PolicyAtt
+	for i, spec := range specs {
+		pol := convertUpstreamPolicy(spec)
+		pol.ct = time.Unix(int64(i), 0)
+		atts = append(atts, ir.PolicyAtt{PolicyIr: pol})
+	}
+	return ir.Upstream{
+		AttachedPolicies: ir.AttachedPolicies{
+			Policies: map[schema.GroupKind][]ir.PolicyAtt{
+				v1alpha1.UpstreamPolicyGVK.GroupKind(): atts,
+			},
+		},
+	}
+}
+
+// processAll runs the plugin the way the upstream translator does, once per attached policy.
+func processAll(u ir.Upstream) *envoy_config_cluster_v3.Cluster {
+	out := &envoy_config_cluster_v3.Cluster{ConnectTimeout: durationpb.New(5 * time.Second)}
+	for _, att := range u.AttachedPolicies.Policies[v1alpha1.UpstreamPolicyGVK.GroupKind()] {
+		processUpstream(context.Background(), att.PolicyIr, u, out)
+	}
+	return out
+}
+
+func TestProcessUpstream(t *testing.T) {
+	t.Run("connection settings", func(t *testing.T) {
+		out := processAll(upstreamWithPolicies(v1alpha1.UpstreamPolicySpec{
+			ConnectTimeout: &metav1.Duration{Duration: time.Second},
+			TcpKeepalive: &v1alpha1.TcpKeepalive{
+				Probes:   ptr.To(uint32(3)),
+				Time:     &metav1.Duration{Duration: 30 * time.Second},
+				Interval: &metav1.Duration{Duration: 1500 * time.Millisecond},
+			},
+			CircuitBreakers: &v1alpha1.CircuitBreakers{MaxConnections: ptr.To(uint32(10))},
+			OutlierDetection: &v1alpha1.OutlierDetection{
+				ConsecutiveGatewayErrors: ptr.To(uint32(3)),
+				BaseEjectionTime:         &metav1.Duration{Duration: time.Minute},
+			},
+		}))
+		assert.Equal(t, time.Second, out.GetConnectTimeout().AsDuration())
+		keepalive := out.GetUpstreamConnectionOptions().GetTcpKeepalive()
+		assert.Equal(t, uint32(3), keepalive.GetKeepaliveProbes().GetValue())
+		assert.Equal(t, uint32(30), keepalive.GetKeepaliveTime().GetValue())
+		assert.Equal(t, uint32(2), keepalive.GetKeepaliveInterval().GetValue())
+		require.Len(t, out.GetCircuitBreakers().GetThresholds(), 1)
+		assert.Equal(t, uint32(10), out.GetCircuitBreakers().GetThresholds()[0].GetMaxConnections().GetValue())
+		assert.Nil(t, out.GetCircuitBreakers().GetThresholds()[0].GetMaxRequests())
+		assert.Equal(t, uint32(3), out.GetOutlierDetection().GetConsecutiveGatewayFailure().GetValue())
+		assert.Equal(t, uint32(100), out.GetOutlierDetection().GetEnforcingConsecutiveGatewayFailure().GetValue())
+		assert.Equal(t, time.Minute, out.GetOutlierDetection().GetBaseEjectionTime().AsDuration())
+	})
+
+	t.Run("load balancers", func(t *testing.T) {
+		out := processAll(upstreamWithPolicies(v1alpha1.UpstreamPolicySpec{
+			LoadBalancer: &v1alpha1.LoadBalancer{LeastRequest: &v1alpha1.LeastRequestLoadBalancer{ChoiceCount: ptr.To(uint32(4))}},
+		}))
+		assert.Equal(t, envoy_config_cluster_v3.Cluster_LEAST_REQUEST, out.GetLbPolicy())
+		assert.Equal(t, uint32(4), out.GetLeastRequestLbConfig().GetChoiceCount().GetValue())
+
+		out = processAll(upstreamWithPolicies(v1alpha1.UpstreamPolicySpec{
+			LoadBalancer: &v1alpha1.LoadBalancer{Maglev: &v1alpha1.MaglevLoadBalancer{UseHostnameForHashing: true}},
+		}))
+		assert.Equal(t, envoy_config_cluster_v3.Cluster_MAGLEV, out.GetLbPolicy())
+		assert.True(t, out.GetCommonLbConfig().GetConsistentHashingLbConfig().GetUseHostnameForHashing())
+
+		out = processAll(upstreamWithPolicies(v1alpha1.UpstreamPolicySpec{
+			LoadBalancer: &v1alpha1.LoadBalancer{RingHash: &v1alpha1.RingHashLoadBalancer{MinimumRingSize: ptr.To(uint64(128))}},
+		}))
+		assert.Equal(t, envoy_config_cluster_v3.Cluster_RING_HASH, out.GetLbPolicy())
+		assert.Equal(t, uint64(128), out.GetRingHashLbConfig().GetMinimumRingSize().GetValue())
+		assert.Nil(t, out.GetCommonLbConfig())
+	})
+
+	t.Run("http2 protocol options", func(t *testing.T) {
+		out := processAll(upstreamWithPolicies(v1alpha1.UpstreamPolicySpec{
+			Http2ProtocolOptions: &v1alpha1.Http2ProtocolOptions{MaxConcurrentStreams: ptr.To(uint32(100))},
+		}))
+		opts := &envoy_upstreams_v3.HttpProtocolOptions{}
+		require.NoError(t, out.GetTypedExtensionProtocolOptions()["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"].UnmarshalTo(opts))
+		assert.Equal(t, uint32(100), opts.GetExplicitHttpConfig().GetHttp2ProtocolOptions().GetMaxConcurrentStreams().GetValue())
+	})
+
+	t.Run("health check", func(t *testing.T) {
+		out := processAll(upstreamWithPolicies(v1alpha1.UpstreamPolicySpec{
+			HealthCheck: &v1alpha1.HealthCheck{
+				Interval: &metav1.Duration{Duration: 5 * time.Second},
+				Grpc:     &v1alpha1.GrpcHealthCheck{ServiceName: "api"},
+			},
+		}))
+		require.Len(t, out.GetHealthChecks(), 1)
+		assert.Equal(t, 5*time.Second, out.GetHealthChecks()[0].GetInterval().AsDuration())
+		assert.Equal(t, "api", out.GetHealthChecks()[0].GetGrpcHealthCheck().GetServiceName())
+	})
+
+	t.Run("fields of the oldest policy win", func(t *testing.T) {
+		out := processAll(upstreamWithPolicies(
+			v1alpha1.UpstreamPolicySpec{
+				ConnectTimeout: &metav1.Duration{Duration: time.Second},
+				LoadBalancer:   &v1alpha1.LoadBalancer{RoundRobin: &v1alpha1.RoundRobinLoadBalancer{}},
+			},
+			v1alpha1.UpstreamPolicySpec{
+				ConnectTimeout:  &metav1.Duration{Duration: 2 * time.Second},
+				CircuitBreakers: &v1alpha1.CircuitBreakers{MaxRequests: ptr.To(uint32(5))},
+				LoadBalancer:    &v1alpha1.LoadBalancer{Maglev: &v1alpha1.MaglevLoadBalancer{}},
+			},
+		))
+		assert.Equal(t, time.Second, out.GetConnectTimeout().AsDuration())
+		assert.Equal(t, envoy_config_cluster_v3.Cluster_ROUND_ROBIN, out.GetLbPolicy())
+		assert.Nil(t, out.GetMaglevLbConfig())
+		assert.Equal(t, uint32(5), out.GetCircuitBreakers().GetThresholds()[0].GetMaxRequests().GetValue())
+	})
+	t.Run("load balancer of the oldest policy wins", func(t *testing.T) {
+		u := upstreamWithPolicies(
+			v1alpha1.UpstreamPolicySpec{
+				LoadBalancer: &v1alpha1.LoadBalancer{Maglev: &v1alpha1.MaglevLoadBalancer{}},
+			},
+			v1alpha1.UpstreamPolicySpec{
+				LoadBalancer: &v1alpha1.LoadBalancer{RingHash: &v1alpha1.RingHashLoadBalancer{}},
+			},
+		)
+		out := processAll(u)
+		assert.Equal(t, envoy_config_cluster_v3.Cluster_MAGLEV, out.GetLbPolicy())
+		assert.Nil(t, out.GetRingHashLbConfig())
+		// the hash policies of the routes depend on the load balancer reported by the upstream
+		assert.Equal(t, envoy_config_cluster_v3.Cluster_MAGLEV, u.LbPolicy())
+	})
+}
@@ -0,0 +1,86 @@
+package pluginutils
+
+import (
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+const (
+	defaultHealthCheckTimeout            = time.Second
+	defaultHealthCheckInterval           = 10 * time.Second
+	defaultHealthCheckUnhealthyThreshold = 2
+	defaultHealthCheckHealthyThreshold   = 1
+)
+
+// ToEnvoyHealthCheck converts the health check to its envoy representation.
+func ToEnvoyHealthCheck(in *v1alpha1.HealthCheck) *envoy_config_core_v3.HealthCheck {
+	if in == nil {
+		return nil
+	}
+
+	out := &envoy_config_core_v3.HealthCheck{
+		Timeout:            durationpb.New(defaultHealthCheckTimeout),
+		Interval:           durationpb.New(defaultHealthCheckInterval),
+		UnhealthyThreshold: wrapperspb.UInt32(defaultHealthCheckUnhealthyThreshold),
+		HealthyThreshold:   wrapperspb.UInt32(defaultHealthCheckHealthyThreshold),
+	}
+	if in.Timeout != nil {
+		out.Timeout = durationpb.New(in.Timeout.Duration)
+	}
+	if in.Interval != nil {
+		out.Interval = durationpb.New(in.Interval.Duration)
+	}
+	if in.UnhealthyThreshold != nil {
+		out.UnhealthyThreshold = wrapperspb.UInt32(*in.UnhealthyThreshold)
+	}
+	if in.HealthyThreshold != nil {
+		out.HealthyThreshold = wrapperspb.UInt32(*in.HealthyThreshold)
+	}
+
+	switch {
+	case in.Http != nil:
+		httpCheck := &envoy_config_core_v3.HealthCheck_HttpHealthCheck{
+			Path: in.Http.Path,
+			Host: in.Http.Host,
+		}
+		for _, r := range in.Http.ExpectedStatuses {
+			// envoy ranges are half open
+			httpCheck.ExpectedStatuses = append(httpCheck.ExpectedStatuses, &envoy_type_v3.Int64Range{
+				Start: int64(r.Start),
+				End:   int64(r.End) + 1,
+			})
+		}
+		out.HealthChecker = &envoy_config_core_v3.HealthCheck_HttpHealthCheck_{HttpHealthCheck: httpCheck}
+	case in.Grpc != nil:
+		out.HealthChecker = &envoy_config_core_v3.HealthCheck_GrpcHealthCheck_{
+			GrpcHealthCheck: &envoy_config_core_v3.HealthCheck_GrpcHealthCheck{
+				ServiceName: in.Grpc.ServiceName,
+				Authority:   in.Grpc.Authority,
+			},
+		}
+	case in.Tcp != nil:
+		tcpCheck := &envoy_config_core_v3.HealthCheck_TcpHealthCheck{}
+		if in.Tcp.Send != "" {
+			tcpCheck.Send = binaryPayload(in.Tcp.Send)
+		}
+		for _, r := range in.Tcp.Receive {
+			tcpCheck.Receive = append(tcpCheck.Receive, binaryPayload(r))
+		}
+		out.HealthChecker = &envoy_config_core_v3.HealthCheck_TcpHealthCheck_{TcpHealthCheck: tcpCheck}
+	default:
+		return nil
+	}
+	return out
+}
+
+func binaryPayload(s string) *envoy_config_core_v3.HealthCheck_Payload {
+	return &envoy_config_core_v3.HealthCheck_Payload{
+		Payload: &envoy_config_core_v3.HealthCheck_Payload_Binary{Binary: []byte(s)},
+	}
+}
@@ -0,0 +1,26 @@
+package pluginutils
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// ToEnvoyHttp2ProtocolOptions converts the HTTP/2 options to their envoy representation.
+func ToEnvoyHttp2ProtocolOptions(in *v1alpha1.Http2ProtocolOptions) *envoy_config_core_v3.Http2ProtocolOptions {
+	if in == nil {
+		return nil
+	}
+	out := &envoy_config_core_v3.Http2ProtocolOptions{}
+	if in.MaxConcurrentStreams != nil {
+		out.MaxConcurrentStreams = wrapperspb.UInt32(*in.MaxConcurrentStreams)
+	}
+	if in.InitialStreamWindowSize != nil {
+		out.InitialStreamWindowSize = wrapperspb.UInt32(*in.InitialStreamWindowSize)
+	}
+	if in.InitialConnectionWindowSize != nil {
+		out.InitialConnectionWindowSize = wrapperspb.UInt32(*in.InitialConnectionWindowSize)
+	}
+	return out
+}
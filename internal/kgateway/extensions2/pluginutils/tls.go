@@ -0,0 +1,73 @@
+package pluginutils
+
+import (
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// CACertKey is the key of the CA certificate bundle in the Secrets and ConfigMaps referenced
+	// to validate backends, as defined by the Gateway API.
+	CACertKey = "ca.crt"
+
+	// SystemCACertsFile is the CA certificate bundle of the system, used to validate backends
+	// with well known CA certificates.
+	SystemCACertsFile = "/etc/ssl/certs/ca-certificates.crt"
+)
+
+// UpstreamTlsTransportSocket returns the tls transport socket originating TLS to a backend with the
+// given SNI, validating the backend with the given validation context. The backend is not
+// validated if the validation context is nil.
+func UpstreamTlsTransportSocket(sni string, validation *envoyauth.CertificateValidationContext) (*envoy_config_core_v3.TransportSocket, error) {
+	tlsContext := &envoyauth.UpstreamTlsContext{
+		Sni:              sni,
+		CommonTlsContext: &envoyauth.CommonTlsContext{},
+	}
+	if validation != nil {
+		tlsContext.CommonTlsContext.ValidationContextType = &envoyauth.CommonTlsContext_ValidationContext{
+			ValidationContext: validation,
+		}
+	}
+	typedConfig, err := anypb.New(tlsContext)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}, nil
+}
+
+// TrustedCA returns the data source of the given CA certificates, or of the CA certificate bundle
+// of the system if there are none.
+func TrustedCA(caCerts []string) *envoy_config_core_v3.DataSource {
+	if len(caCerts) > 0 {
+		return &envoy_config_core_v3.DataSource{
+			Specifier: &envoy_config_core_v3.DataSource_InlineString{
+				InlineString: strings.Join(caCerts, "\n"),
+			},
+		}
+	}
+	return &envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_Filename{
+			Filename: SystemCACertsFile,
+		},
+	}
+}
+
+// SanMatcher returns the matcher of a SAN of the backend certificate equal to the given name.
+func SanMatcher(sanType envoyauth.SubjectAltNameMatcher_SanType, name string) *envoyauth.SubjectAltNameMatcher {
+	return &envoyauth.SubjectAltNameMatcher{
+		SanType: sanType,
+		Matcher: &envoymatcher.StringMatcher{
+			MatchPattern: &envoymatcher.StringMatcher_Exact{
+				Exact: name,
+			},
+		},
+	}
+}
@@ -9,14 +9,20 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/backendtlspolicy"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/cors"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/destrule"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/directresponse"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/extauth"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/httplistenerpolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/istio"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/jwt"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/kubernetes"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/listenerpolicy"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/ratelimit"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/routepolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/upstream"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/upstreampolicy"
 )
 
 func mergedGw(funcs []extensionsplug.GwTranslatorFactory) extensionsplug.GwTranslatorFactory {
@@ -75,6 +81,12 @@ func Plugins(ctx context.Context, commoncol *common.CommonCollections) []extensi
 		destrule.NewPlugin(ctx, commoncol),
 		listenerpolicy.NewPlugin(ctx, commoncol),
 		httplistenerpolicy.NewPlugin(ctx, commoncol),
+		ratelimit.NewPlugin(ctx, commoncol),
+		extauth.NewPlugin(ctx, commoncol),
+		jwt.NewPlugin(ctx, commoncol),
+		cors.NewPlugin(ctx, commoncol),
+		backendtlspolicy.NewPlugin(ctx, commoncol),
+		upstreampolicy.NewPlugin(ctx, commoncol),
 	}
 }
 
@@ -9,6 +9,10 @@ type Settings struct {
 	EnableAutoMTLS         bool
 	StsClusterName         string
 	StsUri                 string
+
+	// EnableExperimentalListenerSets enables the experimental XListenerSet API of the Gateway API,
+	// along with the gateway.kgateway.dev/allowed-listeners annotation of the Gateways.
+	EnableExperimentalListenerSets bool
 }
 
 // BuildSettings returns a zero-valued Settings obj if error is encountered when parsing env
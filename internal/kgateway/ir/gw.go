@@ -10,7 +10,9 @@ import (
 )
 
 type UpstreamInit struct {
-	InitUpstream func(ctx context.Context, in Upstream, out *envoy_config_cluster_v3.Cluster)
+	// InitUpstream initializes the cluster of the upstream. The cluster is not sent to the proxies
+	// if it returns an error.
+	InitUpstream func(ctx context.Context, in Upstream, out *envoy_config_cluster_v3.Cluster) error
 }
 
 type PolicyTargetRef struct {
@@ -28,6 +30,18 @@ type PolicyAtt struct {
 
 	// policy target ref that cause the attachment (can be used to report status correctly). nil if extension ref
 	PolicyTargetRef *PolicyTargetRef
+
+	// the policy object that this attachment originated from, used to report policy ancestor status.
+	// nil for global policies and extension refs.
+	PolicyRef *ObjectSource
+	// generation of the policy object, used as the observed generation in policy status.
+	Generation int64
+	// Errors processing the policy, reported as conditions in the policy status.
+	Errors []error
+	// the top-level fields set in the spec of the policy object, besides its target refs. policies of
+	// the same kind only conflict if they set some of the same fields. nil if unknown, in which case
+	// the policy conflicts entirely.
+	SetFields []string
 }
 
 func (c PolicyAtt) Obj() PolicyIR {
@@ -39,7 +53,8 @@ func (c PolicyAtt) TargetRef() *PolicyTargetRef {
 }
 
 func (c PolicyAtt) Equals(in PolicyAtt) bool {
-	return c.GroupKind == in.GroupKind && ptrEquals(c.PolicyTargetRef, in.PolicyTargetRef) && c.PolicyIr.Equals(in.PolicyIr)
+	return c.GroupKind == in.GroupKind && ptrEquals(c.PolicyTargetRef, in.PolicyTargetRef) && ptrEquals(c.PolicyRef, in.PolicyRef) &&
+		c.Generation == in.Generation && c.PolicyIr.Equals(in.PolicyIr)
 }
 
 func ptrEquals[T comparable](a, b *T) bool {
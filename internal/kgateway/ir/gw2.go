@@ -43,6 +43,8 @@ type ListenerIR struct {
 
 	HttpFilterChain []HttpFilterChainIR
 	TcpFilterChain  []TcpIR
+	// UDP listeners have no filter chains; if set, this is a UDP listener.
+	UdpProxy *UdpIR
 }
 
 type VirtualHost struct {
@@ -91,6 +93,12 @@ type TcpIR struct {
 	BackendRefs []Backend
 }
 
+type UdpIR struct {
+	// used as the stat prefix of the udp proxy
+	Name        string
+	BackendRefs []Backend
+}
+
 // this is 1:1 with envoy deployments
 // not in a collection so doesn't need a krt interfaces.
 type GatewayIR struct {
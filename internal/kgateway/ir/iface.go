@@ -9,8 +9,10 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/plugins"
 )
@@ -19,7 +21,8 @@ type ListenerContext struct {
 	Policy PolicyIR
 }
 type VirtualHostContext struct {
-	Policy PolicyIR
+	FilterChainName string
+	Policy          PolicyIR
 }
 type RouteBackendContext struct {
 	FilterChainName string
@@ -36,12 +39,30 @@ func (r *RouteBackendContext) AddTypedConfig(key string, v *anypb.Any) {
 }
 
 type RouteContext struct {
-	Policy PolicyIR
-	In     HttpRouteRuleMatchIR
+	FilterChainName string
+	Policy          PolicyIR
+	In              HttpRouteRuleMatchIR
+}
+
+// PolicyWarning is returned when applying a policy that has no effect in its context, e.g. because of
+// the configuration of the backend. Unlike other errors, it is only reported in the policy status and
+// doesn't invalidate the route.
+type PolicyWarning struct {
+	Message string
+}
+
+func (w *PolicyWarning) Error() string {
+	return w.Message
 }
 
 type HcmContext struct {
 	Policy PolicyIR
+	// Gateway whose proxies the HttpConnectionManager is translated for.
+	Gateway *gwv1.Gateway
+}
+
+type TcpProxyContext struct {
+	Policy PolicyIR
 }
 
 type ProxyTranslationPass interface {
@@ -56,6 +77,10 @@ type ProxyTranslationPass interface {
 	ApplyHCM(ctx context.Context,
 		pCtx *HcmContext,
 		out *envoy_hcm.HttpConnectionManager) error
+	// called 1 time per tcp filter chain after listeners
+	ApplyTcpProxy(ctx context.Context,
+		pCtx *TcpProxyContext,
+		out *envoytcp.TcpProxy) error
 
 	ApplyVhostPlugin(
 		ctx context.Context,
@@ -96,6 +121,19 @@ type PolicyIR interface {
 	Equals(in any) bool
 }
 
+// OldestWinsPolicyIR is implemented by the IR of policies for which the oldest of the policies of
+// the same kind attached to the same resource wins, rather than the newest.
+type OldestWinsPolicyIR interface {
+	OldestWins()
+}
+
+// AppendedFieldsPolicyIR is implemented by the IR of policies with spec fields that are appended to
+// the same fields of the other policies of the same kind attached to the same resource, so they
+// never conflict.
+type AppendedFieldsPolicyIR interface {
+	AppendedFields() []string
+}
+
 type PolicyWrapper struct {
 	ObjectSource `json:",inline"`
 	Policy       metav1.Object
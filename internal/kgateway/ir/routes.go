@@ -103,3 +103,64 @@ func (c TcpRouteIR) Equals(in TcpRouteIR) bool {
 }
 
 var _ Route = &TcpRouteIR{}
+
+type TlsRouteIR struct {
+	ObjectSource     `json:",inline"`
+	SourceObject     *gwv1alpha2.TLSRoute
+	ParentRefs       []gwv1.ParentReference
+	Hostnames        []string
+	AttachedPolicies AttachedPolicies
+	Backends         []Backend
+}
+
+func (c *TlsRouteIR) GetParentRefs() []gwv1.ParentReference {
+	return c.ParentRefs
+}
+func (c *TlsRouteIR) GetSourceObject() metav1.Object {
+	return c.SourceObject
+}
+func (c TlsRouteIR) ResourceName() string {
+	return c.ObjectSource.ResourceName()
+}
+
+func (c TlsRouteIR) Equals(in TlsRouteIR) bool {
+	return c.ObjectSource == in.ObjectSource && versionEquals(c.SourceObject, in.SourceObject) && c.AttachedPolicies.Equals(in.AttachedPolicies) && backendsEqual(c.Backends, in.Backends)
+}
+
+var _ Route = &TlsRouteIR{}
+
+type UdpRouteIR struct {
+	ObjectSource     `json:",inline"`
+	SourceObject     *gwv1alpha2.UDPRoute
+	ParentRefs       []gwv1.ParentReference
+	AttachedPolicies AttachedPolicies
+	Backends         []Backend
+}
+
+func (c *UdpRouteIR) GetParentRefs() []gwv1.ParentReference {
+	return c.ParentRefs
+}
+func (c *UdpRouteIR) GetSourceObject() metav1.Object {
+	return c.SourceObject
+}
+func (c UdpRouteIR) ResourceName() string {
+	return c.ObjectSource.ResourceName()
+}
+
+func (c UdpRouteIR) Equals(in UdpRouteIR) bool {
+	return c.ObjectSource == in.ObjectSource && versionEquals(c.SourceObject, in.SourceObject) && c.AttachedPolicies.Equals(in.AttachedPolicies) && backendsEqual(c.Backends, in.Backends)
+}
+
+var _ Route = &UdpRouteIR{}
+
+func backendsEqual(a, b []Backend) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, backend := range a {
+		if backend.ClusterName != b[i].ClusterName || backend.Weight != b[i].Weight {
+			return false
+		}
+	}
+	return true
+}
@@ -3,12 +3,16 @@ package ir
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"istio.io/istio/pkg/kube/krt"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 )
 
 type ObjectSource struct {
@@ -47,12 +51,36 @@ func (c ObjectSource) Equals(in ObjectSource) bool {
 	return c.Namespace == in.Namespace && c.Name == in.Name && c.Group == in.Group && c.Kind == in.Kind
 }
 
+// AppProtocol is the application protocol spoken by an upstream.
+type AppProtocol string
+
+const (
+	DefaultAppProtocol AppProtocol = ""
+	HTTP2AppProtocol   AppProtocol = "http2"
+)
+
+// ParseAppProtocol returns the AppProtocol for the appProtocol of a service port. Both the
+// Kubernetes standard name and the common short names for HTTP/2 and gRPC are supported.
+func ParseAppProtocol(appProtocol *string) AppProtocol {
+	if appProtocol == nil {
+		return DefaultAppProtocol
+	}
+	switch strings.ToLower(*appProtocol) {
+	case "kubernetes.io/h2c", "h2c", "http2", "grpc":
+		return HTTP2AppProtocol
+	default:
+		return DefaultAppProtocol
+	}
+}
+
 type Upstream struct {
 	// Ref to source object. sometimes the group and kind are not populated from api-server, so
 	// set them explicitly here, and pass this around as the reference.
 	ObjectSource `json:",inline"`
 	// optional port for if ObjectSource is a service that can have multiple ports.
 	Port int32
+	// optional name of the port, matching the sectionName of policies targeting the port.
+	PortName string
 
 	// prefix the cluster name with this string to distringuish it from other GVKs.
 	// here explicitly as it shows up in stats. each (group, kind) pair should have a unique prefix.
@@ -66,7 +94,14 @@ type Upstream struct {
 	// i think so, assuming obj -> objir is a 1:1 mapping.
 	ObjIr interface{ Equals(any) bool }
 
+	// the application protocol spoken by the upstream, e.g. HTTP/2 for gRPC backends.
+	AppProtocol AppProtocol
+
 	AttachedPolicies AttachedPolicies
+
+	// Errors processing the upstream, e.g. unresolved secret references, reported in its status.
+	// The cluster of an upstream with errors is not sent to the proxies.
+	Errors []error
 }
 
 func (c Upstream) ResourceName() string {
@@ -78,7 +113,32 @@ func UpstreamResourceName(objSource ObjectSource, port int32) string {
 }
 
 func (c Upstream) Equals(in Upstream) bool {
-	return c.ObjectSource.Equals(in.ObjectSource) && versionEquals(c.Obj, in.Obj) && c.AttachedPolicies.Equals(in.AttachedPolicies)
+	return c.ObjectSource.Equals(in.ObjectSource) && versionEquals(c.Obj, in.Obj) && c.AppProtocol == in.AppProtocol && c.AttachedPolicies.Equals(in.AttachedPolicies) &&
+		slices.EqualFunc(c.Errors, in.Errors, func(a, b error) bool { return a.Error() == b.Error() })
+}
+
+// LoadBalancerPolicyIR is implemented by the IR of upstream policies that can set the load
+// balancing policy of the cluster.
+type LoadBalancerPolicyIR interface {
+	// LbPolicy returns the load balancing policy set by the policy, if any.
+	LbPolicy() (envoy_config_cluster_v3.Cluster_LbPolicy, bool)
+}
+
+// LbPolicy returns the load balancing policy of the cluster of the upstream, as set by the oldest
+// of its attached policies setting one, since the oldest upstream policy wins.
+func (c Upstream) LbPolicy() envoy_config_cluster_v3.Cluster_LbPolicy {
+	for _, pols := range c.AttachedPolicies.Policies {
+		for _, pol := range pols {
+			lbPol, ok := pol.PolicyIr.(LoadBalancerPolicyIR)
+			if !ok {
+				continue
+			}
+			if lbPolicy, ok := lbPol.LbPolicy(); ok {
+				return lbPolicy
+			}
+		}
+	}
+	return envoy_config_cluster_v3.Cluster_ROUND_ROBIN
 }
 
 func (c Upstream) ClusterName() string {
@@ -130,13 +190,49 @@ func (l Secret) MarshalJSON() ([]byte, error) {
 
 type Listener struct {
 	gwv1.Listener
+	// ListenerSet is the XListenerSet which attached the listener to the Gateway, nil for the
+	// listeners of the Gateway itself.
+	ListenerSet      *ObjectSource
 	AttachedPolicies AttachedPolicies
 }
 
+// ListenerSet is an XListenerSet attaching listeners to a Gateway.
+type ListenerSet struct {
+	ObjectSource `json:",inline"`
+	// Listeners of the XListenerSet, named by reports.ListenerSetListenerName so that they are
+	// unique across the listeners of the Gateway.
+	Listeners []Listener
+	Obj       *apixv1alpha1.XListenerSet
+	// Allowed is false if the Gateway does not allow the XListenerSet to attach listeners.
+	Allowed bool
+}
+
+func (c ListenerSet) Equals(in ListenerSet) bool {
+	return c.ObjectSource.Equals(in.ObjectSource) && versionEquals(c.Obj, in.Obj) && c.Allowed == in.Allowed &&
+		listenerPoliciesEqual(c.Listeners, in.Listeners)
+}
+
+// listenerPoliciesEqual compares the policies attached to each listener; the listeners themselves
+// are compared through the version of the object defining them.
+func listenerPoliciesEqual(a, b []Listener) bool {
+	return slices.EqualFunc(a, b, func(x, y Listener) bool { return x.AttachedPolicies.Equals(y.AttachedPolicies) })
+}
+
 type Gateway struct {
 	ObjectSource `json:",inline"`
 	Listeners    []Listener
 	Obj          *gwv1.Gateway
+	// ListenerSets targeting the Gateway, in order of precedence.
+	ListenerSets []ListenerSet
+	// values of the addresses assigned to the Gateway, as written in its status by the gateway
+	// controller. Kept here so that the Gateway is translated again when they change.
+	AssignedAddresses []string
+	// SelfManaged is true if the proxy of the Gateway is self-managed, as set by its
+	// GatewayParameters or the default GatewayParameters of its GatewayClass.
+	SelfManaged bool
+	// LoadBalancer is true if the Service deployed for the Gateway is of type LoadBalancer, which
+	// can only request one of the addresses of the Gateway.
+	LoadBalancer bool
 
 	AttachedListenerPolicies AttachedPolicies
 	AttachedHttpPolicies     AttachedPolicies
@@ -147,5 +243,8 @@ func (c Gateway) ResourceName() string {
 }
 
 func (c Gateway) Equals(in Gateway) bool {
-	return c.ObjectSource.Equals(in.ObjectSource) && versionEquals(c.Obj, in.Obj) && c.AttachedListenerPolicies.Equals(in.AttachedListenerPolicies) && c.AttachedHttpPolicies.Equals(in.AttachedHttpPolicies)
+	return c.ObjectSource.Equals(in.ObjectSource) && versionEquals(c.Obj, in.Obj) && c.AttachedListenerPolicies.Equals(in.AttachedListenerPolicies) && c.AttachedHttpPolicies.Equals(in.AttachedHttpPolicies) &&
+		listenerPoliciesEqual(c.Listeners, in.Listeners) &&
+		slices.EqualFunc(c.ListenerSets, in.ListenerSets, func(a, b ListenerSet) bool { return a.Equals(b) }) &&
+		slices.Equal(c.AssignedAddresses, in.AssignedAddresses) && c.SelfManaged == in.SelfManaged && c.LoadBalancer == in.LoadBalancer
 }
@@ -0,0 +1,116 @@
+package krtcollections
+
+import (
+	"regexp"
+
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// anyGrpcName matches a single gRPC service or method path segment.
+const anyGrpcName = "[^/]+"
+
+// toHttpMatches converts GRPCRoute matches to their HTTPRoute equivalent. gRPC requests are
+// HTTP/2 requests with a `/<service>/<method>` path, so method matches become path matches.
+func toHttpMatches(in []gwv1.GRPCRouteMatch) []gwv1.HTTPRouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := make([]gwv1.HTTPRouteMatch, 0, len(in))
+	for _, m := range in {
+		out = append(out, gwv1.HTTPRouteMatch{
+			Path:    toHttpPathMatch(m.Method),
+			Headers: toHttpHeaderMatches(m.Headers),
+		})
+	}
+	return out
+}
+
+func toHttpPathMatch(m *gwv1.GRPCMethodMatch) *gwv1.HTTPPathMatch {
+	if m == nil || (m.Service == nil && m.Method == nil) {
+		return nil
+	}
+
+	if m.Type != nil && *m.Type == gwv1.GRPCMethodMatchRegularExpression {
+		service := anyGrpcName
+		if m.Service != nil {
+			service = *m.Service
+		}
+		method := anyGrpcName
+		if m.Method != nil {
+			method = *m.Method
+		}
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchRegularExpression),
+			Value: ptr.To("/" + service + "/" + method),
+		}
+	}
+
+	switch {
+	case m.Service != nil && m.Method != nil:
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchExact),
+			Value: ptr.To("/" + *m.Service + "/" + *m.Method),
+		}
+	case m.Service != nil:
+		// the trailing slash makes sure only methods of this exact service are matched
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchPathPrefix),
+			Value: ptr.To("/" + *m.Service + "/"),
+		}
+	default:
+		return &gwv1.HTTPPathMatch{
+			Type:  ptr.To(gwv1.PathMatchRegularExpression),
+			Value: ptr.To("/" + anyGrpcName + "/" + regexp.QuoteMeta(*m.Method)),
+		}
+	}
+}
+
+func toHttpHeaderMatches(in []gwv1.GRPCHeaderMatch) []gwv1.HTTPHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := make([]gwv1.HTTPHeaderMatch, 0, len(in))
+	for _, h := range in {
+		var matchType *gwv1.HeaderMatchType
+		if h.Type != nil {
+			matchType = ptr.To(gwv1.HeaderMatchType(*h.Type))
+		}
+		out = append(out, gwv1.HTTPHeaderMatch{
+			Type:  matchType,
+			Name:  gwv1.HTTPHeaderName(h.Name),
+			Value: h.Value,
+		})
+	}
+	return out
+}
+
+// toHttpFilters converts GRPCRoute filters to HTTPRoute filters. All GRPCRoute filter types
+// have an HTTPRoute filter with the same name and configuration.
+func toHttpFilters(in []gwv1.GRPCRouteFilter) []gwv1.HTTPRouteFilter {
+	if in == nil {
+		return nil
+	}
+	out := make([]gwv1.HTTPRouteFilter, 0, len(in))
+	for _, f := range in {
+		out = append(out, gwv1.HTTPRouteFilter{
+			Type:                   gwv1.HTTPRouteFilterType(f.Type),
+			RequestHeaderModifier:  f.RequestHeaderModifier,
+			ResponseHeaderModifier: f.ResponseHeaderModifier,
+			RequestMirror:          f.RequestMirror,
+			ExtensionRef:           f.ExtensionRef,
+		})
+	}
+	return out
+}
+
+func toHttpBackendRefs(in []gwv1.GRPCBackendRef) []gwv1.HTTPBackendRef {
+	out := make([]gwv1.HTTPBackendRef, 0, len(in))
+	for _, ref := range in {
+		out = append(out, gwv1.HTTPBackendRef{
+			BackendRef: ref.BackendRef,
+			Filters:    toHttpFilters(ref.Filters),
+		})
+	}
+	return out
+}
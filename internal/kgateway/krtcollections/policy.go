@@ -4,19 +4,25 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
 	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/backendref"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 var (
@@ -39,6 +45,10 @@ type UpstreamIndex struct {
 	policies            *PolicyIndex
 	refgrants           *RefGrantIndex
 	krtopts             krtutil.KrtOptions
+
+	// GRPCRoutes, indexed by the upstreams they reference. used to default those upstreams to HTTP/2.
+	grpcRoutes          krt.Collection[*gwv1.GRPCRoute]
+	grpcRoutesByBackend krt.Index[string, *gwv1.GRPCRoute]
 }
 
 func NewUpstreamIndex(
@@ -60,6 +70,9 @@ func (s *UpstreamIndex) HasSynced() bool {
 	if !s.policies.HasSynced() {
 		return false
 	}
+	if s.grpcRoutes != nil && !s.grpcRoutes.HasSynced() {
+		return false
+	}
 	if !s.refgrants.HasSynced() {
 		return false
 	}
@@ -79,10 +92,50 @@ func (ui *UpstreamIndex) Upstreams() []krt.Collection[ir.Upstream] {
 	return ret
 }
 
+// setGrpcRoutes makes upstreams referenced by a GRPCRoute use HTTP/2, unless they already specify
+// an application protocol. It must be called before any upstreams are added.
+func (ui *UpstreamIndex) setGrpcRoutes(grpcRoutes krt.Collection[*gwv1.GRPCRoute]) {
+	ui.grpcRoutes = grpcRoutes
+	ui.grpcRoutesByBackend = krt.NewIndex(grpcRoutes, func(route *gwv1.GRPCRoute) []string {
+		var keys []string
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				to := toFromBackendRef(route.Namespace, ref.BackendObjectReference)
+				var port int32
+				if ref.Port != nil {
+					port = int32(*ref.Port)
+				} else if to.Group == "" && to.Kind == "Service" {
+					// the port of a Service backendRef is required, so the ref resolves to no upstream
+					continue
+				}
+				keys = append(keys, ir.UpstreamResourceName(to, port))
+			}
+		}
+		return keys
+	})
+}
+
+// appProtocol returns the application protocol of the upstream. An upstream is shared by all the
+// routes referencing it, so it only defaults to HTTP/2 for GRPCRoutes allowed to reference it:
+// a cross namespace reference without a ReferenceGrant must not change the upstream of others.
+func (ui *UpstreamIndex) appProtocol(kctx krt.HandlerContext, u *ir.Upstream) ir.AppProtocol {
+	if u.AppProtocol != ir.DefaultAppProtocol || ui.grpcRoutes == nil {
+		return u.AppProtocol
+	}
+	grpcRouteGK := wellknown.GRPCRouteGVK.GroupKind()
+	for _, route := range krt.Fetch(kctx, ui.grpcRoutes, krt.FilterIndex(ui.grpcRoutesByBackend, u.ResourceName())) {
+		if ui.refgrants.ReferenceAllowed(kctx, grpcRouteGK, route.Namespace, u.ObjectSource) {
+			return ir.HTTP2AppProtocol
+		}
+	}
+	return u.AppProtocol
+}
+
 func (ui *UpstreamIndex) AddUpstreams(gk schema.GroupKind, col krt.Collection[ir.Upstream]) {
 	ucol := krt.NewCollection(col, func(kctx krt.HandlerContext, u ir.Upstream) *ir.Upstream {
-		policies := ui.policies.getTargetingPolicies(kctx, extensionsplug.UpstreamAttachmentPoint, u.ObjectSource, "")
+		policies := ui.policies.getTargetingPolicies(kctx, extensionsplug.UpstreamAttachmentPoint, u.ObjectSource, u.PortName)
 		u.AttachedPolicies = toAttachedPolicies(policies)
+		u.AppProtocol = ui.appProtocol(kctx, &u)
 		return &u
 	}, ui.krtopts.ToOptions("")...)
 	ui.availableUpstreams[gk] = ucol
@@ -93,7 +146,8 @@ func AddUpstreamMany[T metav1.Object](ui *UpstreamIndex, gk schema.GroupKind, co
 		upstreams := build(kctx, svc)
 		for i := range upstreams {
 			u := &upstreams[i]
-			u.AttachedPolicies = toAttachedPolicies(ui.policies.getTargetingPolicies(kctx, extensionsplug.UpstreamAttachmentPoint, u.ObjectSource, ""))
+			u.AttachedPolicies = toAttachedPolicies(ui.policies.getTargetingPolicies(kctx, extensionsplug.UpstreamAttachmentPoint, u.ObjectSource, u.PortName))
+			u.AppProtocol = ui.appProtocol(kctx, u)
 		}
 		return upstreams
 	}, ui.krtopts.ToOptions("")...)
@@ -107,7 +161,8 @@ func AddUpstream[T metav1.Object](ui *UpstreamIndex, gk schema.GroupKind, col kr
 		if upstream == nil {
 			return nil
 		}
-		upstream.AttachedPolicies = toAttachedPolicies(ui.policies.getTargetingPolicies(kctx, extensionsplug.UpstreamAttachmentPoint, upstream.ObjectSource, ""))
+		upstream.AttachedPolicies = toAttachedPolicies(ui.policies.getTargetingPolicies(kctx, extensionsplug.UpstreamAttachmentPoint, upstream.ObjectSource, upstream.PortName))
+		upstream.AppProtocol = ui.appProtocol(kctx, upstream)
 
 		return upstream
 	}, ui.krtopts.ToOptions("")...)
@@ -170,6 +225,9 @@ func (i *UpstreamIndex) GetUpstreamFromRef(kctx krt.HandlerContext, src ir.Objec
 type GatewayIndex struct {
 	policies *PolicyIndex
 	Gateways krt.Collection[ir.Gateway]
+
+	// all the Gateways, including the ones of other controllers
+	allGateways krt.Collection[*gwv1.Gateway]
 }
 
 func NewGatewayIndex(
@@ -177,8 +235,20 @@ func NewGatewayIndex(
 	isOurGw func(gw *gwv1.Gateway) bool,
 	policies *PolicyIndex,
 	gws krt.Collection[*gwv1.Gateway],
+	listenerSets krt.Collection[*apixv1alpha1.XListenerSet],
+	selfManagedGateways krt.Collection[SelfManagedGateway],
+	services krt.Collection[*corev1.Service],
 ) *GatewayIndex {
-	h := &GatewayIndex{policies: policies}
+	h := &GatewayIndex{policies: policies, allGateways: gws}
+	listenerSetsByParent := krt.NewIndex(listenerSets, func(ls *apixv1alpha1.XListenerSet) []types.NamespacedName {
+		if !isParentRefForGateway(ls.Spec.ParentRef) {
+			return nil
+		}
+		return []types.NamespacedName{{
+			Namespace: strOr(ls.Spec.ParentRef.Namespace, ls.Namespace),
+			Name:      string(ls.Spec.ParentRef.Name),
+		}}
+	})
 	h.Gateways = krt.NewCollection(gws, func(kctx krt.HandlerContext, i *gwv1.Gateway) *ir.Gateway {
 		if !isOurGw(i) {
 			return nil
@@ -193,6 +263,15 @@ func NewGatewayIndex(
 			Obj:       i,
 			Listeners: make([]ir.Listener, 0, len(i.Spec.Listeners)),
 		}
+		for _, addr := range i.Status.Addresses {
+			out.AssignedAddresses = append(out.AssignedAddresses, addr.Value)
+		}
+		selfManaged := SelfManagedGateway{NamespacedName: types.NamespacedName{Namespace: i.Namespace, Name: i.Name}}
+		out.SelfManaged = krt.FetchOne(kctx, selfManagedGateways, krt.FilterKey(selfManaged.ResourceName())) != nil
+		// the Service deployed for the Gateway has its name
+		if svc := krt.FetchOne(kctx, services, krt.FilterKey(types.NamespacedName{Namespace: i.Namespace, Name: i.Name}.String())); svc != nil {
+			out.LoadBalancer = metav1.IsControlledBy(*svc, i) && (*svc).Spec.Type == corev1.ServiceTypeLoadBalancer
+		}
 
 		// TODO: http polic
 		//		panic("TODO: implement http policies not just listener")
@@ -205,11 +284,73 @@ func NewGatewayIndex(
 			})
 		}
 
+		listenerSets := krt.Fetch(kctx, listenerSets, krt.FilterIndex(listenerSetsByParent, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}))
+		// listener sets are ordered by creation time, then by namespace and name, to resolve conflicts between their listeners
+		slices.SortFunc(listenerSets, func(a, b *apixv1alpha1.XListenerSet) int {
+			if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+				return c
+			}
+			if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Name, b.Name)
+		})
+		for _, ls := range listenerSets {
+			out.ListenerSets = append(out.ListenerSets, h.toListenerSet(kctx, i, ls))
+		}
+
 		return &out
 	}, krtopts.ToOptions("gateways")...)
 	return h
 }
 
+func (h *GatewayIndex) toListenerSet(kctx krt.HandlerContext, gw *gwv1.Gateway, ls *apixv1alpha1.XListenerSet) ir.ListenerSet {
+	objSrc := ir.ObjectSource{
+		Group:     apixv1alpha1.GroupName,
+		Kind:      wellknown.XListenerSetKind,
+		Namespace: ls.Namespace,
+		Name:      ls.Name,
+	}
+	out := ir.ListenerSet{
+		ObjectSource: objSrc,
+		Obj:          ls,
+		Listeners:    make([]ir.Listener, 0, len(ls.Spec.Listeners)),
+		Allowed:      listenerSetAllowed(gw, ls),
+	}
+	for _, l := range ls.Spec.Listeners {
+		out.Listeners = append(out.Listeners, ir.Listener{
+			Listener: gwv1.Listener{
+				Name:          gwv1.SectionName(reports.ListenerSetListenerName(ls, l.Name)),
+				Hostname:      l.Hostname,
+				Port:          l.Port,
+				Protocol:      l.Protocol,
+				TLS:           l.TLS,
+				AllowedRoutes: l.AllowedRoutes,
+			},
+			ListenerSet:      &objSrc,
+			AttachedPolicies: toAttachedPolicies(h.policies.getTargetingPolicies(kctx, extensionsplug.RouteAttachmentPoint, objSrc, string(l.Name))),
+		})
+	}
+	return out
+}
+
+// listenerSetAllowed returns true if the Gateway allows the XListenerSet to attach listeners,
+// according to the allowed listeners annotation of the Gateway.
+func listenerSetAllowed(gw *gwv1.Gateway, ls *apixv1alpha1.XListenerSet) bool {
+	switch gw.GetAnnotations()[wellknown.AllowedListenersAnnotationName] {
+	case "All":
+		return true
+	case "Same":
+		return ls.Namespace == gw.Namespace
+	default:
+		return false
+	}
+}
+
+func isParentRefForGateway(ref apixv1alpha1.ParentGatewayReference) bool {
+	return strOr(ref.Group, gwv1.GroupName) == gwv1.GroupName && strOr(ref.Kind, wellknown.GatewayKind) == wellknown.GatewayKind
+}
+
 type targetRefIndexKey struct {
 	ir.PolicyTargetRef
 	Namespace string
@@ -306,23 +447,15 @@ func (p *PolicyIndex) getTargetingPolicies(
 		Namespace: targetRef.Namespace,
 	}
 	policies := krt.Fetch(kctx, p.policies, krt.FilterIndex(p.targetRefIndex, targetRefIndexKey))
-	targetRefIndexKey.SectionName = sectionName
-	sectionNamePolicies := krt.Fetch(kctx, p.policies, krt.FilterIndex(p.targetRefIndex, targetRefIndexKey))
-
 	for _, p := range policies {
-		ret = append(ret, ir.PolicyAtt{PolicyIr: p.PolicyIR, GroupKind: p.GetGroupKind(), PolicyTargetRef: &ir.PolicyTargetRef{
-			Group: p.Group,
-			Kind:  p.Kind,
-			Name:  p.Name,
-		}})
-	}
-	for _, p := range sectionNamePolicies {
-		ret = append(ret, ir.PolicyAtt{PolicyIr: p.PolicyIR, GroupKind: p.GetGroupKind(), PolicyTargetRef: &ir.PolicyTargetRef{
-			Group:       p.Group,
-			Kind:        p.Kind,
-			Name:        p.Name,
-			SectionName: sectionName,
-		}})
+		ret = append(ret, toPolicyAtt(p, ""))
+	}
+	if sectionName != "" {
+		targetRefIndexKey.SectionName = sectionName
+		sectionNamePolicies := krt.Fetch(kctx, p.policies, krt.FilterIndex(p.targetRefIndex, targetRefIndexKey))
+		for _, p := range sectionNamePolicies {
+			ret = append(ret, toPolicyAtt(p, sectionName))
+		}
 	}
 	slices.SortFunc(ret, func(a, b ir.PolicyAtt) int {
 		return a.PolicyIr.CreationTime().Compare(b.PolicyIr.CreationTime())
@@ -330,6 +463,117 @@ func (p *PolicyIndex) getTargetingPolicies(
 	return ret
 }
 
+// UnresolvedPolicyTargets holds the target refs of a policy that don't resolve to one of our
+// Gateways or a route, used to report TargetNotFound in the policy status.
+type UnresolvedPolicyTargets struct {
+	ir.ObjectSource
+	Generation int64
+	TargetRefs []ir.PolicyTargetRef
+}
+
+func (u UnresolvedPolicyTargets) ResourceName() string {
+	return u.ObjectSource.ResourceName()
+}
+
+func (u UnresolvedPolicyTargets) Equals(in UnresolvedPolicyTargets) bool {
+	return u.ObjectSource.Equals(in.ObjectSource) && u.Generation == in.Generation && slices.Equal(u.TargetRefs, in.TargetRefs)
+}
+
+// NewUnresolvedPolicyTargets returns a collection with an entry for every policy that has target refs
+// to a Gateway or route that doesn't exist. Target refs to other kinds are not checked, and neither
+// are Gateways of other controllers, as their status isn't ours to report.
+func NewUnresolvedPolicyTargets(
+	krtopts krtutil.KrtOptions,
+	policies *PolicyIndex,
+	gateways *GatewayIndex,
+	routes *RoutesIndex,
+) krt.Collection[UnresolvedPolicyTargets] {
+	return krt.NewCollection(policies.policies, func(kctx krt.HandlerContext, p ir.PolicyWrapper) *UnresolvedPolicyTargets {
+		var unresolved []ir.PolicyTargetRef
+		for _, tr := range p.TargetRefs {
+			if !targetExists(kctx, gateways, routes, p.Namespace, tr) {
+				unresolved = append(unresolved, tr)
+			}
+		}
+		if len(unresolved) == 0 {
+			return nil
+		}
+
+		var generation int64
+		if p.Policy != nil {
+			generation = p.Policy.GetGeneration()
+		}
+		return &UnresolvedPolicyTargets{
+			ObjectSource: p.ObjectSource,
+			Generation:   generation,
+			TargetRefs:   unresolved,
+		}
+	}, krtopts.ToOptions("UnresolvedPolicyTargets")...)
+}
+
+func targetExists(kctx krt.HandlerContext, gateways *GatewayIndex, routes *RoutesIndex, ns string, tr ir.PolicyTargetRef) bool {
+	gk := schema.GroupKind{Group: tr.Group, Kind: tr.Kind}
+	if gk.Group != gwv1.GroupName {
+		return true
+	}
+	switch gk.Kind {
+	case "Gateway":
+		key := types.NamespacedName{Namespace: ns, Name: tr.Name}
+		return krt.FetchOne(kctx, gateways.allGateways, krt.FilterObjectName(key)) != nil
+	case "HTTPRoute", "GRPCRoute", "TCPRoute", "TLSRoute", "UDPRoute":
+		return routes.Fetch(kctx, gk, ns, tr.Name) != nil
+	default:
+		return true
+	}
+}
+
+func toPolicyAtt(p ir.PolicyWrapper, sectionName string) ir.PolicyAtt {
+	var generation int64
+	if p.Policy != nil {
+		generation = p.Policy.GetGeneration()
+	}
+	policyRef := p.ObjectSource
+	return ir.PolicyAtt{
+		PolicyIr:  p.PolicyIR,
+		GroupKind: p.GetGroupKind(),
+		PolicyTargetRef: &ir.PolicyTargetRef{
+			Group:       p.Group,
+			Kind:        p.Kind,
+			Name:        p.Name,
+			SectionName: sectionName,
+		},
+		PolicyRef:  &policyRef,
+		Generation: generation,
+		Errors:     p.Errors,
+		SetFields:  specFields(p.Policy),
+	}
+}
+
+// specFields returns the top-level fields set in the spec of the policy object, besides its target
+// refs, or nil if the object has no spec.
+func specFields(obj metav1.Object) []string {
+	if obj == nil {
+		return nil
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil
+	}
+	spec, ok := u["spec"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	fields := []string{}
+	for field, value := range spec {
+		if value == nil || field == "targetRef" || field == "targetRefs" {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	return fields
+}
+
 func (p *PolicyIndex) fetchPolicy(kctx krt.HandlerContext, policyRef ir.ObjectSource) *ir.PolicyWrapper {
 	gk := policyRef.GetGroupKind()
 	if f, ok := p.policiesFetch[gk]; ok {
@@ -433,6 +677,18 @@ func (c RouteWrapper) Equals(in RouteWrapper) bool {
 		} else {
 			return a.Equals(*bhttp)
 		}
+	case *ir.TlsRouteIR:
+		if btls, ok := in.Route.(*ir.TlsRouteIR); !ok {
+			return false
+		} else {
+			return a.Equals(*btls)
+		}
+	case *ir.UdpRouteIR:
+		if budp, ok := in.Route.(*ir.UdpRouteIR); !ok {
+			return false
+		} else {
+			return a.Equals(*budp)
+		}
 	}
 	panic("unknown route type")
 }
@@ -440,6 +696,7 @@ func (c RouteWrapper) Equals(in RouteWrapper) bool {
 type RoutesIndex struct {
 	routes          krt.Collection[RouteWrapper]
 	httpRoutes      krt.Collection[ir.HttpRouteIR]
+	grpcRoutes      krt.Collection[ir.HttpRouteIR]
 	httpByNamespace krt.Index[string, ir.HttpRouteIR]
 	byTargetRef     krt.Index[types.NamespacedName, RouteWrapper]
 
@@ -456,28 +713,43 @@ func (h *RoutesIndex) HasSynced() bool {
 			return false
 		}
 	}
-	return h.httpRoutes.HasSynced() && h.routes.HasSynced() && h.policies.HasSynced() && h.upstreams.HasSynced() && h.refgrants.HasSynced()
+	return h.httpRoutes.HasSynced() && h.grpcRoutes.HasSynced() && h.routes.HasSynced() && h.policies.HasSynced() && h.upstreams.HasSynced() && h.refgrants.HasSynced()
 }
 
 func NewRoutesIndex(
 	krtopts krtutil.KrtOptions,
 	httproutes krt.Collection[*gwv1.HTTPRoute],
+	grpcroutes krt.Collection[*gwv1.GRPCRoute],
 	tcproutes krt.Collection[*gwv1a2.TCPRoute],
+	tlsroutes krt.Collection[*gwv1a2.TLSRoute],
+	udproutes krt.Collection[*gwv1a2.UDPRoute],
 	policies *PolicyIndex,
 	upstreams *UpstreamIndex,
 	refgrants *RefGrantIndex,
 ) *RoutesIndex {
 	h := &RoutesIndex{policies: policies, refgrants: refgrants, upstreams: upstreams}
-	h.hasSyncedFuncs = append(h.hasSyncedFuncs, httproutes.HasSynced, tcproutes.HasSynced)
+	h.hasSyncedFuncs = append(h.hasSyncedFuncs, httproutes.HasSynced, grpcroutes.HasSynced, tcproutes.HasSynced, tlsroutes.HasSynced, udproutes.HasSynced)
 	h.httpRoutes = krt.NewCollection(httproutes, h.transformHttpRoute, krtopts.ToOptions("http-routes-with-policy")...)
 	hr := krt.NewCollection(h.httpRoutes, func(kctx krt.HandlerContext, i ir.HttpRouteIR) *RouteWrapper {
 		return &RouteWrapper{Route: &i}
 	}, krtopts.ToOptions("routes-http-routes-with-policy")...)
+	h.grpcRoutes = krt.NewCollection(grpcroutes, h.transformGrpcRoute, krtopts.ToOptions("grpc-routes-with-policy")...)
+	gr := krt.NewCollection(h.grpcRoutes, func(kctx krt.HandlerContext, i ir.HttpRouteIR) *RouteWrapper {
+		return &RouteWrapper{Route: &i}
+	}, krtopts.ToOptions("routes-grpc-routes-with-policy")...)
 	tr := krt.NewCollection(tcproutes, func(kctx krt.HandlerContext, i *gwv1a2.TCPRoute) *RouteWrapper {
 		t := h.transformTcpRoute(kctx, i)
 		return &RouteWrapper{Route: t}
 	}, krtopts.ToOptions("routes-tcp-routes-with-policy")...)
-	h.routes = krt.JoinCollection([]krt.Collection[RouteWrapper]{hr, tr}, krtopts.ToOptions("all-routes-with-policy")...)
+	tlsr := krt.NewCollection(tlsroutes, func(kctx krt.HandlerContext, i *gwv1a2.TLSRoute) *RouteWrapper {
+		t := h.transformTlsRoute(kctx, i)
+		return &RouteWrapper{Route: t}
+	}, krtopts.ToOptions("routes-tls-routes-with-policy")...)
+	udpr := krt.NewCollection(udproutes, func(kctx krt.HandlerContext, i *gwv1a2.UDPRoute) *RouteWrapper {
+		t := h.transformUdpRoute(kctx, i)
+		return &RouteWrapper{Route: t}
+	}, krtopts.ToOptions("routes-udp-routes-with-policy")...)
+	h.routes = krt.JoinCollection([]krt.Collection[RouteWrapper]{hr, gr, tr, tlsr, udpr}, krtopts.ToOptions("all-routes-with-policy")...)
 
 	httpByNamespace := krt.NewIndex(h.httpRoutes, func(i ir.HttpRouteIR) []string {
 		return []string{i.GetNamespace()}
@@ -552,6 +824,48 @@ func (h *RoutesIndex) transformTcpRoute(kctx krt.HandlerContext, i *gwv1a2.TCPRo
 		AttachedPolicies: toAttachedPolicies(h.policies.getTargetingPolicies(kctx, extensionsplug.RouteAttachmentPoint, src, "")),
 	}
 }
+
+func (h *RoutesIndex) transformTlsRoute(kctx krt.HandlerContext, i *gwv1a2.TLSRoute) *ir.TlsRouteIR {
+	src := ir.ObjectSource{
+		Group:     gwv1a2.SchemeGroupVersion.Group,
+		Kind:      "TLSRoute",
+		Namespace: i.Namespace,
+		Name:      i.Name,
+	}
+	var backends []gwv1.BackendRef
+	if len(i.Spec.Rules) > 0 {
+		backends = i.Spec.Rules[0].BackendRefs
+	}
+	return &ir.TlsRouteIR{
+		ObjectSource:     src,
+		SourceObject:     i,
+		ParentRefs:       i.Spec.ParentRefs,
+		Hostnames:        tostr(i.Spec.Hostnames),
+		Backends:         h.getTcpBackends(kctx, src, backends),
+		AttachedPolicies: toAttachedPolicies(h.policies.getTargetingPolicies(kctx, extensionsplug.RouteAttachmentPoint, src, "")),
+	}
+}
+
+func (h *RoutesIndex) transformUdpRoute(kctx krt.HandlerContext, i *gwv1a2.UDPRoute) *ir.UdpRouteIR {
+	src := ir.ObjectSource{
+		Group:     gwv1a2.SchemeGroupVersion.Group,
+		Kind:      "UDPRoute",
+		Namespace: i.Namespace,
+		Name:      i.Name,
+	}
+	var backends []gwv1.BackendRef
+	if len(i.Spec.Rules) > 0 {
+		backends = i.Spec.Rules[0].BackendRefs
+	}
+	return &ir.UdpRouteIR{
+		ObjectSource:     src,
+		SourceObject:     i,
+		ParentRefs:       i.Spec.ParentRefs,
+		Backends:         h.getTcpBackends(kctx, src, backends),
+		AttachedPolicies: toAttachedPolicies(h.policies.getTargetingPolicies(kctx, extensionsplug.RouteAttachmentPoint, src, "")),
+	}
+}
+
 func (h *RoutesIndex) transformHttpRoute(kctx krt.HandlerContext, i *gwv1.HTTPRoute) *ir.HttpRouteIR {
 	src := ir.ObjectSource{
 		Group:     gwv1.SchemeGroupVersion.Group,
@@ -569,10 +883,51 @@ func (h *RoutesIndex) transformHttpRoute(kctx krt.HandlerContext, i *gwv1.HTTPRo
 		AttachedPolicies: toAttachedPolicies(h.policies.getTargetingPolicies(kctx, extensionsplug.RouteAttachmentPoint, src, "")),
 	}
 }
+
+// transformGrpcRoute converts a GRPCRoute into the same IR as an HTTPRoute, so it is translated into
+// Envoy routes by the regular HTTP route translation.
+func (h *RoutesIndex) transformGrpcRoute(kctx krt.HandlerContext, i *gwv1.GRPCRoute) *ir.HttpRouteIR {
+	src := ir.ObjectSource{
+		Group:     gwv1.SchemeGroupVersion.Group,
+		Kind:      "GRPCRoute",
+		Namespace: i.Namespace,
+		Name:      i.Name,
+	}
+
+	return &ir.HttpRouteIR{
+		ObjectSource:     src,
+		SourceObject:     i,
+		ParentRefs:       i.Spec.ParentRefs,
+		Hostnames:        tostr(i.Spec.Hostnames),
+		Rules:            h.transformGrpcRules(kctx, src, i.Spec.Rules),
+		AttachedPolicies: toAttachedPolicies(h.policies.getTargetingPolicies(kctx, extensionsplug.RouteAttachmentPoint, src, "")),
+	}
+}
+
+func (h *RoutesIndex) transformGrpcRules(kctx krt.HandlerContext, src ir.ObjectSource, i []gwv1.GRPCRouteRule) []ir.HttpRouteRuleIR {
+	rules := make([]ir.HttpRouteRuleIR, 0, len(i))
+	for _, r := range i {
+		extensionRefs := h.getExtensionRefs(kctx, src, toHttpFilters(r.Filters))
+		var policies ir.AttachedPolicies
+		if r.Name != nil {
+			policies = toAttachedPolicies(h.policies.getTargetingPolicies(kctx, extensionsplug.RouteAttachmentPoint, src, string(*r.Name)))
+		}
+
+		rules = append(rules, ir.HttpRouteRuleIR{
+			ExtensionRefs:    extensionRefs,
+			AttachedPolicies: policies,
+			Backends:         h.getBackends(kctx, src, toHttpBackendRefs(r.BackendRefs)),
+			Matches:          toHttpMatches(r.Matches),
+			Name:             emptyIfNil(r.Name),
+		})
+	}
+	return rules
+}
+
 func (h *RoutesIndex) transformRules(kctx krt.HandlerContext, src ir.ObjectSource, i []gwv1.HTTPRouteRule) []ir.HttpRouteRuleIR {
 	rules := make([]ir.HttpRouteRuleIR, 0, len(i))
 	for _, r := range i {
-		extensionRefs := h.getExtensionRefs(kctx, src.Namespace, r.Filters)
+		extensionRefs := h.getExtensionRefs(kctx, src, r.Filters)
 		var policies ir.AttachedPolicies
 		if r.Name != nil {
 			policies = toAttachedPolicies(h.policies.getTargetingPolicies(kctx, extensionsplug.RouteAttachmentPoint, src, string(*r.Name)))
@@ -589,13 +944,13 @@ func (h *RoutesIndex) transformRules(kctx krt.HandlerContext, src ir.ObjectSourc
 	return rules
 }
 
-func (h *RoutesIndex) getExtensionRefs(kctx krt.HandlerContext, ns string, r []gwv1.HTTPRouteFilter) ir.AttachedPolicies {
+func (h *RoutesIndex) getExtensionRefs(kctx krt.HandlerContext, src ir.ObjectSource, r []gwv1.HTTPRouteFilter) ir.AttachedPolicies {
 	ret := ir.AttachedPolicies{
 		Policies: map[schema.GroupKind][]ir.PolicyAtt{},
 	}
 	for _, ext := range r {
 		// TODO: propagate error if we can't find the extension
-		gk, policy := h.resolveExtension(kctx, ns, ext)
+		gk, policy := h.resolveExtension(kctx, src, ext)
 		if policy != nil {
 			ret.Policies[gk] = append(ret.Policies[gk], ir.PolicyAtt{PolicyIr: policy /*direct attachment - no target ref*/})
 		}
@@ -603,7 +958,7 @@ func (h *RoutesIndex) getExtensionRefs(kctx krt.HandlerContext, ns string, r []g
 	return ret
 }
 
-func (h *RoutesIndex) resolveExtension(kctx krt.HandlerContext, ns string, ext gwv1.HTTPRouteFilter) (schema.GroupKind, ir.PolicyIR) {
+func (h *RoutesIndex) resolveExtension(kctx krt.HandlerContext, src ir.ObjectSource, ext gwv1.HTTPRouteFilter) (schema.GroupKind, ir.PolicyIR) {
 	if ext.Type == gwv1.HTTPRouteFilterExtensionRef {
 		if ext.ExtensionRef == nil {
 			// TODO: report error!!
@@ -614,7 +969,7 @@ func (h *RoutesIndex) resolveExtension(kctx krt.HandlerContext, ns string, ext g
 		key := ir.ObjectSource{
 			Group:     string(ref.Group),
 			Kind:      string(ref.Kind),
-			Namespace: ns,
+			Namespace: src.Namespace,
 			Name:      string(ref.Name),
 		}
 		policy := h.policies.fetchPolicy(kctx, key)
@@ -630,12 +985,7 @@ func (h *RoutesIndex) resolveExtension(kctx krt.HandlerContext, ns string, ext g
 		return gk, policy.PolicyIR
 	}
 
-	fromGK := schema.GroupKind{
-		Group: gwv1.SchemeGroupVersion.Group,
-		Kind:  "HTTPRoute",
-	}
-
-	return VirtualBuiltInGK, NewBuiltInIr(kctx, ext, fromGK, ns, h.refgrants, h.upstreams)
+	return VirtualBuiltInGK, NewBuiltInIr(kctx, ext, src.GetGroupKind(), src.Namespace, h.refgrants, h.upstreams)
 }
 
 func toFromBackendRef(fromns string, ref gwv1.BackendObjectReference) ir.ObjectSource {
@@ -650,11 +1000,12 @@ func toFromBackendRef(fromns string, ref gwv1.BackendObjectReference) ir.ObjectS
 func (h *RoutesIndex) getBackends(kctx krt.HandlerContext, src ir.ObjectSource, i []gwv1.HTTPBackendRef) []ir.HttpBackendOrDelegate {
 	backends := make([]ir.HttpBackendOrDelegate, 0, len(i))
 	for _, ref := range i {
-		extensionRefs := h.getExtensionRefs(kctx, src.Namespace, ref.Filters)
+		extensionRefs := h.getExtensionRefs(kctx, src, ref.Filters)
 		fromns := src.Namespace
 
 		to := toFromBackendRef(fromns, ref.BackendObjectReference)
-		if backendref.RefIsHTTPRoute(ref.BackendRef.BackendObjectReference) {
+		// only HTTPRoutes can delegate to other routes
+		if src.Kind == "HTTPRoute" && backendref.RefIsHTTPRoute(ref.BackendRef.BackendObjectReference) {
 			backends = append(backends, ir.HttpBackendOrDelegate{
 				Delegate:         &to,
 				AttachedPolicies: extensionRefs,
@@ -730,7 +1081,14 @@ func toAttachedPolicies(policies []ir.PolicyAtt) ir.AttachedPolicies {
 			Group: p.GroupKind.Group,
 			Kind:  p.GroupKind.Kind,
 		}
-		ret.Policies[gk] = append(ret.Policies[gk], ir.PolicyAtt{PolicyIr: p.PolicyIr, PolicyTargetRef: p.PolicyTargetRef})
+		ret.Policies[gk] = append(ret.Policies[gk], ir.PolicyAtt{
+			PolicyIr:        p.PolicyIr,
+			PolicyTargetRef: p.PolicyTargetRef,
+			PolicyRef:       p.PolicyRef,
+			Generation:      p.Generation,
+			Errors:          p.Errors,
+			SetFields:       p.SetFields,
+		})
 	}
 	return ret
 }
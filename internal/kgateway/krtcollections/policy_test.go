@@ -16,6 +16,8 @@ import (
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
@@ -30,6 +32,7 @@ var (
 
 func backends(refN, refNs string) []any {
 	return []any{httpRouteWithBackendRef(refN, refNs),
+		grpcRouteWithBackendRef(refN, refNs),
 		tcpRouteWithBackendRef(refN, refNs),
 	}
 }
@@ -124,6 +127,7 @@ func TestFailWitWithRefGrantAndWrongFrom(t *testing.T) {
 	rg := refGrant()
 	rg.Spec.From[0].Kind = gwv1.Kind("NotARoute")
 	rg.Spec.From[1].Kind = gwv1.Kind("NotARoute")
+	rg.Spec.From[2].Kind = gwv1.Kind("NotARoute")
 
 	inputs := []any{
 		rg,
@@ -201,6 +205,125 @@ func TestFailWithWrongNs(t *testing.T) {
 	}
 }
 
+func TestGrpcRouteBackendsUseHttp2(t *testing.T) {
+	inputs := []any{
+		svc(""),
+	}
+
+	for _, backend := range backends("foo", "") {
+		t.Run(fmt.Sprintf("backend %T", backend), func(t *testing.T) {
+			inputs := append(inputs, backend)
+			route := translateRoute(t, inputs)
+			if route == nil {
+				t.Fatalf("expected ir")
+			}
+			backends := getBackends(route)
+			if backends == nil {
+				t.Fatalf("expected backends")
+			}
+			expected := route.GetGroupKind().Kind == "GRPCRoute"
+			if actual := backends[0].Upstream.AppProtocol == ir.HTTP2AppProtocol; actual != expected {
+				t.Fatalf("expected http2 upstream to be %v, got app protocol %q", expected, backends[0].Upstream.AppProtocol)
+			}
+		})
+	}
+}
+
+func TestGrpcRouteMatches(t *testing.T) {
+	exact := gwv1.GRPCMethodMatchExact
+	regex := gwv1.GRPCMethodMatchRegularExpression
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		method    *gwv1.GRPCMethodMatch
+		pathType  gwv1.PathMatchType
+		pathValue string
+	}{
+		{
+			name:      "service and method",
+			method:    &gwv1.GRPCMethodMatch{Type: &exact, Service: str("echo.Echo"), Method: str("Ping")},
+			pathType:  gwv1.PathMatchExact,
+			pathValue: "/echo.Echo/Ping",
+		},
+		{
+			name:      "service only",
+			method:    &gwv1.GRPCMethodMatch{Service: str("echo.Echo")},
+			pathType:  gwv1.PathMatchPathPrefix,
+			pathValue: "/echo.Echo/",
+		},
+		{
+			name:      "method only",
+			method:    &gwv1.GRPCMethodMatch{Method: str("Ping")},
+			pathType:  gwv1.PathMatchRegularExpression,
+			pathValue: "/[^/]+/Ping",
+		},
+		{
+			name:      "regular expression",
+			method:    &gwv1.GRPCMethodMatch{Type: &regex, Service: str("echo\\..*")},
+			pathType:  gwv1.PathMatchRegularExpression,
+			pathValue: "/echo\\..*/[^/]+",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := toHttpMatches([]gwv1.GRPCRouteMatch{{Method: tc.method}})
+			path := matches[0].Path
+			if path == nil {
+				t.Fatalf("expected path match")
+			}
+			if *path.Type != tc.pathType || *path.Value != tc.pathValue {
+				t.Fatalf("expected %s %s, got %s %s", tc.pathType, tc.pathValue, *path.Type, *path.Value)
+			}
+		})
+	}
+
+	if matches := toHttpMatches([]gwv1.GRPCRouteMatch{{}}); matches[0].Path != nil {
+		t.Fatalf("expected match without method to match any path")
+	}
+}
+
+func TestGrpcRouteCrossNamespaceBackendsUseHttp2(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   []any
+		expected ir.AppProtocol
+	}{
+		{
+			name:     "with reference grant",
+			inputs:   []any{svc("default2"), grpcRouteWithBackendRef("foo", "default2"), refGrant()},
+			expected: ir.HTTP2AppProtocol,
+		},
+		{
+			name:     "without reference grant",
+			inputs:   []any{svc("default2"), grpcRouteWithBackendRef("foo", "default2")},
+			expected: ir.DefaultAppProtocol,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := krttest.NewMock(t, tc.inputs)
+			services := krttest.GetMockCollection[*corev1.Service](mock)
+			policies := NewPolicyIndex(krtutil.KrtOptions{}, extensionsplug.ContributesPolicies{})
+			refgrants := NewRefGrantIndex(krttest.GetMockCollection[*gwv1beta1.ReferenceGrant](mock))
+			upstreams := NewUpstreamIndex(krtutil.KrtOptions{}, nil, policies, refgrants)
+			upstreams.setGrpcRoutes(krttest.GetMockCollection[*gwv1.GRPCRoute](mock))
+			upstreams.AddUpstreams(SvcGk, k8sUpstreams(services))
+			for !upstreams.HasSynced() {
+				time.Sleep(time.Second / 10)
+			}
+
+			us := upstreams.Upstreams()[0].List()
+			if len(us) != 1 {
+				t.Fatalf("expected 1 upstream, got %d", len(us))
+			}
+			if us[0].AppProtocol != tc.expected {
+				t.Fatalf("expected app protocol %q, got %q", tc.expected, us[0].AppProtocol)
+			}
+		})
+	}
+}
+
 func svc(ns string) *corev1.Service {
 	if ns == "" {
 		ns = "default"
@@ -238,6 +361,11 @@ func refGrant() *gwv1beta1.ReferenceGrant {
 					Kind:      gwv1.Kind("TCPRoute"),
 					Namespace: gwv1.Namespace("default"),
 				},
+				{
+					Group:     gwv1.Group("gateway.networking.k8s.io"),
+					Kind:      gwv1.Kind("GRPCRoute"),
+					Namespace: gwv1.Namespace("default"),
+				},
 			},
 			To: []gwv1beta1.ReferenceGrantTo{
 				{
@@ -300,6 +428,38 @@ func httpRouteWithBackendRef(refN, refNs string) *gwv1.HTTPRoute {
 		},
 	}
 }
+func grpcRouteWithBackendRef(refN, refNs string) *gwv1.GRPCRoute {
+	var ns *gwv1.Namespace
+	if refNs != "" {
+		n := gwv1.Namespace(refNs)
+		ns = &n
+	}
+	var port gwv1.PortNumber = 8080
+	return &gwv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "grpcroute",
+			Namespace: "default",
+		},
+		Spec: gwv1.GRPCRouteSpec{
+			Rules: []gwv1.GRPCRouteRule{
+				{
+					BackendRefs: []gwv1.GRPCBackendRef{
+						{
+							BackendRef: gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name:      gwv1.ObjectName(refN),
+									Namespace: ns,
+									Port:      &port,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func tcpRouteWithBackendRef(refN, refNs string) *gwv1a2.TCPRoute {
 	var ns *gwv1.Namespace
 	if refNs != "" {
@@ -336,12 +496,16 @@ func preRouteIndex(t *testing.T, inputs []any) *RoutesIndex {
 
 	policies := NewPolicyIndex(krtutil.KrtOptions{}, extensionsplug.ContributesPolicies{})
 	refgrants := NewRefGrantIndex(krttest.GetMockCollection[*gwv1beta1.ReferenceGrant](mock))
+	grpcroutes := krttest.GetMockCollection[*gwv1.GRPCRoute](mock)
 	upstreams := NewUpstreamIndex(krtutil.KrtOptions{}, nil, policies, refgrants)
+	upstreams.setGrpcRoutes(grpcroutes)
 	upstreams.AddUpstreams(SvcGk, k8sUpstreams(services))
 
 	httproutes := krttest.GetMockCollection[*gwv1.HTTPRoute](mock)
 	tcpproutes := krttest.GetMockCollection[*gwv1a2.TCPRoute](mock)
-	rtidx := NewRoutesIndex(krtutil.KrtOptions{}, httproutes, tcpproutes, policies, upstreams, refgrants)
+	tlsroutes := krttest.GetMockCollection[*gwv1a2.TLSRoute](mock)
+	udproutes := krttest.GetMockCollection[*gwv1a2.UDPRoute](mock)
+	rtidx := NewRoutesIndex(krtutil.KrtOptions{}, httproutes, grpcroutes, tcpproutes, tlsroutes, udproutes, policies, upstreams, refgrants)
 	services.WaitUntilSynced(nil)
 	for !rtidx.HasSynced() || !refgrants.HasSynced() {
 		time.Sleep(time.Second / 10)
@@ -375,6 +539,13 @@ func translateRoute(t *testing.T, inputs []any) ir.Route {
 	if t := rtidx.Fetch(krt.TestingDummyContext{}, tcpGk, "default", "tcproute"); t != nil {
 		return t.Route
 	}
+	grpcGk := schema.GroupKind{
+		Group: gwv1.GroupName,
+		Kind:  "GRPCRoute",
+	}
+	if g := rtidx.Fetch(krt.TestingDummyContext{}, grpcGk, "default", "grpcroute"); g != nil {
+		return g.Route
+	}
 
 	h := rtidx.FetchHttp(krt.TestingDummyContext{}, "default", "httproute")
 	if h == nil {
@@ -383,3 +554,60 @@ func translateRoute(t *testing.T, inputs []any) ir.Route {
 	}
 	return h
 }
+
+func TestUnresolvedPolicyTargets(t *testing.T) {
+	gateway := func(name, className string) *gwv1.Gateway {
+		return &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: gwv1.ObjectName(className)},
+		}
+	}
+	policy := ir.PolicyWrapper{
+		ObjectSource: ir.ObjectSource{Group: "gateway.kgateway.dev", Kind: "RoutePolicy", Namespace: "default", Name: "policy"},
+		TargetRefs: []ir.PolicyTargetRef{
+			{Group: gwv1.GroupName, Kind: "Gateway", Name: "ours"},
+			{Group: gwv1.GroupName, Kind: "Gateway", Name: "theirs"},
+			{Group: gwv1.GroupName, Kind: "Gateway", Name: "missing"},
+		},
+	}
+
+	mock := krttest.NewMock(t, []any{gateway("ours", "kgateway"), gateway("theirs", "other")})
+	policyCol := krt.NewStaticCollection([]ir.PolicyWrapper{policy})
+	policies := NewPolicyIndex(krtutil.KrtOptions{}, extensionsplug.ContributesPolicies{
+		{Group: "gateway.kgateway.dev", Kind: "RoutePolicy"}: {Policies: policyCol},
+	})
+	gateways := NewGatewayIndex(krtutil.KrtOptions{}, func(gw *gwv1.Gateway) bool {
+		return gw.Spec.GatewayClassName == "kgateway"
+	}, policies, krttest.GetMockCollection[*gwv1.Gateway](mock), krttest.GetMockCollection[*apixv1alpha1.XListenerSet](mock), krttest.GetMockCollection[SelfManagedGateway](mock), krttest.GetMockCollection[*corev1.Service](mock))
+	routes := preRouteIndex(t, nil)
+
+	unresolved := NewUnresolvedPolicyTargets(krtutil.KrtOptions{}, policies, gateways, routes)
+	unresolved.WaitUntilSynced(nil)
+
+	got := unresolved.List()
+	if len(got) != 1 {
+		t.Fatalf("expected one policy with unresolved targets, got %d", len(got))
+	}
+	if len(got[0].TargetRefs) != 1 || got[0].TargetRefs[0].Name != "missing" {
+		t.Fatalf("expected only the missing gateway to be unresolved, got %v", got[0].TargetRefs)
+	}
+}
+
+func TestSpecFields(t *testing.T) {
+	policy := &v1alpha1.RoutePolicy{
+		Spec: v1alpha1.RoutePolicySpec{
+			TargetRef: v1alpha1.LocalPolicyTargetReference{Group: gwv1.GroupName, Kind: "HTTPRoute", Name: "route"},
+			Timeout:   10,
+			Retry:     &v1alpha1.RetryPolicy{},
+		},
+	}
+	if got := specFields(policy); strings.Join(got, ",") != "retry,timeout" {
+		t.Fatalf("expected the retry and timeout fields, got %v", got)
+	}
+	if got := specFields(&v1alpha1.RoutePolicy{}); got == nil || len(got) != 0 {
+		t.Fatalf("expected no fields, got %v", got)
+	}
+	if got := specFields(nil); got != nil {
+		t.Fatalf("expected unknown fields, got %v", got)
+	}
+}
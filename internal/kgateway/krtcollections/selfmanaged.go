@@ -0,0 +1,99 @@
+package krtcollections
+
+import (
+	"context"
+
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+)
+
+// SelfManagedGateway is a Gateway whose proxy is self-managed, as set by the GatewayParameters of
+// the Gateway or the default GatewayParameters of its GatewayClass. It is keyed by the role of
+// its proxy, so the role claimed by a proxy can be checked against it.
+type SelfManagedGateway struct {
+	types.NamespacedName
+}
+
+func (g SelfManagedGateway) ResourceName() string {
+	return xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, g.Namespace, g.Name)
+}
+
+func (g SelfManagedGateway) Equals(in SelfManagedGateway) bool {
+	return g == in
+}
+
+var (
+	_ krt.ResourceNamer               = SelfManagedGateway{}
+	_ krt.Equaler[SelfManagedGateway] = SelfManagedGateway{}
+)
+
+func NewSelfManagedGateways(ctx context.Context, istioClient kube.Client, krtOpts krtutil.KrtOptions) krt.Collection[SelfManagedGateway] {
+	registerTypes()
+	gateways := krt.WrapClient(kclient.New[*gwv1.Gateway](istioClient), krtOpts.ToOptions("SelfManagedKubeGateways")...)
+	gatewayClasses := krt.WrapClient(kclient.New[*gwv1.GatewayClass](istioClient), krtOpts.ToOptions("GatewayClasses")...)
+	gatewayParameters := krtutil.SetupCollectionDynamic[v1alpha1.GatewayParameters](
+		ctx,
+		istioClient,
+		v1alpha1.SchemeGroupVersion.WithResource("gatewayparameters"),
+		krtOpts.ToOptions("GatewayParameters")...,
+	)
+	return NewSelfManagedGatewaysFromCols(gateways, gatewayClasses, gatewayParameters, krtOpts)
+}
+
+// NewSelfManagedGatewaysFromCols resolves the GatewayParameters of the Gateways the way the
+// deployer merges them: a Gateway is self-managed if either its own GatewayParameters or the
+// default GatewayParameters of its GatewayClass are.
+func NewSelfManagedGatewaysFromCols(
+	gateways krt.Collection[*gwv1.Gateway],
+	gatewayClasses krt.Collection[*gwv1.GatewayClass],
+	gatewayParameters krt.Collection[*v1alpha1.GatewayParameters],
+	krtOpts krtutil.KrtOptions,
+) krt.Collection[SelfManagedGateway] {
+	selfManaged := func(kctx krt.HandlerContext, namespace, name string) bool {
+		if name == "" {
+			return false
+		}
+		gwp := krt.FetchOne(kctx, gatewayParameters, krt.FilterKey(types.NamespacedName{Namespace: namespace, Name: name}.String()))
+		return gwp != nil && (*gwp).Spec.SelfManaged != nil
+	}
+	return krt.NewCollection(gateways, func(kctx krt.HandlerContext, gw *gwv1.Gateway) *SelfManagedGateway {
+		// the GatewayParameters of the Gateway must live in its namespace
+		if selfManaged(kctx, gw.Namespace, gatewayParametersName(gw)) {
+			return &SelfManagedGateway{NamespacedName: types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}}
+		}
+		gwc := krt.FetchOne(kctx, gatewayClasses, krt.FilterKey(string(gw.Spec.GatewayClassName)))
+		if gwc == nil || (*gwc).Spec.ParametersRef == nil {
+			return nil
+		}
+		ref := (*gwc).Spec.ParametersRef
+		namespace := ""
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		if selfManaged(kctx, namespace, ref.Name) {
+			return &SelfManagedGateway{NamespacedName: types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}}
+		}
+		return nil
+	}, krtOpts.ToOptions("SelfManagedGateways")...)
+}
+
+// gatewayParametersName returns the name of the GatewayParameters specific to the Gateway, if
+// any, as the deployer does.
+func gatewayParametersName(gw *gwv1.Gateway) string {
+	if gw.Spec.Infrastructure != nil && gw.Spec.Infrastructure.ParametersRef != nil {
+		ref := gw.Spec.Infrastructure.ParametersRef
+		if string(ref.Group) != v1alpha1.GroupName || string(ref.Kind) != v1alpha1.GatewayParametersKind {
+			return ""
+		}
+		return ref.Name
+	}
+	return gw.GetAnnotations()[wellknown.GatewayParametersAnnotationName]
+}
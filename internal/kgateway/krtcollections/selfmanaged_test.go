@@ -0,0 +1,83 @@
+package krtcollections_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	. "github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func TestSelfManagedGateways(t *testing.T) {
+	g := NewWithT(t)
+
+	gatewayParameters := func(namespace, name string, selfManaged bool) *v1alpha1.GatewayParameters {
+		gwp := &v1alpha1.GatewayParameters{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+		if selfManaged {
+			gwp.Spec.SelfManaged = &v1alpha1.SelfManagedGateway{}
+		} else {
+			gwp.Spec.Kube = &v1alpha1.KubernetesProxyConfig{}
+		}
+		return gwp
+	}
+	gatewayClass := func(name, gwpName string) *gwv1.GatewayClass {
+		return &gwv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gwv1.GatewayClassSpec{
+				ParametersRef: &gwv1.ParametersReference{
+					Group:     v1alpha1.GroupName,
+					Kind:      v1alpha1.GatewayParametersKind,
+					Name:      gwpName,
+					Namespace: ptr.To(gwv1.Namespace("kgateway-system")),
+				},
+			},
+		}
+	}
+	gateway := func(name, class string, annotations map[string]string) *gwv1.Gateway {
+		return &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, Annotations: annotations},
+			Spec:       gwv1.GatewaySpec{GatewayClassName: gwv1.ObjectName(class)},
+		}
+	}
+
+	mock := krttest.NewMock(t, []any{
+		gatewayClass("deployed", "deployed"),
+		gatewayClass("self-managed", "self-managed"),
+		gatewayParameters("kgateway-system", "deployed", false),
+		gatewayParameters("kgateway-system", "self-managed", true),
+		gatewayParameters("ns", "self-managed", true),
+		gatewayParameters("ns", "deployed", false),
+		// self-managed by the default GatewayParameters of its class
+		gateway("class-default", "self-managed", nil),
+		// self-managed by its own GatewayParameters
+		gateway("override", "deployed", map[string]string{wellknown.GatewayParametersAnnotationName: "self-managed"}),
+		gateway("deployed", "deployed", map[string]string{wellknown.GatewayParametersAnnotationName: "deployed"}),
+		// a missing GatewayParameters does not make the Gateway self-managed
+		gateway("missing-parameters", "deployed", map[string]string{wellknown.GatewayParametersAnnotationName: "missing"}),
+	})
+	selfManaged := NewSelfManagedGatewaysFromCols(
+		krttest.GetMockCollection[*gwv1.Gateway](mock),
+		krttest.GetMockCollection[*gwv1.GatewayClass](mock),
+		krttest.GetMockCollection[*v1alpha1.GatewayParameters](mock),
+		krtutil.KrtOptions{},
+	)
+	selfManaged.WaitUntilSynced(nil)
+
+	var got []types.NamespacedName
+	for _, gw := range selfManaged.List() {
+		got = append(got, gw.NamespacedName)
+	}
+	g.Expect(got).To(ConsistOf(
+		types.NamespacedName{Namespace: "ns", Name: "class-default"},
+		types.NamespacedName{Namespace: "ns", Name: "override"},
+	))
+	g.Expect(selfManaged.GetKey(wellknown.GatewayApiProxyValue + "~ns~override")).NotTo(BeNil())
+}
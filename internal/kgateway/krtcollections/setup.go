@@ -13,10 +13,12 @@ import (
 	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/config/schema/gvr"
 	skubeclient "istio.io/istio/pkg/config/schema/kubeclient"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
@@ -33,6 +35,16 @@ func registerTypes() {
 			return c.GatewayAPI().GatewayV1().HTTPRoutes(namespace).Watch(context.Background(), o)
 		},
 	)
+	skubeclient.Register[*gwv1.GRPCRoute](
+		gvr.GRPCRoute,
+		gvk.GRPCRoute.Kubernetes(),
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (runtime.Object, error) {
+			return c.GatewayAPI().GatewayV1().GRPCRoutes(namespace).List(context.Background(), o)
+		},
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (watch.Interface, error) {
+			return c.GatewayAPI().GatewayV1().GRPCRoutes(namespace).Watch(context.Background(), o)
+		},
+	)
 	skubeclient.Register[*gwv1a2.TCPRoute](
 		gvr.TCPRoute,
 		gvk.TCPRoute.Kubernetes(),
@@ -43,6 +55,36 @@ func registerTypes() {
 			return c.GatewayAPI().GatewayV1alpha2().TCPRoutes(namespace).Watch(context.Background(), o)
 		},
 	)
+	skubeclient.Register[*gwv1a2.TLSRoute](
+		gvr.TLSRoute,
+		gvk.TLSRoute.Kubernetes(),
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (runtime.Object, error) {
+			return c.GatewayAPI().GatewayV1alpha2().TLSRoutes(namespace).List(context.Background(), o)
+		},
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (watch.Interface, error) {
+			return c.GatewayAPI().GatewayV1alpha2().TLSRoutes(namespace).Watch(context.Background(), o)
+		},
+	)
+	skubeclient.Register[*gwv1a2.UDPRoute](
+		gvr.UDPRoute,
+		gvk.UDPRoute.Kubernetes(),
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (runtime.Object, error) {
+			return c.GatewayAPI().GatewayV1alpha2().UDPRoutes(namespace).List(context.Background(), o)
+		},
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (watch.Interface, error) {
+			return c.GatewayAPI().GatewayV1alpha2().UDPRoutes(namespace).Watch(context.Background(), o)
+		},
+	)
+	skubeclient.Register[*gwv1.GatewayClass](
+		gvr.GatewayClass_v1,
+		gvk.GatewayClass_v1.Kubernetes(),
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (runtime.Object, error) {
+			return c.GatewayAPI().GatewayV1().GatewayClasses().List(context.Background(), o)
+		},
+		func(c skubeclient.ClientGetter, namespace string, o metav1.ListOptions) (watch.Interface, error) {
+			return c.GatewayAPI().GatewayV1().GatewayClasses().Watch(context.Background(), o)
+		},
+	)
 	skubeclient.Register[*gwv1.Gateway](
 		gvr.KubernetesGateway_v1,
 		gvk.KubernetesGateway_v1.Kubernetes(),
@@ -61,27 +103,50 @@ func InitCollections(
 	istioClient kube.Client,
 	isOurGw func(gw *gwv1.Gateway) bool,
 	refgrants *RefGrantIndex,
+	enableListenerSets bool,
 	krtopts krtutil.KrtOptions,
-) (*GatewayIndex, *RoutesIndex, *UpstreamIndex, krt.Collection[ir.EndpointsForUpstream]) {
+) (*GatewayIndex, *RoutesIndex, *UpstreamIndex, krt.Collection[ir.EndpointsForUpstream], *PolicyIndex) {
 	registerTypes()
 
 	httpRoutes := krt.WrapClient(kclient.New[*gwv1.HTTPRoute](istioClient), krtopts.ToOptions("HTTPRoute")...)
+	grpcRoutes := krt.WrapClient(kclient.New[*gwv1.GRPCRoute](istioClient), krtopts.ToOptions("GRPCRoute")...)
 	kubeRawGateways := krt.WrapClient(kclient.New[*gwv1.Gateway](istioClient), krtopts.ToOptions("KubeGateways")...)
+	// the XListenerSet API is experimental, so no listener sets attach unless it is enabled
+	var listenerSets krt.Collection[*apixv1alpha1.XListenerSet] = krt.NewStaticCollection[*apixv1alpha1.XListenerSet](nil, krtopts.ToOptions("XListenerSet")...)
+	if enableListenerSets {
+		listenerSets = krtutil.SetupCollectionDynamic[apixv1alpha1.XListenerSet](
+			ctx,
+			istioClient,
+			apixv1alpha1.SchemeGroupVersion.WithResource("xlistenersets"),
+			krtopts.ToOptions("XListenerSet")...,
+		)
+	}
 
 	tcproutes := krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.TCPRoute](istioClient, gvr.TCPRoute, kubetypes.StandardInformer, kclient.Filter{}), krtopts.ToOptions("TCPRoute")...)
+	tlsroutes := krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.TLSRoute](istioClient, gvr.TLSRoute, kubetypes.StandardInformer, kclient.Filter{}), krtopts.ToOptions("TLSRoute")...)
+	udproutes := krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.UDPRoute](istioClient, gvr.UDPRoute, kubetypes.StandardInformer, kclient.Filter{}), krtopts.ToOptions("UDPRoute")...)
+
+	selfManagedGateways := NewSelfManagedGateways(ctx, istioClient, krtopts)
+	services := krt.WrapClient(kclient.New[*corev1.Service](istioClient), krtopts.ToOptions("GatewayServices")...)
 
-	return initCollectionsWithGateways(isOurGw, kubeRawGateways, httpRoutes, tcproutes, refgrants, extensions, krtopts)
+	return initCollectionsWithGateways(isOurGw, kubeRawGateways, listenerSets, selfManagedGateways, services, httpRoutes, grpcRoutes, tcproutes, tlsroutes, udproutes, refgrants, extensions, krtopts)
 }
 
 func initCollectionsWithGateways(
 	isOurGw func(gw *gwv1.Gateway) bool,
 	kubeRawGateways krt.Collection[*gwv1.Gateway],
+	listenerSets krt.Collection[*apixv1alpha1.XListenerSet],
+	selfManagedGateways krt.Collection[SelfManagedGateway],
+	services krt.Collection[*corev1.Service],
 	httpRoutes krt.Collection[*gwv1.HTTPRoute],
+	grpcRoutes krt.Collection[*gwv1.GRPCRoute],
 	tcproutes krt.Collection[*gwv1a2.TCPRoute],
+	tlsroutes krt.Collection[*gwv1a2.TLSRoute],
+	udproutes krt.Collection[*gwv1a2.UDPRoute],
 	refgrants *RefGrantIndex,
 	extensions extensionsplug.Plugin,
 	krtopts krtutil.KrtOptions,
-) (*GatewayIndex, *RoutesIndex, *UpstreamIndex, krt.Collection[ir.EndpointsForUpstream]) {
+) (*GatewayIndex, *RoutesIndex, *UpstreamIndex, krt.Collection[ir.EndpointsForUpstream], *PolicyIndex) {
 	policies := NewPolicyIndex(krtopts, extensions.ContributesPolicies)
 	var backendRefPlugins []extensionsplug.GetBackendForRefPlugin
 	for _, ext := range extensions.ContributesPolicies {
@@ -91,12 +156,13 @@ func initCollectionsWithGateways(
 	}
 
 	upstreamIndex := NewUpstreamIndex(krtopts, backendRefPlugins, policies, refgrants)
+	upstreamIndex.setGrpcRoutes(grpcRoutes)
 	endpointIRs := initUpstreams(extensions, upstreamIndex, krtopts)
 
-	kubeGateways := NewGatewayIndex(krtopts, isOurGw, policies, kubeRawGateways)
+	kubeGateways := NewGatewayIndex(krtopts, isOurGw, policies, kubeRawGateways, listenerSets, selfManagedGateways, services)
 
-	routes := NewRoutesIndex(krtopts, httpRoutes, tcproutes, policies, upstreamIndex, refgrants)
-	return kubeGateways, routes, upstreamIndex, endpointIRs
+	routes := NewRoutesIndex(krtopts, httpRoutes, grpcRoutes, tcproutes, tlsroutes, udproutes, policies, upstreamIndex, refgrants)
+	return kubeGateways, routes, upstreamIndex, endpointIRs, policies
 }
 
 func initUpstreams(
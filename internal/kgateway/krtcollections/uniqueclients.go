@@ -1,9 +1,11 @@
 package krtcollections
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -18,20 +20,60 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
 )
 
 type ConnectedClient struct {
 	uniqueClientName string
+	nodeId           string
+	// state of the xds resources of the stream, by type url
+	resources map[string]*XdsResourceStatus
 }
 
-func newConnectedClient(uniqueClientName string) ConnectedClient {
+func newConnectedClient(uniqueClientName, nodeId string) ConnectedClient {
 	return ConnectedClient{
 		uniqueClientName: uniqueClientName,
+		nodeId:           nodeId,
+		resources:        make(map[string]*XdsResourceStatus),
 	}
 }
 
+// ConnectedClientInfo is an envoy connected to the xds server, along with the unique client it maps to.
+type ConnectedClientInfo struct {
+	StreamId int64  `json:"streamId"`
+	NodeId   string `json:"nodeId"`
+	// ResourceName is the name of the unique client, which is the key of its snapshot in the xds cache.
+	ResourceName string            `json:"resourceName"`
+	Role         string            `json:"role"`
+	Namespace    string            `json:"namespace,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Locality     ir.PodLocality    `json:"locality"`
+	// Resources is the state of each type of xds resources, by type url.
+	Resources map[string]XdsResourceStatus `json:"resources"`
+}
+
+// XdsResourceStatus is the state of a type of xds resources of a stream.
+type XdsResourceStatus struct {
+	// SentVersion is the version of the last response sent to the envoy.
+	SentVersion string `json:"sentVersion,omitempty"`
+	// AckedVersion is the version of the last response the envoy accepted.
+	AckedVersion string `json:"ackedVersion,omitempty"`
+	// NackedVersion is the version of the last response the envoy rejected, if it didn't accept a
+	// response since then.
+	NackedVersion string `json:"nackedVersion,omitempty"`
+	// NackError is the error detail of the rejection.
+	NackError string `json:"nackError,omitempty"`
+
+	sentNonce string
+}
+
+// ConnectedClientsDebugger lists the envoys connected to the xds server, for debugging.
+type ConnectedClientsDebugger interface {
+	ConnectedClients() []ConnectedClientInfo
+}
+
 // Certain parts of translation (mainly priority failover) require different translation for
 // different clients (for example, 2 envoys on different AZs).
 // This collection represents the unique clients (envoys) that are connected to the xds server.
@@ -41,12 +83,13 @@ func newConnectedClient(uniqueClientName string) ConnectedClient {
 // We then fetch that pod to get its labels, create a UniqlyConnectedClient and it them to the collection.
 
 type callbacksCollection struct {
-	logger           *zap.Logger
-	augmentedPods    krt.Collection[LocalityPod]
-	clients          map[int64]ConnectedClient
-	uniqClientsCount map[string]uint64
-	uniqClients      map[string]ir.UniqlyConnectedClient
-	stateLock        sync.RWMutex
+	logger              *zap.Logger
+	augmentedPods       krt.Collection[LocalityPod]
+	selfManagedGateways krt.Collection[SelfManagedGateway]
+	clients             map[int64]ConnectedClient
+	uniqClientsCount    map[string]uint64
+	uniqClients         map[string]ir.UniqlyConnectedClient
+	stateLock           sync.RWMutex
 
 	trigger *krt.RecomputeTrigger
 }
@@ -56,31 +99,45 @@ type callbacks struct {
 }
 
 // If augmentedPods is nil, we won't use the pod locality info, and all pods for the same gateway will receive the same config.
-type UniquelyConnectedClientsBulider func(ctx context.Context, krtOpts krtutil.KrtOptions, augmentedPods krt.Collection[LocalityPod]) krt.Collection[ir.UniqlyConnectedClient]
+// Proxies claiming to be the proxy of a self-managed Gateway are only accepted if the Gateway is in selfManagedGateways.
+type UniquelyConnectedClientsBulider func(
+	ctx context.Context,
+	krtOpts krtutil.KrtOptions,
+	augmentedPods krt.Collection[LocalityPod],
+	selfManagedGateways krt.Collection[SelfManagedGateway],
+) krt.Collection[ir.UniqlyConnectedClient]
 
 // THIS IS THE SET OF THINGS WE RUN TRANSLATION FOR
 // add returned callbacks to the xds server.
+// The returned debugger lists the connected clients, along with the state of their snapshots.
 
-func NewUniquelyConnectedClients() (xdsserver.Callbacks, UniquelyConnectedClientsBulider) {
+func NewUniquelyConnectedClients() (xdsserver.Callbacks, UniquelyConnectedClientsBulider, ConnectedClientsDebugger) {
 	cb := &callbacks{}
 	envoycb := xdsserver.CallbackFuncs{
-		StreamClosedFunc:  cb.OnStreamClosed,
-		StreamRequestFunc: cb.OnStreamRequest,
-		FetchRequestFunc:  cb.OnFetchRequest,
+		StreamClosedFunc:   cb.OnStreamClosed,
+		StreamRequestFunc:  cb.OnStreamRequest,
+		StreamResponseFunc: cb.OnStreamResponse,
+		FetchRequestFunc:   cb.OnFetchRequest,
 	}
-	return envoycb, buildCollection(cb)
+	return envoycb, buildCollection(cb), cb
 }
 
 func buildCollection(callbacks *callbacks) UniquelyConnectedClientsBulider {
-	return func(ctx context.Context, krtOpts krtutil.KrtOptions, augmentedPods krt.Collection[LocalityPod]) krt.Collection[ir.UniqlyConnectedClient] {
+	return func(
+		ctx context.Context,
+		krtOpts krtutil.KrtOptions,
+		augmentedPods krt.Collection[LocalityPod],
+		selfManagedGateways krt.Collection[SelfManagedGateway],
+	) krt.Collection[ir.UniqlyConnectedClient] {
 		trigger := krt.NewRecomputeTrigger(true)
 		col := &callbacksCollection{
-			logger:           contextutils.LoggerFrom(ctx).Desugar(),
-			augmentedPods:    augmentedPods,
-			clients:          make(map[int64]ConnectedClient),
-			uniqClientsCount: make(map[string]uint64),
-			uniqClients:      make(map[string]ir.UniqlyConnectedClient),
-			trigger:          trigger,
+			logger:              contextutils.LoggerFrom(ctx).Desugar(),
+			augmentedPods:       augmentedPods,
+			selfManagedGateways: selfManagedGateways,
+			clients:             make(map[int64]ConnectedClient),
+			uniqClientsCount:    make(map[string]uint64),
+			uniqClients:         make(map[string]ir.UniqlyConnectedClient),
+			trigger:             trigger,
 		}
 
 		callbacks.collection.Store(col)
@@ -117,6 +174,7 @@ func (x *callbacksCollection) del(sid int64) *ir.UniqlyConnectedClient {
 
 	c, ok := x.clients[sid]
 	delete(x.clients, sid)
+	metrics.SetXdsConnectedClients(len(x.clients))
 	if ok {
 		resourceName := c.uniqueClientName
 		current := x.uniqClientsCount[resourceName]
@@ -135,10 +193,38 @@ func roleFromRequest(r *envoy_service_discovery_v3.DiscoveryRequest) string {
 	return r.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
 }
 
+// selfManaged returns whether the request comes from the proxy of a self-managed Gateway. The node
+// metadata is set by the proxy, so the claim is only accepted if the Gateway of its role is
+// self-managed according to its GatewayParameters; otherwise any client could skip the pod lookup
+// and get the configuration of any Gateway.
+func (x *callbacksCollection) selfManaged(r *envoy_service_discovery_v3.DiscoveryRequest) (bool, error) {
+	if !r.GetNode().GetMetadata().GetFields()[xds.SelfManagedKey].GetBoolValue() {
+		return false, nil
+	}
+	role := roleFromRequest(r)
+	if x.selfManagedGateways == nil || x.selfManagedGateways.GetKey(role) == nil {
+		return false, fmt.Errorf("role %s of self-managed node %s is not the role of a self-managed gateway", role, r.GetNode().GetId())
+	}
+	return true, nil
+}
+
+func localityFromNode(node *envoy_config_core_v3.Node) ir.PodLocality {
+	return ir.PodLocality{
+		Region:  node.GetLocality().GetRegion(),
+		Zone:    node.GetLocality().GetZone(),
+		Subzone: node.GetLocality().GetSubZone(),
+	}
+}
+
 func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest) (string, bool, error) {
 	var pod *LocalityPod
-	// see if user wants to use pod locality info
-	usePod := x.augmentedPods != nil
+	// see if user wants to use pod locality info; self-managed proxies may have no pod, so their
+	// locality is taken from the node instead
+	selfManaged, err := x.selfManaged(r)
+	if err != nil {
+		return "", false, err
+	}
+	usePod := x.augmentedPods != nil && !selfManaged
 	if usePod && r.GetNode() != nil {
 		podRef := getRef(r.GetNode())
 		k := krt.Named{Name: podRef.Name, Namespace: podRef.Namespace}.ResourceName()
@@ -152,7 +238,9 @@ func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.Disco
 		var locality ir.PodLocality
 		var ns string
 		var labels map[string]string
-		if usePod {
+		if selfManaged {
+			locality = localityFromNode(r.GetNode())
+		} else if usePod {
 			if pod == nil {
 				// we need to use the pod locality info, so it's an error if we can't get the pod
 				return "", false, fmt.Errorf("pod not found for node %v", r.GetNode())
@@ -166,8 +254,9 @@ func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.Disco
 		x.logger.Debug("adding xds client", zap.Any("locality", locality), zap.String("ns", ns), zap.Any("labels", labels), zap.String("role", role))
 		// TODO: modify request to include the label that are relevant for the client?
 		ucc := ir.NewUniqlyConnectedClient(role, ns, labels, locality)
-		c = newConnectedClient(ucc.ResourceName())
+		c = newConnectedClient(ucc.ResourceName(), r.GetNode().GetId())
 		x.clients[sid] = c
+		metrics.SetXdsConnectedClients(len(x.clients))
 		currentUnique := x.uniqClientsCount[ucc.ResourceName()]
 		x.uniqClientsCount[ucc.ResourceName()] = currentUnique + 1
 		if currentUnique == 0 {
@@ -199,6 +288,7 @@ func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3
 		x.logger.Debug("error processing xds client", zap.Error(err))
 		return err
 	}
+	x.recordRequest(sid, r)
 	if ucc != "" {
 		nodeMd := r.GetNode().GetMetadata()
 		if nodeMd == nil {
@@ -221,6 +311,104 @@ func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3
 	return nil
 }
 
+// recordRequest records whether the request acks or nacks the last response of its type.
+func (x *callbacksCollection) recordRequest(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest) {
+	// the first request of a type is not a response to anything
+	if r.GetResponseNonce() == "" {
+		return
+	}
+
+	x.stateLock.Lock()
+	defer x.stateLock.Unlock()
+	c, ok := x.clients[sid]
+	if !ok {
+		return
+	}
+	status := c.resourceStatus(r.GetTypeUrl())
+	if r.GetErrorDetail() != nil {
+		// the version of a nack is the last accepted version, so the rejected one is the one
+		// of the response with the nonce.
+		status.NackedVersion = ""
+		if r.GetResponseNonce() == status.sentNonce {
+			status.NackedVersion = status.SentVersion
+		}
+		status.NackError = r.GetErrorDetail().GetMessage()
+		if r.GetVersionInfo() != "" {
+			status.AckedVersion = r.GetVersionInfo()
+		}
+		return
+	}
+	status.AckedVersion = r.GetVersionInfo()
+	status.NackedVersion = ""
+	status.NackError = ""
+}
+
+// OnStreamResponse is called immediately prior to sending a response on a stream.
+func (x *callbacks) OnStreamResponse(_ context.Context, sid int64, _ *envoy_service_discovery_v3.DiscoveryRequest, resp *envoy_service_discovery_v3.DiscoveryResponse) {
+	c := x.collection.Load()
+	if c == nil {
+		return
+	}
+	c.recordResponse(sid, resp)
+}
+
+func (x *callbacksCollection) recordResponse(sid int64, resp *envoy_service_discovery_v3.DiscoveryResponse) {
+	x.stateLock.Lock()
+	defer x.stateLock.Unlock()
+	c, ok := x.clients[sid]
+	if !ok {
+		return
+	}
+	status := c.resourceStatus(resp.GetTypeUrl())
+	status.SentVersion = resp.GetVersionInfo()
+	status.sentNonce = resp.GetNonce()
+}
+
+func (c ConnectedClient) resourceStatus(typeUrl string) *XdsResourceStatus {
+	status, ok := c.resources[typeUrl]
+	if !ok {
+		status = &XdsResourceStatus{}
+		c.resources[typeUrl] = status
+	}
+	return status
+}
+
+// ConnectedClients returns the connected envoys, sorted by stream id.
+func (x *callbacks) ConnectedClients() []ConnectedClientInfo {
+	c := x.collection.Load()
+	if c == nil {
+		return nil
+	}
+	return c.connectedClients()
+}
+
+func (x *callbacksCollection) connectedClients() []ConnectedClientInfo {
+	x.stateLock.RLock()
+	defer x.stateLock.RUnlock()
+	out := make([]ConnectedClientInfo, 0, len(x.clients))
+	for sid, c := range x.clients {
+		ucc := x.uniqClients[c.uniqueClientName]
+		info := ConnectedClientInfo{
+			StreamId:     sid,
+			NodeId:       c.nodeId,
+			ResourceName: c.uniqueClientName,
+			Role:         ucc.Role,
+			Namespace:    ucc.Namespace,
+			Labels:       ucc.Labels,
+			Locality:     ucc.Locality,
+			Resources:    make(map[string]XdsResourceStatus, len(c.resources)),
+		}
+		for typeUrl, status := range c.resources {
+			info.Resources[typeUrl] = *status
+		}
+		out = append(out, info)
+	}
+	slices.SortFunc(out, func(a, b ConnectedClientInfo) int {
+		return cmp.Compare(a.StreamId, b.StreamId)
+	})
+	return out
+}
+
 func (x *callbacksCollection) getClients() []ir.UniqlyConnectedClient {
 	x.stateLock.RLock()
 	defer x.stateLock.RUnlock()
@@ -247,8 +435,13 @@ func (x *callbacks) OnFetchRequest(ctx context.Context, r *envoy_service_discove
 }
 
 func (x *callbacksCollection) fetchRequest(_ context.Context, r *envoy_service_discovery_v3.DiscoveryRequest) error {
-	// nothing special to do in a fetch request, as we don't need to maintain state
-	if x.augmentedPods == nil {
+	selfManaged, err := x.selfManaged(r)
+	if err != nil {
+		return err
+	}
+	// nothing special to do in a fetch request, as we don't need to maintain state.
+	// the unique client of a self-managed proxy is its role, so there is nothing to augment either.
+	if x.augmentedPods == nil || selfManaged {
 		return nil
 	}
 
@@ -7,13 +7,16 @@ import (
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/krt/krttest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
@@ -25,6 +28,9 @@ import (
 )
 
 func TestUniqueClients(t *testing.T) {
+	selfManagedGateways := krt.NewStaticCollection([]SelfManagedGateway{
+		{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "self-managed"}},
+	})
 	testCases := []struct {
 		name     string
 		inputs   []any
@@ -69,6 +75,32 @@ func TestUniqueClients(t *testing.T) {
 			},
 			result: sets.New(fmt.Sprintf("gloo-kube-gateway-api~best-proxy-role~%d~ns", utils.HashLabels(map[string]string{corev1.LabelTopologyRegion: "region", corev1.LabelTopologyZone: "zone", "a": "b"}))),
 		},
+		{
+			name: "self-managed",
+			inputs: []any{
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "podname",
+						Namespace: "ns",
+					},
+				},
+			},
+			requests: []*envoy_service_discovery_v3.DiscoveryRequest{
+				{
+					Node: &corev3.Node{
+						Id: "off-cluster",
+						Metadata: &structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								xds.RoleKey:        structpb.NewStringValue(wellknown.GatewayApiProxyValue + "~ns~self-managed"),
+								xds.SelfManagedKey: structpb.NewBoolValue(true),
+							},
+						},
+						Locality: &corev3.Locality{Region: "region", Zone: "zone"},
+					},
+				},
+			},
+			result: sets.New(wellknown.GatewayApiProxyValue + "~ns~self-managed"),
+		},
 		{
 			name:   "no-pods",
 			inputs: nil,
@@ -99,8 +131,8 @@ func TestUniqueClients(t *testing.T) {
 				pods.WaitUntilSynced(context.Background().Done())
 			}
 
-			cb, uccBuilder := NewUniquelyConnectedClients()
-			ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, pods)
+			cb, uccBuilder, _ := NewUniquelyConnectedClients()
+			ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, pods, selfManagedGateways)
 			ucc.WaitUntilSynced(context.Background().Done())
 
 			// check fetch as well
@@ -145,3 +177,108 @@ func TestUniqueClients(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectedClients(t *testing.T) {
+	g := NewWithT(t)
+	cb, uccBuilder, connectedClients := NewUniquelyConnectedClients()
+	ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, nil, nil)
+	ucc.WaitUntilSynced(context.Background().Done())
+
+	role := wellknown.GatewayApiProxyValue + "~best-proxy-role"
+	request := func(version, nonce string, errorDetail *status.Status) *envoy_service_discovery_v3.DiscoveryRequest {
+		return &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: &corev3.Node{
+				Id: "podname.ns",
+				Metadata: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						xds.RoleKey: structpb.NewStringValue(role),
+					},
+				},
+			},
+			TypeUrl:       resource.ClusterType,
+			VersionInfo:   version,
+			ResponseNonce: nonce,
+			ErrorDetail:   errorDetail,
+		}
+	}
+	response := func(version, nonce string) *envoy_service_discovery_v3.DiscoveryResponse {
+		return &envoy_service_discovery_v3.DiscoveryResponse{
+			TypeUrl:     resource.ClusterType,
+			VersionInfo: version,
+			Nonce:       nonce,
+		}
+	}
+
+	g.Expect(cb.OnStreamRequest(1, request("", "", nil))).To(Succeed())
+	cb.OnStreamResponse(context.Background(), 1, nil, response("1", "a"))
+	g.Expect(cb.OnStreamRequest(1, request("1", "a", nil))).To(Succeed())
+
+	clients := connectedClients.ConnectedClients()
+	g.Expect(clients).To(HaveLen(1))
+	g.Expect(clients[0].StreamId).To(Equal(int64(1)))
+	g.Expect(clients[0].NodeId).To(Equal("podname.ns"))
+	g.Expect(clients[0].ResourceName).To(Equal(role))
+	g.Expect(clients[0].Role).To(Equal(role))
+	g.Expect(clients[0].Resources).To(HaveLen(1))
+	g.Expect(clients[0].Resources[resource.ClusterType]).To(And(
+		HaveField("SentVersion", "1"),
+		HaveField("AckedVersion", "1"),
+		HaveField("NackedVersion", ""),
+	))
+
+	// the envoy rejects the next version
+	cb.OnStreamResponse(context.Background(), 1, nil, response("2", "b"))
+	g.Expect(cb.OnStreamRequest(1, request("1", "b", &status.Status{Message: "invalid cluster"}))).To(Succeed())
+	g.Expect(connectedClients.ConnectedClients()[0].Resources[resource.ClusterType]).To(And(
+		HaveField("SentVersion", "2"),
+		HaveField("AckedVersion", "1"),
+		HaveField("NackedVersion", "2"),
+		HaveField("NackError", "invalid cluster"),
+	))
+
+	// and accepts the one after it
+	cb.OnStreamResponse(context.Background(), 1, nil, response("3", "c"))
+	g.Expect(cb.OnStreamRequest(1, request("3", "c", nil))).To(Succeed())
+	g.Expect(connectedClients.ConnectedClients()[0].Resources[resource.ClusterType]).To(And(
+		HaveField("SentVersion", "3"),
+		HaveField("AckedVersion", "3"),
+		HaveField("NackedVersion", ""),
+		HaveField("NackError", ""),
+	))
+
+	cb.OnStreamClosed(1, nil)
+	g.Expect(connectedClients.ConnectedClients()).To(BeEmpty())
+}
+
+func TestUnverifiedSelfManagedClient(t *testing.T) {
+	g := NewWithT(t)
+	mock := krttest.NewMock(t, []any{
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "podname", Namespace: "ns"}},
+	})
+	nodes := NewNodeMetadataCollection(krttest.GetMockCollection[*corev1.Node](mock))
+	pods := NewLocalityPodsCollection(nodes, krttest.GetMockCollection[*corev1.Pod](mock), krtutil.KrtOptions{})
+	pods.WaitUntilSynced(context.Background().Done())
+	selfManagedGateways := krt.NewStaticCollection([]SelfManagedGateway{
+		{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "self-managed"}},
+	})
+
+	cb, uccBuilder, _ := NewUniquelyConnectedClients()
+	ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, pods, selfManagedGateways)
+	ucc.WaitUntilSynced(context.Background().Done())
+
+	// the gateway of the role is not self-managed, so the node can't skip the pod lookup
+	request := &envoy_service_discovery_v3.DiscoveryRequest{
+		Node: &corev3.Node{
+			Id: "off-cluster",
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					xds.RoleKey:        structpb.NewStringValue(wellknown.GatewayApiProxyValue + "~ns~deployed"),
+					xds.SelfManagedKey: structpb.NewBoolValue(true),
+				},
+			},
+		},
+	}
+	g.Expect(cb.OnStreamRequest(1, proto.Clone(request).(*envoy_service_discovery_v3.DiscoveryRequest))).NotTo(Succeed())
+	g.Expect(cb.OnFetchRequest(context.Background(), proto.Clone(request).(*envoy_service_discovery_v3.DiscoveryRequest))).NotTo(Succeed())
+	g.Consistently(ucc.List, "100ms").Should(BeEmpty())
+}
@@ -0,0 +1,144 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// The metrics of the control plane. They are registered in the registry of controller-runtime, so
+// they are served from the metrics endpoint of the controller manager.
+
+const namespace = "kgateway"
+
+var (
+	translationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "translator",
+			Name:      "translation_duration_seconds",
+			Help:      "How long the translation of a gateway takes, in seconds.",
+			Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 5, 10},
+		},
+		[]string{"translator", "namespace", "name"},
+	)
+
+	collectionSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "krt",
+			Name:      "collection_size",
+			Help:      "The number of objects in a krt collection.",
+		},
+		[]string{"collection"},
+	)
+
+	statusSyncFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "status",
+			Name:      "sync_failures_total",
+			Help:      "The number of status updates that failed after all their retries.",
+		},
+		[]string{"syncer"},
+	)
+
+	xdsSnapshotInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "xds",
+			Name:      "snapshot_info",
+			Help:      "The version of the resources of the xDS snapshot of a client, as a label. The value is always 1.",
+		},
+		[]string{"client", "type", "version"},
+	)
+
+	// xdsSnapshotVersions is the version of the xdsSnapshotInfo series of each client and type, so
+	// the series of the previous version can be deleted when it changes.
+	xdsSnapshotVersionsLock sync.Mutex
+	xdsSnapshotVersions     = map[xdsSnapshotKey]string{}
+
+	xdsConnectedClients = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "xds",
+			Name:      "connected_clients",
+			Help:      "The number of envoys connected to the xDS server.",
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		translationDuration,
+		collectionSize,
+		statusSyncFailures,
+		xdsSnapshotInfo,
+		xdsConnectedClients,
+	)
+}
+
+// ObserveTranslationDuration records the time since start as the translation duration of the gateway.
+func ObserveTranslationDuration(translator string, gateway types.NamespacedName, start time.Time) {
+	translationDuration.WithLabelValues(translator, gateway.Namespace, gateway.Name).Observe(time.Since(start).Seconds())
+}
+
+// RegisterCollectionSize keeps the size of the collection up to date.
+func RegisterCollectionSize[T any](name string, col krt.Collection[T]) {
+	gauge := collectionSize.WithLabelValues(name)
+	col.RegisterBatch(func(events []krt.Event[T], initialSync bool) {
+		for _, e := range events {
+			switch e.Event {
+			case controllers.EventAdd:
+				gauge.Inc()
+			case controllers.EventDelete:
+				gauge.Dec()
+			}
+		}
+	}, true)
+}
+
+// IncStatusSyncFailures counts a status update of the syncer that failed.
+func IncStatusSyncFailures(syncer string) {
+	statusSyncFailures.WithLabelValues(syncer).Inc()
+}
+
+type xdsSnapshotKey struct {
+	client  string
+	typeUrl string
+}
+
+// SetXdsSnapshotVersion records the version of the resources of the given type served to the client,
+// replacing the series of its previous version.
+func SetXdsSnapshotVersion(client, typeUrl, version string) {
+	xdsSnapshotVersionsLock.Lock()
+	defer xdsSnapshotVersionsLock.Unlock()
+	key := xdsSnapshotKey{client: client, typeUrl: typeUrl}
+	if prev, ok := xdsSnapshotVersions[key]; ok && prev != version {
+		xdsSnapshotInfo.DeleteLabelValues(client, typeUrl, prev)
+	}
+	xdsSnapshotVersions[key] = version
+	xdsSnapshotInfo.WithLabelValues(client, typeUrl, version).Set(1)
+}
+
+// DeleteXdsSnapshotVersions removes the versions of a client that is no longer connected.
+func DeleteXdsSnapshotVersions(client string) {
+	xdsSnapshotVersionsLock.Lock()
+	defer xdsSnapshotVersionsLock.Unlock()
+	for key := range xdsSnapshotVersions {
+		if key.client == client {
+			delete(xdsSnapshotVersions, key)
+		}
+	}
+	xdsSnapshotInfo.DeletePartialMatch(prometheus.Labels{"client": client})
+}
+
+// SetXdsConnectedClients records the number of envoys connected to the xDS server.
+func SetXdsConnectedClients(count int) {
+	xdsConnectedClients.Set(float64(count))
+}
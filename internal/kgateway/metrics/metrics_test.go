@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+)
+
+func TestXdsSnapshotVersion(t *testing.T) {
+	SetXdsSnapshotVersion("client-a", "clusters", "1234")
+	SetXdsSnapshotVersion("client-a", "routes", "42")
+	SetXdsSnapshotVersion("client-b", "clusters", "7")
+
+	assert.Equal(t, float64(1), value(t, xdsSnapshotInfo.WithLabelValues("client-a", "clusters", "1234")))
+	assert.Equal(t, 3, count(xdsSnapshotInfo))
+
+	// a new version replaces the series of the previous one
+	SetXdsSnapshotVersion("client-a", "clusters", "5678")
+	assert.Equal(t, 3, count(xdsSnapshotInfo))
+	assert.False(t, xdsSnapshotInfo.DeleteLabelValues("client-a", "clusters", "1234"))
+	assert.Equal(t, float64(1), value(t, xdsSnapshotInfo.WithLabelValues("client-a", "clusters", "5678")))
+
+	DeleteXdsSnapshotVersions("client-a")
+	assert.Equal(t, 1, count(xdsSnapshotInfo))
+	assert.Equal(t, float64(1), value(t, xdsSnapshotInfo.WithLabelValues("client-b", "clusters", "7")))
+
+	// the series of a client that reconnects starts from its new version
+	SetXdsSnapshotVersion("client-a", "clusters", "9")
+	assert.Equal(t, 2, count(xdsSnapshotInfo))
+}
+
+func TestRegisterCollectionSize(t *testing.T) {
+	col := krt.NewStaticCollection([]string{"a", "b"})
+	RegisterCollectionSize("strings", col)
+	gauge := collectionSize.WithLabelValues("strings")
+	// the events of the collection are handled asynchronously
+	size := func(expected float64) {
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			out := &dto.Metric{}
+			require.NoError(c, gauge.Write(out))
+			assert.Equal(c, expected, out.GetGauge().GetValue())
+		}, time.Second, 10*time.Millisecond)
+	}
+	size(2)
+
+	col.UpdateObject("c")
+	size(3)
+
+	// updating an object does not change the size
+	col.UpdateObject("c")
+	col.DeleteObject("a")
+	size(2)
+}
+
+func TestStatusSyncFailures(t *testing.T) {
+	IncStatusSyncFailures("GatewayStatusSyncer")
+	IncStatusSyncFailures("GatewayStatusSyncer")
+
+	assert.Equal(t, float64(2), value(t, statusSyncFailures.WithLabelValues("GatewayStatusSyncer")))
+}
+
+func value(t *testing.T, m prometheus.Metric) float64 {
+	out := &dto.Metric{}
+	require.NoError(t, m.Write(out))
+	if out.GetCounter() != nil {
+		return out.GetCounter().GetValue()
+	}
+	return out.GetGauge().GetValue()
+}
+
+func count(c prometheus.Collector) int {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		c.Collect(ch)
+		close(ch)
+	}()
+	n := 0
+	for range ch {
+		n++
+	}
+	return n
+}
@@ -3,12 +3,23 @@ package proxy_syncer
 import (
 	"context"
 
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/solo-io/go-utils/contextutils"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/metrics"
 )
 
+// names of the resource types of the snapshots, for the metrics of their versions
+var snapshotResourceTypes = map[envoycachetypes.ResponseType]string{
+	envoycachetypes.Cluster:  "clusters",
+	envoycachetypes.Endpoint: "endpoints",
+	envoycachetypes.Route:    "routes",
+	envoycachetypes.Listener: "listeners",
+}
+
 func (s *ProxyTranslator) syncXds(
 	ctx context.Context,
 	snapWrap XdsSnapWrapper,
@@ -34,4 +45,7 @@ func (s *ProxyTranslator) syncXds(
 	// a default initial fetch timeout
 	// snap.MakeConsistent()
 	s.xdsCache.SetSnapshot(ctx, proxyKey, snap)
+	for typ, name := range snapshotResourceTypes {
+		metrics.SetXdsSnapshotVersion(proxyKey, name, snap.Resources[typ].Version)
+	}
 }
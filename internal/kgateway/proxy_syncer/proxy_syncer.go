@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"reflect"
+	"slices"
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
 	"istio.io/istio/pkg/kube"
@@ -21,17 +24,26 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/solo-io/go-utils/contextutils"
 	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	extensions "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
@@ -62,12 +74,18 @@ type ProxySyncer struct {
 	perclientSnapCollection krt.Collection[XdsSnapWrapper]
 
 	waitForSync []cache.InformerSynced
+
+	// policiesWithAncestors are the policies carrying an ancestor of this controller in their status,
+	// as last synced by the policy status syncer. nil until the first sync.
+	policiesWithAncestors sets.Set[reports.PolicyKey]
 }
 
 type GatewayXdsResources struct {
 	types.NamespacedName
 
 	reports reports.ReportMap
+	// selfManaged is true if the proxy of the Gateway is self-managed
+	selfManaged bool
 	// Clusters are items in the CDS response payload.
 	Clusters     []envoycachetypes.ResourceWithTTL
 	ClustersHash uint64
@@ -83,7 +101,7 @@ func (r GatewayXdsResources) ResourceName() string {
 	return xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, r.Namespace, r.Name)
 }
 func (r GatewayXdsResources) Equals(in GatewayXdsResources) bool {
-	return r.NamespacedName == in.NamespacedName && report{r.reports}.Equals(report{in.reports}) && r.ClustersHash == in.ClustersHash &&
+	return r.NamespacedName == in.NamespacedName && report{reportMap: r.reports}.Equals(report{reportMap: in.reports}) && r.selfManaged == in.selfManaged && r.ClustersHash == in.ClustersHash &&
 		r.Routes.Version == in.Routes.Version && r.Listeners.Version == in.Listeners.Version
 }
 func sliceToResourcesHash[T proto.Message](slice []T) ([]envoycachetypes.ResourceWithTTL, uint64) {
@@ -112,6 +130,7 @@ func toResources(gw ir.Gateway, xdsSnap irtranslator.TranslationResult, r report
 			Name:      gw.Obj.GetName(),
 		},
 		reports:      r,
+		selfManaged:  gw.SelfManaged,
 		ClustersHash: ch,
 		Clusters:     c,
 		Routes:       sliceToResources(xdsSnap.Routes),
@@ -158,6 +177,10 @@ func NewProxyTranslator(xdsCache envoycache.SnapshotCache) ProxyTranslator {
 type report struct {
 	// lower case so krt doesn't error in debug handler
 	reportMap reports.ReportMap
+	// roles of the proxies connected over xds
+	connectedRoles sets.Set[string]
+	// gateways whose proxy is self-managed
+	selfManagedGateways sets.Set[types.NamespacedName]
 }
 
 func (r report) ResourceName() string {
@@ -172,9 +195,33 @@ func (r report) Equals(in report) bool {
 	if !maps.Equal(r.reportMap.HTTPRoutes, in.reportMap.HTTPRoutes) {
 		return false
 	}
+	if !maps.Equal(r.reportMap.GRPCRoutes, in.reportMap.GRPCRoutes) {
+		return false
+	}
 	if !maps.Equal(r.reportMap.TCPRoutes, in.reportMap.TCPRoutes) {
 		return false
 	}
+	if !maps.Equal(r.reportMap.TLSRoutes, in.reportMap.TLSRoutes) {
+		return false
+	}
+	if !maps.Equal(r.reportMap.UDPRoutes, in.reportMap.UDPRoutes) {
+		return false
+	}
+	if !maps.Equal(r.reportMap.ListenerSets, in.reportMap.ListenerSets) {
+		return false
+	}
+	if !r.connectedRoles.Equal(in.connectedRoles) {
+		return false
+	}
+	if !r.selfManagedGateways.Equal(in.selfManagedGateways) {
+		return false
+	}
+	// policy reports are merged into new reports, so compare by value
+	if !maps.EqualFunc(r.reportMap.Policies, in.reportMap.Policies, func(a, b *reports.PolicyReport) bool {
+		return reflect.DeepEqual(a, b)
+	}) {
+		return false
+	}
 	return true
 }
 
@@ -183,12 +230,13 @@ func (s *ProxySyncer) Init(ctx context.Context, isOurGw func(gw *gwv1.Gateway) b
 	ctx = contextutils.WithLogger(ctx, "k8s-gw-proxy-syncer")
 	logger := contextutils.LoggerFrom(ctx)
 
-	kubeGateways, routes, upstreamIndex, endpointIRs := krtcollections.InitCollections(
+	kubeGateways, routes, upstreamIndex, endpointIRs, policies := krtcollections.InitCollections(
 		ctx,
 		s.extensions,
 		s.istioClient,
 		isOurGw,
 		s.commonCols.RefGrants,
+		s.commonCols.Settings.EnableExperimentalListenerSets,
 		krtopts,
 	)
 
@@ -199,10 +247,14 @@ func (s *ProxySyncer) Init(ctx context.Context, isOurGw func(gw *gwv1.Gateway) b
 
 	s.translatorSyncer.Init(ctx, routes)
 
+	unresolvedPolicyTargets := krtcollections.NewUnresolvedPolicyTargets(krtopts, policies, kubeGateways, routes)
+
 	s.mostXdsSnapshots = krt.NewCollection(kubeGateways.Gateways, func(kctx krt.HandlerContext, gw ir.Gateway) *GatewayXdsResources {
 		logger.Debugf("building proxy for kube gw %s version %s", client.ObjectKeyFromObject(gw.Obj), gw.Obj.GetResourceVersion())
 
+		start := time.Now()
 		xdsSnap, rm := s.translatorSyncer.TranslateGateway(kctx, ctx, gw)
+		metrics.ObserveTranslationDuration("ProxySyncer", client.ObjectKeyFromObject(gw.Obj), start)
 		if xdsSnap == nil {
 			return nil
 		}
@@ -233,6 +285,13 @@ func (s *ProxySyncer) Init(ctx context.Context, isOurGw func(gw *gwv1.Gateway) b
 		clustersPerClient,
 	)
 
+	metrics.RegisterCollectionSize("FinalUpstreams", finalUpstreams)
+	metrics.RegisterCollectionSize("MostXdsSnapshots", s.mostXdsSnapshots)
+	metrics.RegisterCollectionSize("PerClientEnvoyEndpoints", epPerClient.endpoints)
+	metrics.RegisterCollectionSize("PerClientEnvoyClusters", clustersPerClient.clusters)
+	metrics.RegisterCollectionSize("PerClientXdsSnapshots", s.perclientSnapCollection)
+	metrics.RegisterCollectionSize("UniqueConnectedClients", s.uniqueClients)
+
 	// as proxies are created, they also contain a reportMap containing status for the Gateway and associated xRoutes (really parentRefs)
 	// here we will merge reports that are per-Proxy to a singleton Report used to persist to k8s on a timer
 	s.statusReport = krt.NewSingleton(func(kctx krt.HandlerContext) *report {
@@ -241,6 +300,8 @@ func (s *ProxySyncer) Init(ctx context.Context, isOurGw func(gw *gwv1.Gateway) b
 		for _, p := range proxies {
 			// 1. merge GW Reports for all Proxies' status reports
 			maps.Copy(merged.Gateways, p.reports.Gateways)
+			// the listener statuses of listener sets are in the reports of their parent GW
+			maps.Copy(merged.ListenerSets, p.reports.ListenerSets)
 
 			// 2. merge httproute parentRefs into RouteReports
 			for rnn, rr := range p.reports.HTTPRoutes {
@@ -255,7 +316,23 @@ func (s *ProxySyncer) Init(ctx context.Context, isOurGw func(gw *gwv1.Gateway) b
 				maps.Copy(p.reports.HTTPRoutes[rnn].Parents, rr.Parents)
 			}
 
-			// 3. merge tcproute parentRefs into RouteReports
+			// 3. merge grpcroute parentRefs into RouteReports
+			for rnn, rr := range p.reports.GRPCRoutes {
+				// if we haven't encountered this route, just copy it over completely
+				old := merged.GRPCRoutes[rnn]
+				if old == nil {
+					// copy the parents so merging the ones of other proxies leaves this report as-is
+					rr := *rr
+					rr.Parents = maps.Clone(rr.Parents)
+					merged.GRPCRoutes[rnn] = &rr
+					continue
+				}
+				// else, let's merge our parentRefs into the existing map
+				// obsGen will stay as-is...
+				maps.Copy(old.Parents, rr.Parents)
+			}
+
+			// 4. merge tcproute parentRefs into RouteReports
 			for rnn, rr := range p.reports.TCPRoutes {
 				// if we haven't encountered this route, just copy it over completely
 				old := merged.TCPRoutes[rnn]
@@ -267,8 +344,82 @@ func (s *ProxySyncer) Init(ctx context.Context, isOurGw func(gw *gwv1.Gateway) b
 				// obsGen will stay as-is...
 				maps.Copy(p.reports.TCPRoutes[rnn].Parents, rr.Parents)
 			}
+
+			// 5. merge tlsroute parentRefs into RouteReports
+			for rnn, rr := range p.reports.TLSRoutes {
+				// if we haven't encountered this route, just copy it over completely
+				old := merged.TLSRoutes[rnn]
+				if old == nil {
+					// copy the parents so merging the ones of other proxies leaves this report as-is
+					rr := *rr
+					rr.Parents = maps.Clone(rr.Parents)
+					merged.TLSRoutes[rnn] = &rr
+					continue
+				}
+				// else, let's merge our parentRefs into the existing map
+				// obsGen will stay as-is...
+				maps.Copy(old.Parents, rr.Parents)
+			}
+
+			// 6. merge udproute parentRefs into RouteReports
+			for rnn, rr := range p.reports.UDPRoutes {
+				// if we haven't encountered this route, just copy it over completely
+				old := merged.UDPRoutes[rnn]
+				if old == nil {
+					// copy the parents so merging the ones of other proxies leaves this report as-is
+					rr := *rr
+					rr.Parents = maps.Clone(rr.Parents)
+					merged.UDPRoutes[rnn] = &rr
+					continue
+				}
+				// else, let's merge our parentRefs into the existing map
+				// obsGen will stay as-is...
+				maps.Copy(old.Parents, rr.Parents)
+			}
+
+			// 7. merge policy ancestors into PolicyReports
+			merged.MergePolicyReports(p.reports)
+		}
+
+		// policies with target refs that don't resolve are never encountered during translation,
+		// so report them here using the missing target as the ancestor
+		reporter := reports.NewReporter(&merged)
+		for _, u := range krt.Fetch(kctx, unresolvedPolicyTargets) {
+			key := reports.PolicyKey{
+				Group: u.Group,
+				Kind:  u.Kind,
+				NamespacedName: types.NamespacedName{
+					Namespace: u.Namespace,
+					Name:      u.Name,
+				},
+			}
+			for _, tr := range u.TargetRefs {
+				ancestorRef := gwv1.ParentReference{
+					Group:     ptr.To(gwv1.Group(tr.Group)),
+					Kind:      ptr.To(gwv1.Kind(tr.Kind)),
+					Namespace: ptr.To(gwv1.Namespace(u.Namespace)),
+					Name:      gwv1.ObjectName(tr.Name),
+				}
+				reporter.Policy(key, u.Generation).AncestorRef(&ancestorRef).SetCondition(reports.PolicyCondition{
+					Type:    gwv1a2.PolicyConditionAccepted,
+					Status:  metav1.ConditionFalse,
+					Reason:  gwv1a2.PolicyReasonTargetNotFound,
+					Message: fmt.Sprintf("%s %s/%s not found", tr.Kind, u.Namespace, tr.Name),
+				})
+			}
+		}
+
+		connectedRoles := sets.New[string]()
+		for _, c := range krt.Fetch(kctx, s.uniqueClients) {
+			connectedRoles.Insert(c.Role)
+		}
+		selfManagedGateways := sets.New[types.NamespacedName]()
+		for _, p := range proxies {
+			if p.selfManaged {
+				selfManagedGateways.Insert(p.NamespacedName)
+			}
 		}
-		return &report{merged}
+		return &report{reportMap: merged, connectedRoles: connectedRoles, selfManagedGateways: selfManagedGateways}
 	})
 
 	s.waitForSync = []cache.InformerSynced{
@@ -277,6 +428,7 @@ func (s *ProxySyncer) Init(ctx context.Context, isOurGw func(gw *gwv1.Gateway) b
 		upstreamIndex.HasSynced,
 		finalUpstreams.HasSynced,
 		kubeGateways.Gateways.HasSynced,
+		unresolvedPolicyTargets.HasSynced,
 		s.perclientSnapCollection.HasSynced,
 		s.mostXdsSnapshots.HasSynced,
 		s.extensions.HasSynced,
@@ -291,7 +443,7 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 	logger.Infof("starting %s Proxy Syncer", s.controllerName)
 	// latestReport will be constantly updated to contain the merged status report for Kube Gateway status
 	// when timer ticks, we will use the state of the mergedReports at that point in time to sync the status to k8s
-	latestReportQueue := ggv2utils.NewAsyncQueue[reports.ReportMap]()
+	latestReportQueue := ggv2utils.NewAsyncQueue[report]()
 	logger.Infof("waiting for cache to sync")
 
 	// wait for krt collections to sync
@@ -314,7 +466,7 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 			// TODO: handle garbage collection (see: https://github.com/solo-io/solo-projects/issues/7086)
 			return
 		}
-		latestReportQueue.Enqueue(o.Latest().reportMap)
+		latestReportQueue.Enqueue(o.Latest())
 	})
 
 	go func() {
@@ -349,12 +501,32 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 		}
 	}()
 
+	upstreamQueue := ggv2utils.NewAsyncQueue[ir.Upstream]()
+	if upstreams := s.extensions.ContributesUpstreams[v1alpha1.UpstreamGVK.GroupKind()].Upstreams; upstreams != nil {
+		upstreams.Register(func(o krt.Event[ir.Upstream]) {
+			if o.Event == controllers.EventDelete {
+				return
+			}
+			upstreamQueue.Enqueue(o.Latest())
+		})
+	}
+	go func() {
+		for {
+			up, err := upstreamQueue.Dequeue(ctx)
+			if err != nil {
+				return
+			}
+			s.syncUpstreamStatus(ctx, up)
+		}
+	}()
+
 	s.perclientSnapCollection.RegisterBatch(func(o []krt.Event[XdsSnapWrapper], initialSync bool) {
 		for _, e := range o {
 			if e.Event != controllers.EventDelete {
 				snapWrap := e.Latest()
 				s.proxyTranslator.syncXds(ctx, snapWrap)
 			} else {
+				metrics.DeleteXdsSnapshotVersions(e.Latest().proxyKey)
 				// key := e.Latest().proxyKey
 				// if _, err := s.proxyTranslator.xdsCache.GetSnapshot(key); err == nil {
 				// 	s.proxyTranslator.xdsCache.ClearSnapshot(e.Latest().proxyKey)
@@ -369,8 +541,10 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 			if err != nil {
 				return
 			}
-			s.syncGatewayStatus(ctx, latestReport)
-			s.syncRouteStatus(ctx, latestReport)
+			s.syncGatewayStatus(ctx, latestReport.reportMap, latestReport.connectedRoles, latestReport.selfManagedGateways)
+			s.syncListenerSetStatus(ctx, latestReport.reportMap)
+			s.syncRouteStatus(ctx, latestReport.reportMap)
+			s.syncPolicyStatus(ctx, latestReport.reportMap)
 		}
 	}()
 	<-ctx.Done()
@@ -417,12 +591,30 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, rm reports.ReportMap)
 				return nil
 			}
 			r.Status.RouteStatus = *status
+		case *gwv1.GRPCRoute:
+			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
+				return nil
+			}
+			r.Status.RouteStatus = *status
 		case *gwv1a2.TCPRoute:
 			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
 			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
 				return nil
 			}
 			r.Status.RouteStatus = *status
+		case *gwv1a2.TLSRoute:
+			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
+				return nil
+			}
+			r.Status.RouteStatus = *status
+		case *gwv1a2.UDPRoute:
+			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
+				return nil
+			}
+			r.Status.RouteStatus = *status
 		default:
 			logger.Warnw(fmt.Sprintf("unsupported route type for %s", routeType), "route", route)
 			return nil
@@ -439,6 +631,18 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, rm reports.ReportMap)
 		})
 		if err != nil {
 			logger.Errorw("all attempts failed at updating HTTPRoute status", "error", err, "route", rnn)
+			metrics.IncStatusSyncFailures("RouteStatusSyncer")
+		}
+	}
+
+	// Sync GRPCRoute statuses
+	for rnn := range rm.GRPCRoutes {
+		err := syncStatusWithRetry(wellknown.GRPCRouteKind, rnn, func() client.Object { return new(gwv1.GRPCRoute) }, func(route client.Object) error {
+			return buildAndUpdateStatus(route, wellknown.GRPCRouteKind)
+		})
+		if err != nil {
+			logger.Errorw("all attempts failed at updating GRPCRoute status", "error", err, "route", rnn)
+			metrics.IncStatusSyncFailures("RouteStatusSyncer")
 		}
 	}
 
@@ -449,12 +653,250 @@ func (s *ProxySyncer) syncRouteStatus(ctx context.Context, rm reports.ReportMap)
 		})
 		if err != nil {
 			logger.Errorw("all attempts failed at updating TCPRoute status", "error", err, "route", rnn)
+			metrics.IncStatusSyncFailures("RouteStatusSyncer")
+		}
+	}
+
+	// Sync TLSRoute statuses
+	for rnn := range rm.TLSRoutes {
+		err := syncStatusWithRetry(wellknown.TLSRouteKind, rnn, func() client.Object { return new(gwv1a2.TLSRoute) }, func(route client.Object) error {
+			return buildAndUpdateStatus(route, wellknown.TLSRouteKind)
+		})
+		if err != nil {
+			logger.Errorw("all attempts failed at updating TLSRoute status", "error", err, "route", rnn)
+			metrics.IncStatusSyncFailures("RouteStatusSyncer")
+		}
+	}
+
+	// Sync UDPRoute statuses
+	for rnn := range rm.UDPRoutes {
+		err := syncStatusWithRetry(wellknown.UDPRouteKind, rnn, func() client.Object { return new(gwv1a2.UDPRoute) }, func(route client.Object) error {
+			return buildAndUpdateStatus(route, wellknown.UDPRouteKind)
+		})
+		if err != nil {
+			logger.Errorw("all attempts failed at updating UDPRoute status", "error", err, "route", rnn)
+			metrics.IncStatusSyncFailures("RouteStatusSyncer")
 		}
 	}
 }
 
+// syncPolicyStatus will build and update the ancestor status for all policies in a reportMap
+func (s *ProxySyncer) syncPolicyStatus(ctx context.Context, rm reports.ReportMap) {
+	ctx = contextutils.WithLogger(ctx, "policyStatusSyncer")
+	logger := contextutils.LoggerFrom(ctx)
+	stopwatch := utils.NewTranslatorStopWatch("PolicyStatusSyncer")
+	stopwatch.Start()
+	defer stopwatch.Stop(ctx)
+
+	cli := s.mgr.GetClient()
+	withAncestors := sets.New[reports.PolicyKey]()
+	for key := range s.policyKeys(rm) {
+		var hasAncestor bool
+		err := retry.Do(func() error {
+			var err error
+			hasAncestor, err = patchPolicyStatus(ctx, cli, key, rm, s.controllerName)
+			if err != nil {
+				logger.Debugw("policy status patch attempt failed", "error", err, "policy", key.String())
+			}
+			return err
+		},
+			retry.Attempts(5),
+			retry.Delay(100*time.Millisecond),
+			retry.DelayType(retry.BackOffDelay),
+		)
+		if err != nil {
+			logger.Errorw("all attempts failed at updating policy status", "error", err, "policy", key.String())
+			metrics.IncStatusSyncFailures("PolicyStatusSyncer")
+			// sync it again next time
+			hasAncestor = true
+		}
+		if hasAncestor {
+			withAncestors.Insert(key)
+		}
+	}
+	s.policiesWithAncestors = withAncestors
+}
+
+// patchPolicyStatus sets the ancestors reported for the policy in its status, if they changed, and
+// returns whether the policy carries an ancestor of the controller.
+func patchPolicyStatus(ctx context.Context, cli client.Client, key reports.PolicyKey, rm reports.ReportMap, controllerName string) (bool, error) {
+	obj := newPolicyObject(schema.GroupKind{Group: key.Group, Kind: key.Kind})
+	if obj == nil {
+		contextutils.LoggerFrom(ctx).Debugw("unsupported policy kind for status", "policy", key.String())
+		return false, nil
+	}
+	if err := cli.Get(ctx, key.NamespacedName, obj.Object); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	status := obj.getStatus()
+	newStatus := rm.BuildPolicyStatus(ctx, key, controllerName, status)
+	if newStatus == nil || isPolicyStatusEqual(&status, newStatus) {
+		return hasControllerAncestor(status, controllerName), nil
+	}
+	original := obj.Object.DeepCopyObject().(client.Object)
+	obj.setStatus(*newStatus)
+	// lock on the resource version, as the patch replaces the ancestors set by other controllers
+	patch := client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})
+	if err := cli.Status().Patch(ctx, obj.Object, patch); err != nil {
+		return false, err
+	}
+	return hasControllerAncestor(*newStatus, controllerName), nil
+}
+
+// syncUpstreamStatus sets the Accepted condition of an Upstream, reporting the errors processing it
+func (s *ProxySyncer) syncUpstreamStatus(ctx context.Context, up ir.Upstream) {
+	ctx = contextutils.WithLogger(ctx, "upstreamStatusSyncer")
+	logger := contextutils.LoggerFrom(ctx)
+
+	cond := metav1.Condition{
+		Type:    string(gwv1a2.PolicyConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(gwv1a2.PolicyReasonAccepted),
+		Message: "Upstream accepted",
+	}
+	if len(up.Errors) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = string(gwv1a2.PolicyReasonInvalid)
+		cond.Message = errors.Join(up.Errors...).Error()
+	}
+
+	key := types.NamespacedName{Namespace: up.Namespace, Name: up.Name}
+	err := retry.Do(func() error {
+		obj := &v1alpha1.Upstream{}
+		if err := s.mgr.GetClient().Get(ctx, key, obj); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		cond.ObservedGeneration = obj.Generation
+		if !meta.SetStatusCondition(&obj.Status.Conditions, cond) {
+			return nil
+		}
+		return s.mgr.GetClient().Status().Update(ctx, obj)
+	},
+		retry.Attempts(5),
+		retry.Delay(100*time.Millisecond),
+		retry.DelayType(retry.BackOffDelay),
+	)
+	if err != nil {
+		logger.Errorw("all attempts failed at updating upstream status", "error", err, "upstream", key.String())
+		metrics.IncStatusSyncFailures("UpstreamStatusSyncer")
+	}
+}
+
+// policyKeys returns the keys of the policies to sync the status of: the ones reported during
+// translation, and the ones carrying an ancestor of this controller, so that it is cleared once a
+// policy is no longer attached to any of our Gateways. The ancestors written before the controller
+// started aren't known, so the first sync goes through all the policies supporting status.
+func (s *ProxySyncer) policyKeys(rm reports.ReportMap) sets.Set[reports.PolicyKey] {
+	keys := sets.KeySet(rm.Policies)
+	if s.policiesWithAncestors != nil {
+		return keys.Union(s.policiesWithAncestors)
+	}
+	for gk, plugin := range s.extensions.ContributesPolicies {
+		if plugin.Policies == nil || newPolicyObject(gk) == nil {
+			continue
+		}
+		for _, pol := range plugin.Policies.List() {
+			keys.Insert(reports.PolicyKey{
+				Group:          gk.Group,
+				Kind:           gk.Kind,
+				NamespacedName: types.NamespacedName{Namespace: pol.Namespace, Name: pol.Name},
+			})
+		}
+	}
+	return keys
+}
+
+// hasControllerAncestor returns whether the status has an ancestor set by the given controller.
+func hasControllerAncestor(status v1alpha1.PolicyStatus, controllerName string) bool {
+	return slices.ContainsFunc(status.Ancestors, func(ancestor v1alpha1.PolicyAncestorStatus) bool {
+		return ancestor.ControllerName == controllerName
+	})
+}
+
+// policyObject is a policy along with accessors converting its status to and from the PolicyStatus
+// built from the reports.
+type policyObject struct {
+	client.Object
+	getStatus func() v1alpha1.PolicyStatus
+	setStatus func(v1alpha1.PolicyStatus)
+}
+
+func newKgatewayPolicyObject(obj client.Object, status *v1alpha1.PolicyStatus) *policyObject {
+	return &policyObject{
+		Object:    obj,
+		getStatus: func() v1alpha1.PolicyStatus { return *status },
+		setStatus: func(in v1alpha1.PolicyStatus) { *status = in },
+	}
+}
+
+// newPolicyObject returns an empty object for the policy kind, or nil if the kind doesn't support
+// status.
+func newPolicyObject(gk schema.GroupKind) *policyObject {
+	switch gk {
+	case v1alpha1.RoutePolicyGVK.GroupKind():
+		obj := &v1alpha1.RoutePolicy{}
+		return newKgatewayPolicyObject(obj, &obj.Status)
+	case v1alpha1.ListenerPolicyGVK.GroupKind():
+		obj := &v1alpha1.ListenerPolicy{}
+		return newKgatewayPolicyObject(obj, &obj.Status)
+	case v1alpha1.HTTPListenerPolicyGVK.GroupKind():
+		obj := &v1alpha1.HTTPListenerPolicy{}
+		return newKgatewayPolicyObject(obj, &obj.Status)
+	case v1alpha1.RateLimitPolicyGVK.GroupKind():
+		obj := &v1alpha1.RateLimitPolicy{}
+		return newKgatewayPolicyObject(obj, &obj.Status)
+	case v1alpha1.ExtAuthPolicyGVK.GroupKind():
+		obj := &v1alpha1.ExtAuthPolicy{}
+		return newKgatewayPolicyObject(obj, &obj.Status)
+	case v1alpha1.JWTPolicyGVK.GroupKind():
+		obj := &v1alpha1.JWTPolicy{}
+		return newKgatewayPolicyObject(obj, &obj.Status)
+	case v1alpha1.CORSPolicyGVK.GroupKind():
+		obj := &v1alpha1.CORSPolicy{}
+		return newKgatewayPolicyObject(obj, &obj.Status)
+	case v1alpha1.UpstreamPolicyGVK.GroupKind():
+		obj := &v1alpha1.UpstreamPolicy{}
+		return newKgatewayPolicyObject(obj, &obj.Status)
+	case wellknown.BackendTLSPolicyGVK.GroupKind():
+		obj := &gwv1a3.BackendTLSPolicy{}
+		return &policyObject{
+			Object:    obj,
+			getStatus: func() v1alpha1.PolicyStatus { return fromGatewayPolicyStatus(obj.Status) },
+			setStatus: func(in v1alpha1.PolicyStatus) { obj.Status = toGatewayPolicyStatus(in) },
+		}
+	default:
+		return nil
+	}
+}
+
+// fromGatewayPolicyStatus converts the status of a Gateway API policy, which has no conditions of
+// its own.
+func fromGatewayPolicyStatus(in gwv1a2.PolicyStatus) v1alpha1.PolicyStatus {
+	out := v1alpha1.PolicyStatus{}
+	for _, ancestor := range in.Ancestors {
+		out.Ancestors = append(out.Ancestors, v1alpha1.PolicyAncestorStatus{
+			AncestorRef:    ancestor.AncestorRef,
+			ControllerName: string(ancestor.ControllerName),
+			Conditions:     ancestor.Conditions,
+		})
+	}
+	return out
+}
+
+func toGatewayPolicyStatus(in v1alpha1.PolicyStatus) gwv1a2.PolicyStatus {
+	out := gwv1a2.PolicyStatus{}
+	for _, ancestor := range in.Ancestors {
+		out.Ancestors = append(out.Ancestors, gwv1a2.PolicyAncestorStatus{
+			AncestorRef:    ancestor.AncestorRef,
+			ControllerName: gwv1.GatewayController(ancestor.ControllerName),
+			Conditions:     ancestor.Conditions,
+		})
+	}
+	return out
+}
+
 // syncGatewayStatus will build and update status for all Gateways in a reportMap
-func (s *ProxySyncer) syncGatewayStatus(ctx context.Context, rm reports.ReportMap) {
+func (s *ProxySyncer) syncGatewayStatus(ctx context.Context, rm reports.ReportMap, connectedRoles sets.Set[string], selfManagedGateways sets.Set[types.NamespacedName]) {
 	ctx = contextutils.WithLogger(ctx, "statusSyncer")
 	logger := contextutils.LoggerFrom(ctx)
 	stopwatch := utils.NewTranslatorStopWatch("GatewayStatusSyncer")
@@ -472,6 +914,12 @@ func (s *ProxySyncer) syncGatewayStatus(ctx context.Context, rm reports.ReportMa
 			gwStatusWithoutAddress := gw.Status
 			gwStatusWithoutAddress.Addresses = nil
 			if status := rm.BuildGWStatus(ctx, gw); status != nil {
+				// only self-managed proxies report their connection, as the pods of deployed
+				// proxies are already tracked by the Programmed condition
+				if selfManagedGateways.Has(gwnn) {
+					role := xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, gw.Namespace, gw.Name)
+					reports.SetProxyConnectedCondition(gw, status, connectedRoles.Has(role))
+				}
 				if !isGatewayStatusEqual(&gwStatusWithoutAddress, status) {
 					gw.Status = *status
 					if err := s.mgr.GetClient().Status().Patch(ctx, &gw, client.Merge); err != nil {
@@ -492,11 +940,59 @@ func (s *ProxySyncer) syncGatewayStatus(ctx context.Context, rm reports.ReportMa
 	)
 	if err != nil {
 		logger.Errorw("all attempts failed at updating gateway statuses", "error", err)
+		metrics.IncStatusSyncFailures("GatewayStatusSyncer")
 	}
 	duration := stopwatch.Stop(ctx)
 	logger.Debugf("synced gw status for %d gateways in %s", len(rm.Gateways), duration.String())
 }
 
+// syncListenerSetStatus will build and update status for all XListenerSets in a reportMap. The
+// XListenerSet is not in the scheme of the manager, so it is read and updated as unstructured.
+func (s *ProxySyncer) syncListenerSetStatus(ctx context.Context, rm reports.ReportMap) {
+	ctx = contextutils.WithLogger(ctx, "listenerSetStatusSyncer")
+	logger := contextutils.LoggerFrom(ctx)
+	stopwatch := utils.NewTranslatorStopWatch("ListenerSetStatusSyncer")
+	stopwatch.Start()
+	defer stopwatch.Stop(ctx)
+
+	for lsnn := range rm.ListenerSets {
+		err := retry.Do(func() error {
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(wellknown.XListenerSetGVK)
+			if err := s.mgr.GetClient().Get(ctx, lsnn, obj); err != nil {
+				logger.Errorw("listener set get failed", "error", err, "listenerset", lsnn.String())
+				return err
+			}
+			ls := apixv1alpha1.XListenerSet{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &ls); err != nil {
+				return err
+			}
+			status := rm.BuildListenerSetStatus(ctx, ls)
+			if status == nil || isListenerSetStatusEqual(&ls.Status, status) {
+				return nil
+			}
+			newStatus, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+			if err != nil {
+				return err
+			}
+			obj.Object["status"] = newStatus
+			if err := s.mgr.GetClient().Status().Update(ctx, obj); err != nil {
+				logger.Debugw("listener set status update attempt failed", "error", err, "listenerset", lsnn.String())
+				return err
+			}
+			return nil
+		},
+			retry.Attempts(5),
+			retry.Delay(100*time.Millisecond),
+			retry.DelayType(retry.BackOffDelay),
+		)
+		if err != nil {
+			logger.Errorw("all attempts failed at updating listener set status", "error", err, "listenerset", lsnn.String())
+			metrics.IncStatusSyncFailures("ListenerSetStatusSyncer")
+		}
+	}
+}
+
 //func applyPostTranslationPlugins(ctx context.Context, pluginRegistry registry.PluginRegistry, translationContext *gwplugins.PostTranslationContext) {
 //	ctx = contextutils.WithLogger(ctx, "postTranslation")
 //	logger := contextutils.LoggerFrom(ctx)
@@ -526,6 +1022,16 @@ func isRouteStatusEqual(objA, objB *gwv1.RouteStatus) bool {
 	return cmp.Equal(objA, objB, opts)
 }
 
+// isPolicyStatusEqual compares two PolicyStatus objects directly
+func isPolicyStatusEqual(objA, objB *v1alpha1.PolicyStatus) bool {
+	return cmp.Equal(objA, objB, opts)
+}
+
+// isListenerSetStatusEqual compares two ListenerSetStatus objects directly
+func isListenerSetStatusEqual(objA, objB *apixv1alpha1.ListenerSetStatus) bool {
+	return cmp.Equal(objA, objB, opts)
+}
+
 type resourcesStringer envoycache.Resources
 
 func (r resourcesStringer) String() string {
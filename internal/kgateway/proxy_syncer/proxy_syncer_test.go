@@ -1,12 +1,19 @@
 package proxy_syncer
 
 import (
+	"context"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
 )
 
 func TestIsGatewayStatusEqual(t *testing.T) {
@@ -140,3 +147,67 @@ func TestIsRouteStatusEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchPolicyStatus(t *testing.T) {
+	ctx := context.Background()
+	key := reports.PolicyKey{
+		Group:          v1alpha1.GroupName,
+		Kind:           v1alpha1.RoutePolicyGVK.Kind,
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "policy"},
+	}
+	theirs := v1alpha1.PolicyAncestorStatus{
+		AncestorRef:    gwv1.ParentReference{Name: "their-gw"},
+		ControllerName: "other-controller",
+	}
+	policy := &v1alpha1.RoutePolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Status:     v1alpha1.PolicyStatus{Ancestors: []v1alpha1.PolicyAncestorStatus{theirs}},
+	}
+	cli := fake.NewClientBuilder().
+		WithScheme(schemes.GatewayScheme()).
+		WithObjects(policy).
+		WithStatusSubresource(policy).
+		Build()
+	get := func() *v1alpha1.RoutePolicy {
+		out := &v1alpha1.RoutePolicy{}
+		if err := cli.Get(ctx, key.NamespacedName, out); err != nil {
+			t.Fatalf("failed to get the policy: %v", err)
+		}
+		return out
+	}
+
+	rm := reports.NewReportMap()
+	reports.NewReporter(&rm).Policy(key, 1).AncestorRef(&gwv1.ParentReference{Name: "gw"})
+	hasAncestor, err := patchPolicyStatus(ctx, cli, key, rm, wellknown.GatewayControllerName)
+	if err != nil || !hasAncestor {
+		t.Fatalf("patchPolicyStatus() = %v, %v, want true, nil", hasAncestor, err)
+	}
+	patched := get()
+	if len(patched.Status.Ancestors) != 2 || patched.Status.Ancestors[0].ControllerName != wellknown.GatewayControllerName {
+		t.Fatalf("expected our ancestor along with theirs, got %v", patched.Status.Ancestors)
+	}
+
+	// the status is only written when it changes
+	if _, err := patchPolicyStatus(ctx, cli, key, rm, wellknown.GatewayControllerName); err != nil {
+		t.Fatalf("patchPolicyStatus() error = %v", err)
+	}
+	if rv := get().ResourceVersion; rv != patched.ResourceVersion {
+		t.Errorf("expected the unchanged status not to be written, resource version %s != %s", rv, patched.ResourceVersion)
+	}
+
+	// once the policy isn't reported anymore, only our ancestor is cleared
+	hasAncestor, err = patchPolicyStatus(ctx, cli, key, reports.NewReportMap(), wellknown.GatewayControllerName)
+	if err != nil || hasAncestor {
+		t.Fatalf("patchPolicyStatus() = %v, %v, want false, nil", hasAncestor, err)
+	}
+	if ancestors := get().Status.Ancestors; len(ancestors) != 1 || ancestors[0].ControllerName != theirs.ControllerName {
+		t.Errorf("expected only their ancestor, got %v", ancestors)
+	}
+
+	// deleted policies are skipped
+	missing := key
+	missing.Name = "missing"
+	if hasAncestor, err := patchPolicyStatus(ctx, cli, missing, rm, wellknown.GatewayControllerName); err != nil || hasAncestor {
+		t.Errorf("patchPolicyStatus() = %v, %v, want false, nil", hasAncestor, err)
+	}
+}
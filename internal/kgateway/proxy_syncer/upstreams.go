@@ -28,7 +28,7 @@ func (c uccWithCluster) ResourceName() string {
 }
 
 func (c uccWithCluster) Equals(in uccWithCluster) bool {
-	return c.Client.Equals(in.Client) && c.ClusterVersion == in.ClusterVersion
+	return c.Client.Equals(in.Client) && c.ClusterVersion == in.ClusterVersion && (c.Error == nil) == (in.Error == nil)
 }
 
 type PerClientEnvoyClusters struct {
@@ -14,7 +14,9 @@ import (
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
@@ -65,15 +67,21 @@ func (r *RouteInfo) Hostnames() []string {
 		return r.HostnameOverrides
 	}
 
-	httpRoute, ok := r.Object.(*ir.HttpRouteIR)
-	if !ok {
-		return []string{}
+	if hostnames := routeHostnames(r.Object); hostnames != nil {
+		return hostnames
 	}
+	return []string{}
+}
 
-	if httpRoute.Hostnames != nil {
-		return httpRoute.Hostnames
+// routeHostnames returns the hostnames specified in the route, for route kinds that have them.
+func routeHostnames(route ir.Route) []string {
+	switch typedRoute := route.(type) {
+	case *ir.HttpRouteIR:
+		return typedRoute.Hostnames
+	case *ir.TlsRouteIR:
+		return typedRoute.Hostnames
 	}
-	return []string{}
+	return nil
 }
 
 // GetChildrenForRef fetches child routes for a given BackendObjectReference.
@@ -117,6 +125,10 @@ func (r *gatewayQueries) GetRouteChain(
 		children = r.getDelegatedChildren(kctx, ctx, parentRef, typedRoute, nil)
 	case *ir.TcpRouteIR:
 		// TODO (danehans): Should TCPRoute delegation support be added in the future?
+	case *ir.TlsRouteIR:
+		// TLSRoutes do not support delegation
+	case *ir.UdpRouteIR:
+		// UDPRoutes do not support delegation
 	default:
 		return nil
 	}
@@ -130,7 +142,9 @@ func (r *gatewayQueries) GetRouteChain(
 	}
 }
 
-func (r *gatewayQueries) allowedRoutes(gw *gwv1.Gateway, l *gwv1.Listener) (func(krt.HandlerContext, string) bool, []metav1.GroupKind, error) {
+// allowedRoutes returns the namespaces and kinds of the routes allowed by the listener, which is
+// defined by a Gateway or XListenerSet in the given namespace.
+func (r *gatewayQueries) allowedRoutes(namespace string, l *gwv1.Listener) (func(krt.HandlerContext, string) bool, []metav1.GroupKind, error) {
 	var allowedKinds []metav1.GroupKind
 
 	// Determine the allowed route kinds based on the listener's protocol
@@ -138,19 +152,22 @@ func (r *gatewayQueries) allowedRoutes(gw *gwv1.Gateway, l *gwv1.Listener) (func
 	case gwv1.HTTPSProtocolType:
 		fallthrough
 	case gwv1.HTTPProtocolType:
-		allowedKinds = []metav1.GroupKind{{Kind: wellknown.HTTPRouteKind, Group: gwv1.GroupName}}
+		allowedKinds = []metav1.GroupKind{
+			{Kind: wellknown.HTTPRouteKind, Group: gwv1.GroupName},
+			{Kind: wellknown.GRPCRouteKind, Group: gwv1.GroupName},
+		}
 	case gwv1.TLSProtocolType:
-		fallthrough
+		allowedKinds = []metav1.GroupKind{{Kind: wellknown.TLSRouteKind, Group: gwv1a2.GroupName}}
 	case gwv1.TCPProtocolType:
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.TCPRouteKind, Group: gwv1a2.GroupName}}
 	case gwv1.UDPProtocolType:
-		allowedKinds = []metav1.GroupKind{{}}
+		allowedKinds = []metav1.GroupKind{{Kind: wellknown.UDPRouteKind, Group: gwv1a2.GroupName}}
 	default:
 		// allow custom protocols to work
 		allowedKinds = []metav1.GroupKind{{Kind: wellknown.HTTPRouteKind, Group: gwv1.GroupName}}
 	}
 
-	allowedNs := SameNamespace(gw.Namespace)
+	allowedNs := SameNamespace(namespace)
 	if ar := l.AllowedRoutes; ar != nil {
 		// Override the allowed route kinds if specified in AllowedRoutes
 		if ar.Kinds != nil {
@@ -288,11 +305,17 @@ func (r *gatewayQueries) GetRoutesForGateway(kctx krt.HandlerContext, ctx contex
 		Name:      gw.Name,
 	}
 
+	listeners := make([]parentListener, 0, len(gw.Spec.Listeners))
+	for _, l := range gw.Spec.Listeners {
+		listeners = append(listeners, parentListener{resultName: string(l.Name), listener: l})
+	}
+
 	// Process each route
 	ret := NewRoutesForGwResult()
 	routes := r.routes.RoutesForGateway(kctx, nns)
 	for _, route := range routes {
-		if err := r.processRoute(kctx, ctx, gw, route, ret); err != nil {
+		refs := getParentRefsForGw(gw, route)
+		if err := r.processRoute(kctx, ctx, gw.Namespace, listeners, refs, route, ret); err != nil {
 			return nil, err
 		}
 	}
@@ -300,14 +323,59 @@ func (r *gatewayQueries) GetRoutesForGateway(kctx krt.HandlerContext, ctx contex
 	return ret, nil
 }
 
+func (r *gatewayQueries) GetRoutesForListenerSet(kctx krt.HandlerContext, ctx context.Context, ls *apixv1alpha1.XListenerSet) (*RoutesForGwResult, error) {
+	nns := types.NamespacedName{
+		Namespace: ls.Namespace,
+		Name:      ls.Name,
+	}
+
+	// the results are keyed by the names of the listeners which are unique across the parent Gateway,
+	// while the parentRefs of the routes reference the names of the listeners in the listener set.
+	listeners := make([]parentListener, 0, len(ls.Spec.Listeners))
+	for _, l := range ls.Spec.Listeners {
+		listeners = append(listeners, parentListener{
+			resultName: reports.ListenerSetListenerName(ls, l.Name),
+			listener: gwv1.Listener{
+				Name:          l.Name,
+				Hostname:      l.Hostname,
+				Port:          l.Port,
+				Protocol:      l.Protocol,
+				TLS:           l.TLS,
+				AllowedRoutes: l.AllowedRoutes,
+			},
+		})
+	}
+
+	ret := NewRoutesForGwResult()
+	routes := r.routes.RoutesForGateway(kctx, nns)
+	for _, route := range routes {
+		refs := getParentRefsForListenerSet(ls, route)
+		if err := r.processRoute(kctx, ctx, ls.Namespace, listeners, refs, route, ret); err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
+
+// parentListener is a listener of a Gateway or XListenerSet which routes attach to.
+type parentListener struct {
+	// resultName is the key of the listener in the ListenerResults.
+	resultName string
+	listener   gwv1.Listener
+}
+
+// processRoute attaches the route to the listeners matched by its parentRefs. The listeners are
+// defined by a Gateway or XListenerSet in the given namespace.
 func (r *gatewayQueries) processRoute(
 	kctx krt.HandlerContext,
 	ctx context.Context,
-	gw *gwv1.Gateway,
+	namespace string,
+	listeners []parentListener,
+	refs []gwv1.ParentReference,
 	route ir.Route,
 	ret *RoutesForGwResult,
 ) error {
-	refs := getParentRefsForGw(gw, route)
 	routeKind := route.GetGroupKind().Kind
 
 	for _, ref := range refs {
@@ -315,14 +383,15 @@ func (r *gatewayQueries) processRoute(
 		anyListenerMatched := false
 		anyHostsMatch := false
 
-		for _, l := range gw.Spec.Listeners {
-			lr := ret.ListenerResults[string(l.Name)]
+		for _, pl := range listeners {
+			l := pl.listener
+			lr := ret.ListenerResults[pl.resultName]
 			if lr == nil {
 				lr = &ListenerResult{}
-				ret.ListenerResults[string(l.Name)] = lr
+				ret.ListenerResults[pl.resultName] = lr
 			}
 
-			allowedNs, allowedKinds, err := r.allowedRoutes(gw, &l)
+			allowedNs, allowedKinds, err := r.allowedRoutes(namespace, &l)
 			if err != nil {
 				lr.Error = err
 				continue
@@ -345,17 +414,15 @@ func (r *gatewayQueries) processRoute(
 			}
 			anyListenerMatched = true
 
-			// If the route is an HTTPRoute, check the hostname intersection
+			// If the route is an HTTPRoute, GRPCRoute or TLSRoute, check the hostname intersection
 			var hostnames []string
-			if routeKind == wellknown.HTTPRouteKind {
-				if hr, ok := route.(*ir.HttpRouteIR); ok {
-					var ok bool
-					ok, hostnames = hostnameIntersect(&l, hr)
-					if !ok {
-						continue
-					}
-					anyHostsMatch = true
+			if hasHostnames(routeKind) {
+				var ok bool
+				ok, hostnames = hostnameIntersect(&l, routeHostnames(route))
+				if !ok {
+					continue
 				}
+				anyHostsMatch = true
 			}
 
 			// If all checks pass, add the route to the listener result
@@ -375,7 +442,7 @@ func (r *gatewayQueries) processRoute(
 				ParentRef: ref,
 				Error:     Error{E: ErrNoMatchingParent, Reason: gwv1.RouteReasonNoMatchingParent},
 			})
-		} else if routeKind == wellknown.HTTPRouteKind && !anyHostsMatch {
+		} else if hasHostnames(routeKind) && !anyHostsMatch {
 			ret.RouteErrors = append(ret.RouteErrors, &RouteError{
 				Route:     route,
 				ParentRef: ref,
@@ -387,6 +454,11 @@ func (r *gatewayQueries) processRoute(
 	return nil
 }
 
+// hasHostnames returns true if routes of the given kind must match the hostname of a listener.
+func hasHostnames(routeKind string) bool {
+	return routeKind == wellknown.HTTPRouteKind || routeKind == wellknown.GRPCRouteKind || routeKind == wellknown.TLSRouteKind
+}
+
 // isKindAllowed is a helper function to check if a kind is allowed.
 func isKindAllowed(routeKind string, allowedKinds []metav1.GroupKind) bool {
 	for _, kind := range allowedKinds {
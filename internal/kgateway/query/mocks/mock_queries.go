@@ -13,6 +13,7 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	v1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	ir "github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	query "github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
 )
@@ -69,6 +70,21 @@ func (mr *MockGatewayQueriesMockRecorder) GetRoutesForGateway(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutesForGateway", reflect.TypeOf((*MockGatewayQueries)(nil).GetRoutesForGateway), arg0, arg1, arg2)
 }
 
+// GetRoutesForListenerSet mocks base method.
+func (m *MockGatewayQueries) GetRoutesForListenerSet(arg0 krt.HandlerContext, arg1 context.Context, arg2 *v1alpha1.XListenerSet) (*query.RoutesForGwResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoutesForListenerSet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*query.RoutesForGwResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoutesForListenerSet indicates an expected call of GetRoutesForListenerSet.
+func (mr *MockGatewayQueriesMockRecorder) GetRoutesForListenerSet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutesForListenerSet", reflect.TypeOf((*MockGatewayQueries)(nil).GetRoutesForListenerSet), arg0, arg1, arg2)
+}
+
 // GetSecretForRef mocks base method.
 func (m *MockGatewayQueries) GetSecretForRef(arg0 krt.HandlerContext, arg1 context.Context, arg2 schema.GroupKind, arg3 string, arg4 v1.SecretObjectReference) (*ir.Secret, error) {
 	m.ctrl.T.Helper()
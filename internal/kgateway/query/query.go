@@ -15,6 +15,7 @@ import (
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	apiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
@@ -102,6 +103,9 @@ type GatewayQueries interface {
 
 	// GetRoutesForGateway finds the top level xRoutes attached to the provided Gateway
 	GetRoutesForGateway(kctx krt.HandlerContext, ctx context.Context, gw *gwv1.Gateway) (*RoutesForGwResult, error)
+	// GetRoutesForListenerSet finds the top level xRoutes attached to the provided XListenerSet. The
+	// results are keyed by the names of the listeners given by reports.ListenerSetListenerName.
+	GetRoutesForListenerSet(kctx krt.HandlerContext, ctx context.Context, ls *apixv1alpha1.XListenerSet) (*RoutesForGwResult, error)
 	// GetRouteChain resolves backends and delegated routes for a the provided xRoute object
 	GetRouteChain(kctx krt.HandlerContext,
 		ctx context.Context,
@@ -191,6 +195,40 @@ func getParentRefsForGw(gw *apiv1.Gateway, obj ir.Route) []apiv1.ParentReference
 	return ret
 }
 
+// getParentRefsForListenerSet extracts the ParentReferences from the provided route for the provided XListenerSet.
+func getParentRefsForListenerSet(ls *apixv1alpha1.XListenerSet, obj ir.Route) []apiv1.ParentReference {
+	var ret []apiv1.ParentReference
+
+	for _, pRef := range obj.GetParentRefs() {
+		if isParentRefForListenerSet(&pRef, ls, obj.GetNamespace()) {
+			ret = append(ret, pRef)
+		}
+	}
+
+	return ret
+}
+
+// isParentRefForListenerSet checks if a ParentReference is associated with the provided XListenerSet.
+func isParentRefForListenerSet(pRef *apiv1.ParentReference, ls *apixv1alpha1.XListenerSet, defaultNs string) bool {
+	if ls == nil || pRef == nil {
+		return false
+	}
+
+	if pRef.Group == nil || *pRef.Group != apixv1alpha1.GroupName {
+		return false
+	}
+	if pRef.Kind == nil || *pRef.Kind != wellknown.XListenerSetKind {
+		return false
+	}
+
+	ns := defaultNs
+	if pRef.Namespace != nil {
+		ns = string(*pRef.Namespace)
+	}
+
+	return ns == ls.Namespace && string(pRef.Name) == ls.Name
+}
+
 // isParentRefForGw checks if a ParentReference is associated with the provided Gateway.
 func isParentRefForGw(pRef *apiv1.ParentReference, gw *apiv1.Gateway, defaultNs string) bool {
 	if gw == nil || pRef == nil {
@@ -212,37 +250,33 @@ func isParentRefForGw(pRef *apiv1.ParentReference, gw *apiv1.Gateway, defaultNs
 	return ns == gw.Namespace && string(pRef.Name) == gw.Name
 }
 
-func hostnameIntersect(l *apiv1.Listener, hr *ir.HttpRouteIR) (bool, []string) {
+func hostnameIntersect(l *apiv1.Listener, routeHostnames []string) (bool, []string) {
 	var hostnames []string
-	if l == nil || hr == nil {
+	if l == nil {
 		return false, hostnames
 	}
 	if l.Hostname == nil {
-		for _, h := range hr.Hostnames {
-			hostnames = append(hostnames, string(h))
-		}
+		hostnames = append(hostnames, routeHostnames...)
 		return true, hostnames
 	}
 	var listenerHostname string = string(*l.Hostname)
 
 	if strings.HasPrefix(listenerHostname, "*.") {
-		if hr.Hostnames == nil {
+		if routeHostnames == nil {
 			return true, []string{listenerHostname}
 		}
 
-		for _, hostname := range hr.Hostnames {
-			hrHost := string(hostname)
+		for _, hrHost := range routeHostnames {
 			if strings.HasSuffix(hrHost, listenerHostname[1:]) {
 				hostnames = append(hostnames, hrHost)
 			}
 		}
 		return len(hostnames) > 0, hostnames
 	} else {
-		if len(hr.Hostnames) == 0 {
+		if len(routeHostnames) == 0 {
 			return true, []string{listenerHostname}
 		}
-		for _, hostname := range hr.Hostnames {
-			hrHost := string(hostname)
+		for _, hrHost := range routeHostnames {
 			if hrHost == listenerHostname {
 				return true, []string{listenerHostname}
 			}
@@ -21,6 +21,7 @@ import (
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	apiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
@@ -376,6 +377,48 @@ var _ = Describe("Query", func() {
 			Expect(routes.RouteErrors[0].ParentRef).To(Equal(hr.Spec.ParentRefs[0]))
 		})
 
+		It("should get http routes for the listeners of a listener set", func() {
+			ls := &apixv1alpha1.XListenerSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "apps",
+				},
+				Spec: apixv1alpha1.ListenerSetSpec{
+					ParentRef: apixv1alpha1.ParentGatewayReference{Name: "test"},
+					Listeners: []apixv1alpha1.ListenerEntry{
+						{Name: "foo", Protocol: apiv1.HTTPProtocolType, Port: 80},
+						{Name: "bar", Protocol: apiv1.HTTPProtocolType, Port: 8080},
+					},
+				},
+			}
+			hr := httpRoute()
+			hr.Spec.ParentRefs = []apiv1.ParentReference{
+				{
+					Group:       ptr.To(apiv1.Group(apixv1alpha1.GroupName)),
+					Kind:        ptr.To(apiv1.Kind(wellknown.XListenerSetKind)),
+					Name:        apiv1.ObjectName(ls.Name),
+					SectionName: ptr.To(apiv1.SectionName("bar")),
+				},
+			}
+
+			gq := newQueries(hr)
+			routes, err := gq.GetRoutesForListenerSet(krt.TestingDummyContext{}, context.Background(), ls)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes.RouteErrors).To(BeEmpty())
+			Expect(routes.ListenerResults["default/apps/bar"].Routes).To(HaveLen(1))
+			Expect(routes.ListenerResults["default/apps/foo"].Routes).To(BeEmpty())
+
+			// the parentRef doesn't reference a gateway with the same name
+			gwWithListener := gw()
+			gwWithListener.Name = ls.Name
+			gwWithListener.Spec.Listeners = []apiv1.Listener{{Name: "bar", Protocol: apiv1.HTTPProtocolType, Port: 8080}}
+			routes, err = gq.GetRoutesForGateway(krt.TestingDummyContext{}, context.Background(), gwWithListener)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes.ListenerResults).To(BeEmpty())
+		})
+
 		Context("test host intersection", func() {
 			expectHostnamesToMatch := func(lh string, rh []string, expectedHostnames ...string) {
 				gwWithListener := gw()
@@ -700,8 +743,11 @@ func newQueries(initObjs ...client.Object) query.GatewayQueries {
 	upstreams.AddUpstreams(SvcGk, k8sUpstreams(services))
 
 	httproutes := krttest.GetMockCollection[*gwv1.HTTPRoute](mock)
+	grpcroutes := krttest.GetMockCollection[*gwv1.GRPCRoute](mock)
 	tcpproutes := krttest.GetMockCollection[*gwv1a2.TCPRoute](mock)
-	rtidx := krtcollections.NewRoutesIndex(krtutil.KrtOptions{}, httproutes, tcpproutes, policies, upstreams, refgrants)
+	tlsroutes := krttest.GetMockCollection[*gwv1a2.TLSRoute](mock)
+	udproutes := krttest.GetMockCollection[*gwv1a2.UDPRoute](mock)
+	rtidx := krtcollections.NewRoutesIndex(krtutil.KrtOptions{}, httproutes, grpcroutes, tcpproutes, tlsroutes, udproutes, policies, upstreams, refgrants)
 	services.WaitUntilSynced(nil)
 
 	secretsCol := map[schema.GroupKind]krt.Collection[ir.Secret]{
@@ -1,7 +1,10 @@
 package reports
 
 import (
+	"cmp"
 	"context"
+	"maps"
+	"slices"
 
 	"github.com/solo-io/go-utils/contextutils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -9,12 +12,20 @@ import (
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 )
 
 type ReportMap struct {
 	Gateways   map[types.NamespacedName]*GatewayReport
 	HTTPRoutes map[types.NamespacedName]*RouteReport
+	GRPCRoutes map[types.NamespacedName]*RouteReport
 	TCPRoutes  map[types.NamespacedName]*RouteReport
+	TLSRoutes  map[types.NamespacedName]*RouteReport
+	UDPRoutes  map[types.NamespacedName]*RouteReport
+	Policies   map[PolicyKey]*PolicyReport
+
+	ListenerSets map[types.NamespacedName]*ListenerSetReport
 }
 
 type GatewayReport struct {
@@ -23,6 +34,14 @@ type GatewayReport struct {
 	observedGeneration int64
 }
 
+// ListenerSetReport is the report of an XListenerSet. The reports of its listeners are kept in the
+// report of the parent Gateway, see ListenerSetListenerName.
+type ListenerSetReport struct {
+	gateway            types.NamespacedName
+	conditions         []metav1.Condition
+	observedGeneration int64
+}
+
 type ListenerReport struct {
 	Status gwv1.ListenerStatus
 }
@@ -43,14 +62,44 @@ type ParentRefKey struct {
 	types.NamespacedName
 }
 
+type PolicyKey struct {
+	Group string
+	Kind  string
+	types.NamespacedName
+}
+
+func (k PolicyKey) String() string {
+	return k.Group + "/" + k.Kind + "/" + k.NamespacedName.String()
+}
+
+type PolicyReport struct {
+	Ancestors          map[ParentRefKey]*AncestorRefReport
+	observedGeneration int64
+}
+
+type AncestorRefReport struct {
+	Conditions []metav1.Condition
+}
+
 func NewReportMap() ReportMap {
 	gr := make(map[types.NamespacedName]*GatewayReport)
 	hr := make(map[types.NamespacedName]*RouteReport)
+	grr := make(map[types.NamespacedName]*RouteReport)
 	tr := make(map[types.NamespacedName]*RouteReport)
+	tlsr := make(map[types.NamespacedName]*RouteReport)
+	ur := make(map[types.NamespacedName]*RouteReport)
+	pr := make(map[PolicyKey]*PolicyReport)
+	lsr := make(map[types.NamespacedName]*ListenerSetReport)
 	return ReportMap{
 		Gateways:   gr,
 		HTTPRoutes: hr,
+		GRPCRoutes: grr,
 		TCPRoutes:  tr,
+		TLSRoutes:  tlsr,
+		UDPRoutes:  ur,
+		Policies:   pr,
+
+		ListenerSets: lsr,
 	}
 }
 
@@ -76,20 +125,54 @@ func (r *ReportMap) newGatewayReport(gateway *gwv1.Gateway) *GatewayReport {
 	return gr
 }
 
+// ListenerSetListenerName returns the name of a listener of an XListenerSet, which is unique among
+// the listeners of the parent Gateway. The listener is translated and reported under this name.
+func ListenerSetListenerName(ls metav1.Object, name gwv1.SectionName) string {
+	return ls.GetNamespace() + "/" + ls.GetName() + "/" + string(name)
+}
+
+// ListenerSet returns the ListenerSetReport for the provided XListenerSet, nil if there is not a
+// report present.
+func (r *ReportMap) ListenerSet(ls *apixv1alpha1.XListenerSet) *ListenerSetReport {
+	return r.ListenerSets[key(ls)]
+}
+
+func (r *ReportMap) newListenerSetReport(ls *apixv1alpha1.XListenerSet) *ListenerSetReport {
+	gwNs := ls.Namespace
+	if ls.Spec.ParentRef.Namespace != nil {
+		gwNs = string(*ls.Spec.ParentRef.Namespace)
+	}
+	lsr := &ListenerSetReport{
+		gateway:            types.NamespacedName{Namespace: gwNs, Name: string(ls.Spec.ParentRef.Name)},
+		observedGeneration: ls.Generation,
+	}
+	r.ListenerSets[key(ls)] = lsr
+	return lsr
+}
+
 // route returns a RouteReport for the provided route object, nil if a report is not present.
 // This is different than the Reporter.Route() method, as we need to understand when
 // reports are not generated for a route that has been translated. Supported object types are:
 //
 // * HTTPRoute
+// * GRPCRoute
 // * TCPRoute
+// * TLSRoute
+// * UDPRoute
 func (r *ReportMap) route(obj metav1.Object) *RouteReport {
 	key := key(obj)
 
 	switch obj.(type) {
 	case *gwv1.HTTPRoute:
 		return r.HTTPRoutes[key]
+	case *gwv1.GRPCRoute:
+		return r.GRPCRoutes[key]
 	case *gwv1alpha2.TCPRoute:
 		return r.TCPRoutes[key]
+	case *gwv1alpha2.TLSRoute:
+		return r.TLSRoutes[key]
+	case *gwv1alpha2.UDPRoute:
+		return r.UDPRoutes[key]
 	default:
 		contextutils.LoggerFrom(context.TODO()).Warnf("Unsupported route type: %T", obj)
 		return nil
@@ -106,8 +189,14 @@ func (r *ReportMap) newRouteReport(obj metav1.Object) *RouteReport {
 	switch obj.(type) {
 	case *gwv1.HTTPRoute:
 		r.HTTPRoutes[key] = rr
+	case *gwv1.GRPCRoute:
+		r.GRPCRoutes[key] = rr
 	case *gwv1alpha2.TCPRoute:
 		r.TCPRoutes[key] = rr
+	case *gwv1alpha2.TLSRoute:
+		r.TLSRoutes[key] = rr
+	case *gwv1alpha2.UDPRoute:
+		r.UDPRoutes[key] = rr
 	default:
 		contextutils.LoggerFrom(context.TODO()).Warnf("Unsupported route type: %T", obj)
 		return nil
@@ -116,6 +205,40 @@ func (r *ReportMap) newRouteReport(obj metav1.Object) *RouteReport {
 	return rr
 }
 
+// policy returns a PolicyReport for the provided policy key, nil if a report is not present.
+func (r *ReportMap) policy(key PolicyKey) *PolicyReport {
+	return r.Policies[key]
+}
+
+func (r *ReportMap) newPolicyReport(key PolicyKey, generation int64) *PolicyReport {
+	pr := &PolicyReport{
+		observedGeneration: generation,
+	}
+	r.Policies[key] = pr
+	return pr
+}
+
+// MergePolicyReports merges the policy reports from other into the ReportMap. Each Gateway is
+// translated separately, so a policy attached to multiple Gateways has one report per translation,
+// each with a different ancestor. The ancestor reports are copied, so other can be modified
+// afterwards without affecting the ReportMap.
+func (r *ReportMap) MergePolicyReports(other ReportMap) {
+	for key, pr := range other.Policies {
+		merged := r.policy(key)
+		if merged == nil {
+			merged = r.newPolicyReport(key, pr.observedGeneration)
+		}
+		for ancestorKey, ar := range pr.Ancestors {
+			if merged.Ancestors == nil {
+				merged.Ancestors = make(map[ParentRefKey]*AncestorRefReport)
+			}
+			merged.Ancestors[ancestorKey] = &AncestorRefReport{
+				Conditions: slices.Clone(ar.Conditions),
+			}
+		}
+	}
+}
+
 func (g *GatewayReport) Listener(listener *gwv1.Listener) ListenerReporter {
 	return g.listener(string(listener.Name))
 }
@@ -157,6 +280,23 @@ func (g *GatewayReport) SetCondition(gc GatewayCondition) {
 	g.conditions = append(g.conditions, condition)
 }
 
+func (l *ListenerSetReport) GetConditions() []metav1.Condition {
+	if l == nil {
+		return []metav1.Condition{}
+	}
+	return l.conditions
+}
+
+func (l *ListenerSetReport) SetCondition(lc ListenerSetCondition) {
+	condition := metav1.Condition{
+		Type:    string(lc.Type),
+		Status:  lc.Status,
+		Reason:  string(lc.Reason),
+		Message: lc.Message,
+	}
+	l.conditions = append(l.conditions, condition)
+}
+
 func NewListenerReport(name string) *ListenerReport {
 	lr := ListenerReport{}
 	lr.Status.Name = gwv1.SectionName(name)
@@ -193,6 +333,14 @@ func (r *reporter) Gateway(gateway *gwv1.Gateway) GatewayReporter {
 	return gr
 }
 
+func (r *reporter) ListenerSet(ls *apixv1alpha1.XListenerSet) ListenerSetReporter {
+	lsr := r.report.ListenerSet(ls)
+	if lsr == nil {
+		lsr = r.report.newListenerSetReport(ls)
+	}
+	return lsr
+}
+
 func (r *reporter) Route(obj metav1.Object) RouteReporter {
 	rr := r.report.route(obj)
 	if rr == nil {
@@ -201,6 +349,14 @@ func (r *reporter) Route(obj metav1.Object) RouteReporter {
 	return rr
 }
 
+func (r *reporter) Policy(key PolicyKey, generation int64) PolicyReporter {
+	pr := r.report.policy(key)
+	if pr == nil {
+		pr = r.report.newPolicyReport(key, generation)
+	}
+	return pr
+}
+
 // TODO: flesh out
 func getParentRefKey(parentRef *gwv1.ParentReference) ParentRefKey {
 	var group string
@@ -245,21 +401,24 @@ func (r *RouteReport) parentRef(parentRef *gwv1.ParentReference) *ParentRefRepor
 func (r *RouteReport) parentRefs() []gwv1.ParentReference {
 	var refs []gwv1.ParentReference
 	for key := range r.Parents {
-		var ns *gwv1.Namespace
-		if key.Namespace != "" {
-			ns = ptr.To(gwv1.Namespace(key.Namespace))
-		}
-		parentRef := gwv1.ParentReference{
-			Group:     ptr.To(gwv1.Group(key.Group)),
-			Kind:      ptr.To(gwv1.Kind(key.Kind)),
-			Name:      gwv1.ObjectName(key.Name),
-			Namespace: ns,
-		}
-		refs = append(refs, parentRef)
+		refs = append(refs, parentRefFromKey(key))
 	}
 	return refs
 }
 
+func parentRefFromKey(key ParentRefKey) gwv1.ParentReference {
+	var ns *gwv1.Namespace
+	if key.Namespace != "" {
+		ns = ptr.To(gwv1.Namespace(key.Namespace))
+	}
+	return gwv1.ParentReference{
+		Group:     ptr.To(gwv1.Group(key.Group)),
+		Kind:      ptr.To(gwv1.Kind(key.Kind)),
+		Name:      gwv1.ObjectName(key.Name),
+		Namespace: ns,
+	}
+}
+
 func (r *RouteReport) ParentRef(parentRef *gwv1.ParentReference) ParentRefReporter {
 	return r.parentRef(parentRef)
 }
@@ -274,13 +433,65 @@ func (prr *ParentRefReport) SetCondition(rc RouteCondition) {
 	prr.Conditions = append(prr.Conditions, condition)
 }
 
+func (r *PolicyReport) ancestorRef(parentRef *gwv1.ParentReference) *AncestorRefReport {
+	key := getParentRefKey(parentRef)
+	if r.Ancestors == nil {
+		r.Ancestors = make(map[ParentRefKey]*AncestorRefReport)
+	}
+	arr, ok := r.Ancestors[key]
+	if !ok {
+		arr = &AncestorRefReport{}
+		r.Ancestors[key] = arr
+	}
+	return arr
+}
+
+// ancestorRefs returns the list of ParentReferences the policy has been reported against, sorted
+// so that the resulting status is stable across syncs.
+func (r *PolicyReport) ancestorRefs() []gwv1.ParentReference {
+	keys := slices.SortedFunc(maps.Keys(r.Ancestors), func(a, b ParentRefKey) int {
+		return cmp.Or(
+			cmp.Compare(a.Group, b.Group),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	refs := make([]gwv1.ParentReference, 0, len(keys))
+	for _, key := range keys {
+		refs = append(refs, parentRefFromKey(key))
+	}
+	return refs
+}
+
+func (r *PolicyReport) AncestorRef(parentRef *gwv1.ParentReference) AncestorRefReporter {
+	return r.ancestorRef(parentRef)
+}
+
+// SetCondition records the condition for the ancestor. The same policy is usually encountered
+// once per route rule it applies to, so identical conditions are only recorded once.
+func (arr *AncestorRefReport) SetCondition(pc PolicyCondition) {
+	condition := metav1.Condition{
+		Type:    string(pc.Type),
+		Status:  pc.Status,
+		Reason:  string(pc.Reason),
+		Message: pc.Message,
+	}
+	if slices.Contains(arr.Conditions, condition) {
+		return
+	}
+	arr.Conditions = append(arr.Conditions, condition)
+}
+
 func NewReporter(reportMap *ReportMap) Reporter {
 	return &reporter{report: reportMap}
 }
 
 type Reporter interface {
 	Gateway(gateway *gwv1.Gateway) GatewayReporter
+	ListenerSet(ls *apixv1alpha1.XListenerSet) ListenerSetReporter
 	Route(obj metav1.Object) RouteReporter
+	Policy(key PolicyKey, generation int64) PolicyReporter
 }
 
 type GatewayReporter interface {
@@ -289,6 +500,10 @@ type GatewayReporter interface {
 	SetCondition(condition GatewayCondition)
 }
 
+type ListenerSetReporter interface {
+	SetCondition(condition ListenerSetCondition)
+}
+
 type ListenerReporter interface {
 	SetCondition(ListenerCondition)
 	SetSupportedKinds([]gwv1.RouteGroupKind)
@@ -303,6 +518,14 @@ type ParentRefReporter interface {
 	SetCondition(condition RouteCondition)
 }
 
+type PolicyReporter interface {
+	AncestorRef(parentRef *gwv1.ParentReference) AncestorRefReporter
+}
+
+type AncestorRefReporter interface {
+	SetCondition(condition PolicyCondition)
+}
+
 type GatewayCondition struct {
 	Type    gwv1.GatewayConditionType
 	Status  metav1.ConditionStatus
@@ -317,9 +540,23 @@ type ListenerCondition struct {
 	Message string
 }
 
+type ListenerSetCondition struct {
+	Type    apixv1alpha1.ListenerSetConditionType
+	Status  metav1.ConditionStatus
+	Reason  apixv1alpha1.ListenerSetConditionReason
+	Message string
+}
+
 type RouteCondition struct {
 	Type    gwv1.RouteConditionType
 	Status  metav1.ConditionStatus
 	Reason  gwv1.RouteConditionReason
 	Message string
 }
+
+type PolicyCondition struct {
+	Type    gwv1alpha2.PolicyConditionType
+	Status  metav1.ConditionStatus
+	Reason  gwv1alpha2.PolicyConditionReason
+	Message string
+}
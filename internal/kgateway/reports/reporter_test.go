@@ -3,16 +3,20 @@ package reports_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 )
 
@@ -108,6 +112,53 @@ var _ = Describe("Reporting Infrastructure", func() {
 			Expect(newTransitionTime).To(Equal(oldTransitionTime))
 		})
 
+		It("should set the programmed condition back once the addresses are assigned", func() {
+			gw := gw()
+			gw.Status.Conditions = []metav1.Condition{{
+				Type:   string(gwv1.GatewayConditionProgrammed),
+				Status: metav1.ConditionFalse,
+				Reason: string(gwv1.GatewayReasonAddressNotAssigned),
+			}}
+			rm := reports.NewReportMap()
+			reporter := reports.NewReporter(&rm)
+			reporter.Gateway(gw)
+
+			status := rm.BuildGWStatus(context.Background(), *gw)
+
+			Expect(status).NotTo(BeNil())
+			Expect(status.Conditions).To(HaveLen(2))
+			programmed := meta.FindStatusCondition(status.Conditions, string(gwv1.GatewayConditionProgrammed))
+			Expect(programmed.Status).To(Equal(metav1.ConditionTrue))
+			Expect(programmed.Reason).To(Equal(string(gwv1.GatewayReasonProgrammed)))
+		})
+
+		It("should set the proxy connected condition and keep its LastTransitionTime", func() {
+			gw := gw()
+			rm := reports.NewReportMap()
+			reporter := reports.NewReporter(&rm)
+			reporter.Gateway(gw)
+
+			status := rm.BuildGWStatus(context.Background(), *gw)
+			reports.SetProxyConnectedCondition(*gw, status, false)
+			Expect(status.Conditions).To(HaveLen(3))
+			connected := meta.FindStatusCondition(status.Conditions, string(reports.GatewayConditionProxyConnected))
+			Expect(connected.Status).To(Equal(metav1.ConditionFalse))
+			Expect(connected.Reason).To(Equal(string(reports.GatewayReasonProxyNotConnected)))
+			oldTransitionTime := metav1.NewTime(connected.LastTransitionTime.Add(-time.Hour))
+			connected.LastTransitionTime = oldTransitionTime
+
+			gw.Status = *status
+			status = rm.BuildGWStatus(context.Background(), *gw)
+			reports.SetProxyConnectedCondition(*gw, status, false)
+			connected = meta.FindStatusCondition(status.Conditions, string(reports.GatewayConditionProxyConnected))
+			Expect(connected.LastTransitionTime).To(Equal(oldTransitionTime))
+
+			reports.SetProxyConnectedCondition(*gw, status, true)
+			connected = meta.FindStatusCondition(status.Conditions, string(reports.GatewayConditionProxyConnected))
+			Expect(connected.Status).To(Equal(metav1.ConditionTrue))
+			Expect(connected.Reason).To(Equal(string(reports.GatewayReasonProxyConnected)))
+		})
+
 		// TODO(Law): add multiple gws/listener tests
 		// TODO(Law): add test confirming transitionTime change when status change
 	})
@@ -128,7 +179,10 @@ var _ = Describe("Reporting Infrastructure", func() {
 				Expect(status.Parents[0].Conditions).To(HaveLen(2))
 			},
 			Entry("regular httproute", httpRoute()),
+			Entry("regular grpcroute", grpcRoute()),
 			Entry("regular tcproute", tcpRoute()),
+			Entry("regular tlsroute", tlsRoute()),
+			Entry("regular udproute", udpRoute()),
 			Entry("delegatee route", delegateeRoute()),
 		)
 
@@ -152,7 +206,10 @@ var _ = Describe("Reporting Infrastructure", func() {
 				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
 			},
 			Entry("regular httproute", httpRoute(), parentRef()),
+			Entry("regular grpcroute", grpcRoute(), parentRef()),
 			Entry("regular tcproute", tcpRoute(), parentRef()),
+			Entry("regular tlsroute", tlsRoute(), parentRef()),
+			Entry("regular udproute", udpRoute(), parentRef()),
 			Entry("delegatee route", delegateeRoute(), parentRouteRef()),
 		)
 
@@ -181,7 +238,10 @@ var _ = Describe("Reporting Infrastructure", func() {
 				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
 			},
 			Entry("regular httproute", httpRoute(), parentRef()),
+			Entry("regular grpcroute", grpcRoute(), parentRef()),
 			Entry("regular tcproute", tcpRoute(), parentRef()),
+			Entry("regular tlsroute", tlsRoute(), parentRef()),
+			Entry("regular udproute", udpRoute(), parentRef()),
 			Entry("delegatee route", delegateeRoute(), parentRouteRef()),
 		)
 
@@ -206,8 +266,14 @@ var _ = Describe("Reporting Infrastructure", func() {
 				switch route := obj.(type) {
 				case *gwv1.HTTPRoute:
 					route.Status.RouteStatus = *status
+				case *gwv1.GRPCRoute:
+					route.Status.RouteStatus = *status
 				case *gwv1a2.TCPRoute:
 					route.Status.RouteStatus = *status
+				case *gwv1a2.TLSRoute:
+					route.Status.RouteStatus = *status
+				case *gwv1a2.UDPRoute:
+					route.Status.RouteStatus = *status
 				default:
 					Fail(fmt.Sprintf("unsupported route type: %T", obj))
 				}
@@ -224,7 +290,10 @@ var _ = Describe("Reporting Infrastructure", func() {
 			},
 			Entry("regular httproute", httpRoute()),
 			Entry("delegatee route", delegateeRoute()),
+			Entry("regular grpcroute", grpcRoute()),
 			Entry("regular tcproute", tcpRoute()),
+			Entry("regular tlsroute", tlsRoute()),
+			Entry("regular udproute", udpRoute()),
 		)
 
 		DescribeTable("should correctly handle multiple ParentRefs on a route",
@@ -235,10 +304,22 @@ var _ = Describe("Reporting Infrastructure", func() {
 					route.Spec.ParentRefs = append(route.Spec.ParentRefs, gwv1.ParentReference{
 						Name: "additional-gateway",
 					})
+				case *gwv1.GRPCRoute:
+					route.Spec.ParentRefs = append(route.Spec.ParentRefs, gwv1.ParentReference{
+						Name: "additional-gateway",
+					})
 				case *gwv1a2.TCPRoute:
 					route.Spec.ParentRefs = append(route.Spec.ParentRefs, gwv1.ParentReference{
 						Name: "additional-gateway",
 					})
+				case *gwv1a2.TLSRoute:
+					route.Spec.ParentRefs = append(route.Spec.ParentRefs, gwv1.ParentReference{
+						Name: "additional-gateway",
+					})
+				case *gwv1a2.UDPRoute:
+					route.Spec.ParentRefs = append(route.Spec.ParentRefs, gwv1.ParentReference{
+						Name: "additional-gateway",
+					})
 				default:
 					Fail(fmt.Sprintf("unsupported route type: %T", obj))
 				}
@@ -260,7 +341,10 @@ var _ = Describe("Reporting Infrastructure", func() {
 				}
 			},
 			Entry("regular HTTPRoute", httpRoute()),
+			Entry("regular GRPCRoute", grpcRoute()),
 			Entry("regular TCPRoute", tcpRoute()),
+			Entry("regular TLSRoute", tlsRoute()),
+			Entry("regular UDPRoute", udpRoute()),
 		)
 
 		DescribeTable("should correctly associate multiple routes with shared and separate listeners",
@@ -272,16 +356,28 @@ var _ = Describe("Reporting Infrastructure", func() {
 				switch r1 := route1.(type) {
 				case *gwv1.HTTPRoute:
 					r1.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener1.Name))
+				case *gwv1.GRPCRoute:
+					r1.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener1.Name))
 				case *gwv1a2.TCPRoute:
 					r1.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener1.Name))
+				case *gwv1a2.TLSRoute:
+					r1.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener1.Name))
+				case *gwv1a2.UDPRoute:
+					r1.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener1.Name))
 				}
 
 				// Assign the second listener to the second route's parent ref
 				switch r2 := route2.(type) {
 				case *gwv1.HTTPRoute:
 					r2.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener2.Name))
+				case *gwv1.GRPCRoute:
+					r2.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener2.Name))
 				case *gwv1a2.TCPRoute:
 					r2.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener2.Name))
+				case *gwv1a2.TLSRoute:
+					r2.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener2.Name))
+				case *gwv1a2.UDPRoute:
+					r2.Spec.ParentRefs[0].SectionName = ptr.To(gwv1.SectionName(listener2.Name))
 				}
 
 				rm := reports.NewReportMap()
@@ -305,22 +401,210 @@ var _ = Describe("Reporting Infrastructure", func() {
 				gwv1.Listener{Name: "foo-http", Protocol: gwv1.HTTPProtocolType},
 				gwv1.Listener{Name: "bar-http", Protocol: gwv1.HTTPProtocolType},
 			),
+			Entry("GRPCRoutes with shared and separate listeners",
+				grpcRoute(), grpcRoute(),
+				gwv1.Listener{Name: "foo-grpc", Protocol: gwv1.HTTPProtocolType},
+				gwv1.Listener{Name: "bar-grpc", Protocol: gwv1.HTTPProtocolType},
+			),
 			Entry("TCPRoutes with shared and separate listeners",
 				tcpRoute(), tcpRoute(),
 				gwv1.Listener{Name: "foo-tcp", Protocol: gwv1.TCPProtocolType},
 				gwv1.Listener{Name: "bar-tcp", Protocol: gwv1.TCPProtocolType},
 			),
+			Entry("TLSRoutes with shared and separate listeners",
+				tlsRoute(), tlsRoute(),
+				gwv1.Listener{Name: "foo-tls", Protocol: gwv1.TLSProtocolType},
+				gwv1.Listener{Name: "bar-tls", Protocol: gwv1.TLSProtocolType},
+			),
+			Entry("UDPRoutes with shared and separate listeners",
+				udpRoute(), udpRoute(),
+				gwv1.Listener{Name: "foo-udp", Protocol: gwv1.UDPProtocolType},
+				gwv1.Listener{Name: "bar-udp", Protocol: gwv1.UDPProtocolType},
+			),
 		)
 	})
 
+	Describe("building listener set status", func() {
+		It("should build accepted conditions and the listener statuses from the gateway report", func() {
+			gw := gw()
+			ls := listenerSet()
+			rm := reports.NewReportMap()
+			reporter := reports.NewReporter(&rm)
+			reporter.Gateway(gw).ListenerName(reports.ListenerSetListenerName(ls, "https")).SetAttachedRoutes(2)
+			reporter.ListenerSet(ls)
+
+			status := rm.BuildListenerSetStatus(context.Background(), *ls)
+
+			Expect(status).NotTo(BeNil())
+			Expect(status.Conditions).To(HaveLen(2))
+			Expect(meta.IsStatusConditionTrue(status.Conditions, string(apixv1alpha1.ListenerSetConditionAccepted))).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(status.Conditions, string(apixv1alpha1.ListenerSetConditionProgrammed))).To(BeTrue())
+			Expect(status.Listeners).To(HaveLen(1))
+			Expect(status.Listeners[0].Name).To(BeEquivalentTo("https"))
+			Expect(status.Listeners[0].Port).To(BeEquivalentTo(8443))
+			Expect(status.Listeners[0].AttachedRoutes).To(BeEquivalentTo(2))
+			Expect(status.Listeners[0].Conditions).To(HaveLen(4))
+		})
+
+		It("should not report listeners of a listener set which is not accepted", func() {
+			ls := listenerSet()
+			rm := reports.NewReportMap()
+			reporter := reports.NewReporter(&rm)
+			reporter.Gateway(gw())
+			reporter.ListenerSet(ls).SetCondition(reports.ListenerSetCondition{
+				Type:   apixv1alpha1.ListenerSetConditionAccepted,
+				Status: metav1.ConditionFalse,
+				Reason: apixv1alpha1.ListenerSetReasonNotAllowed,
+			})
+
+			status := rm.BuildListenerSetStatus(context.Background(), *ls)
+
+			Expect(status).NotTo(BeNil())
+			Expect(status.Listeners).To(BeEmpty())
+			programmed := meta.FindStatusCondition(status.Conditions, string(apixv1alpha1.ListenerSetConditionProgrammed))
+			Expect(programmed.Status).To(Equal(metav1.ConditionFalse))
+			Expect(programmed.Reason).To(BeEquivalentTo(apixv1alpha1.ListenerSetReasonInvalid))
+		})
+
+		It("should return nil for a listener set without a report", func() {
+			rm := reports.NewReportMap()
+			Expect(rm.BuildListenerSetStatus(context.Background(), *listenerSet())).To(BeNil())
+		})
+	})
+
+	Describe("building policy status", func() {
+		It("should build an accepted ancestor with an empty report", func() {
+			rm := reports.NewReportMap()
+			reporter := reports.NewReporter(&rm)
+			// initialize PolicyReporter to mimic translation loop
+			reporter.Policy(policyKey(), 2).AncestorRef(gwAncestorRef("gw"))
+
+			status := rm.BuildPolicyStatus(context.Background(), policyKey(), "kgateway", v1alpha1.PolicyStatus{})
+
+			Expect(status).NotTo(BeNil())
+			Expect(status.Ancestors).To(HaveLen(1))
+			Expect(status.Ancestors[0].AncestorRef).To(Equal(*gwAncestorRef("gw")))
+			Expect(status.Ancestors[0].ControllerName).To(Equal("kgateway"))
+			Expect(status.Ancestors[0].Conditions).To(HaveLen(1))
+			accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1a2.PolicyConditionAccepted))
+			Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+			Expect(accepted.ObservedGeneration).To(Equal(int64(2)))
+		})
+
+		It("should clear the ancestors of a policy without a report", func() {
+			rm := reports.NewReportMap()
+			current := v1alpha1.PolicyStatus{
+				Ancestors: []v1alpha1.PolicyAncestorStatus{
+					{AncestorRef: *gwAncestorRef("gw"), ControllerName: "kgateway"},
+					{AncestorRef: *gwAncestorRef("other-gw"), ControllerName: "other-controller"},
+				},
+			}
+
+			status := rm.BuildPolicyStatus(context.Background(), policyKey(), "kgateway", current)
+
+			Expect(status).NotTo(BeNil())
+			Expect(status.Ancestors).To(HaveLen(1))
+			Expect(status.Ancestors[0].ControllerName).To(Equal("other-controller"))
+		})
+
+		It("should correctly set negative conditions and record duplicates once", func() {
+			rm := reports.NewReportMap()
+			reporter := reports.NewReporter(&rm)
+			for range 2 {
+				reporter.Policy(policyKey(), 1).AncestorRef(gwAncestorRef("gw")).SetCondition(reports.PolicyCondition{
+					Type:    gwv1a2.PolicyConditionAccepted,
+					Status:  metav1.ConditionFalse,
+					Reason:  gwv1a2.PolicyReasonTargetNotFound,
+					Message: "not found",
+				})
+			}
+			Expect(rm.Policies[policyKey()].Ancestors).To(HaveLen(1))
+			for _, ar := range rm.Policies[policyKey()].Ancestors {
+				Expect(ar.Conditions).To(HaveLen(1))
+			}
+
+			status := rm.BuildPolicyStatus(context.Background(), policyKey(), "kgateway", v1alpha1.PolicyStatus{})
+
+			Expect(status).NotTo(BeNil())
+			Expect(status.Ancestors).To(HaveLen(1))
+			Expect(status.Ancestors[0].Conditions).To(HaveLen(1))
+			accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1a2.PolicyConditionAccepted))
+			Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(accepted.Reason).To(Equal(string(gwv1a2.PolicyReasonTargetNotFound)))
+		})
+
+		It("should merge ancestors from multiple report maps", func() {
+			rm1 := reports.NewReportMap()
+			reports.NewReporter(&rm1).Policy(policyKey(), 1).AncestorRef(gwAncestorRef("gw-1"))
+			rm2 := reports.NewReportMap()
+			reports.NewReporter(&rm2).Policy(policyKey(), 1).AncestorRef(gwAncestorRef("gw-2"))
+
+			merged := reports.NewReportMap()
+			merged.MergePolicyReports(rm1)
+			merged.MergePolicyReports(rm2)
+			status := merged.BuildPolicyStatus(context.Background(), policyKey(), "kgateway", v1alpha1.PolicyStatus{})
+
+			Expect(status).NotTo(BeNil())
+			Expect(status.Ancestors).To(HaveLen(2))
+			Expect(status.Ancestors[0].AncestorRef).To(Equal(*gwAncestorRef("gw-1")))
+			Expect(status.Ancestors[1].AncestorRef).To(Equal(*gwAncestorRef("gw-2")))
+		})
+
+		It("should not alias the merged reports", func() {
+			rm := reports.NewReportMap()
+			reporter := reports.NewReporter(&rm)
+			reporter.Policy(policyKey(), 1).AncestorRef(gwAncestorRef("gw"))
+
+			merged := reports.NewReportMap()
+			merged.MergePolicyReports(rm)
+			reporter.Policy(policyKey(), 1).AncestorRef(gwAncestorRef("gw")).SetCondition(reports.PolicyCondition{
+				Type:   gwv1a2.PolicyConditionAccepted,
+				Status: metav1.ConditionFalse,
+				Reason: gwv1a2.PolicyReasonInvalid,
+			})
+
+			status := merged.BuildPolicyStatus(context.Background(), policyKey(), "kgateway", v1alpha1.PolicyStatus{})
+			Expect(status.Ancestors).To(HaveLen(1))
+			accepted := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1a2.PolicyConditionAccepted))
+			Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should keep ancestors of other controllers and not modify LastTransitionTime for unchanged conditions", func() {
+			rm := reports.NewReportMap()
+			reports.NewReporter(&rm).Policy(policyKey(), 1).AncestorRef(gwAncestorRef("gw"))
+
+			status := rm.BuildPolicyStatus(context.Background(), policyKey(), "kgateway", v1alpha1.PolicyStatus{})
+			Expect(status).NotTo(BeNil())
+			oldTransitionTime := metav1.NewTime(metav1.Now().Add(-time.Hour))
+			status.Ancestors[0].Conditions[0].LastTransitionTime = oldTransitionTime
+			status.Ancestors = append(status.Ancestors, v1alpha1.PolicyAncestorStatus{
+				AncestorRef:    *gwAncestorRef("other-gw"),
+				ControllerName: "other-controller",
+			})
+
+			status = rm.BuildPolicyStatus(context.Background(), policyKey(), "kgateway", *status)
+
+			Expect(status).NotTo(BeNil())
+			Expect(status.Ancestors).To(HaveLen(2))
+			Expect(status.Ancestors[0].Conditions[0].LastTransitionTime).To(Equal(oldTransitionTime))
+			Expect(status.Ancestors[1].ControllerName).To(Equal("other-controller"))
+		})
+	})
+
 	DescribeTable("should handle routes with missing parent references gracefully",
 		func(route client.Object) {
 			// Remove ParentRefs from the route.
 			switch r := route.(type) {
 			case *gwv1.HTTPRoute:
 				r.Spec.ParentRefs = nil
+			case *gwv1.GRPCRoute:
+				r.Spec.ParentRefs = nil
 			case *gwv1a2.TCPRoute:
 				r.Spec.ParentRefs = nil
+			case *gwv1a2.TLSRoute:
+				r.Spec.ParentRefs = nil
+			case *gwv1a2.UDPRoute:
+				r.Spec.ParentRefs = nil
 			}
 
 			rm := reports.NewReportMap()
@@ -334,7 +618,10 @@ var _ = Describe("Reporting Infrastructure", func() {
 			Expect(status.Parents).To(BeEmpty())
 		},
 		Entry("HTTPRoute with missing parent reference", httpRoute()),
+		Entry("GRPCRoute with missing parent reference", grpcRoute()),
 		Entry("TCPRoute with missing parent reference", tcpRoute()),
+		Entry("TLSRoute with missing parent reference", tlsRoute()),
+		Entry("UDPRoute with missing parent reference", udpRoute()),
 	)
 })
 
@@ -349,6 +636,17 @@ func httpRoute() client.Object {
 	return route
 }
 
+func grpcRoute() client.Object {
+	route := &gwv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	}
+	route.Spec.CommonRouteSpec.ParentRefs = append(route.Spec.CommonRouteSpec.ParentRefs, *parentRef())
+	return route
+}
+
 func tcpRoute() client.Object {
 	route := &gwv1a2.TCPRoute{
 		ObjectMeta: metav1.ObjectMeta{
@@ -360,6 +658,28 @@ func tcpRoute() client.Object {
 	return route
 }
 
+func tlsRoute() client.Object {
+	route := &gwv1a2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	}
+	route.Spec.CommonRouteSpec.ParentRefs = append(route.Spec.CommonRouteSpec.ParentRefs, *parentRef())
+	return route
+}
+
+func udpRoute() client.Object {
+	route := &gwv1a2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	}
+	route.Spec.CommonRouteSpec.ParentRefs = append(route.Spec.CommonRouteSpec.ParentRefs, *parentRef())
+	return route
+}
+
 func parentRef() *gwv1.ParentReference {
 	return &gwv1.ParentReference{
 		Name: "parent",
@@ -402,3 +722,43 @@ func listener() *gwv1.Listener {
 		Name: "http",
 	}
 }
+
+func listenerSet() *apixv1alpha1.XListenerSet {
+	return &apixv1alpha1.XListenerSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "team",
+			Name:      "test",
+		},
+		Spec: apixv1alpha1.ListenerSetSpec{
+			ParentRef: apixv1alpha1.ParentGatewayReference{
+				Name:      "test",
+				Namespace: ptr.To(gwv1.Namespace("default")),
+			},
+			Listeners: []apixv1alpha1.ListenerEntry{{
+				Name:     "https",
+				Port:     8443,
+				Protocol: gwv1.HTTPSProtocolType,
+			}},
+		},
+	}
+}
+
+func policyKey() reports.PolicyKey {
+	return reports.PolicyKey{
+		Group: "gateway.kgateway.dev",
+		Kind:  "RoutePolicy",
+		NamespacedName: types.NamespacedName{
+			Namespace: "default",
+			Name:      "policy",
+		},
+	}
+}
+
+func gwAncestorRef(name string) *gwv1.ParentReference {
+	return &gwv1.ParentReference{
+		Group:     ptr.To(gwv1.Group("gateway.networking.k8s.io")),
+		Kind:      ptr.To(gwv1.Kind("Gateway")),
+		Name:      gwv1.ObjectName(name),
+		Namespace: ptr.To(gwv1.Namespace("default")),
+	}
+}
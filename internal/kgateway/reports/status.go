@@ -12,6 +12,32 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
+)
+
+const (
+	// GatewayConditionProxyConnected reports whether a proxy of the Gateway is connected to the control plane
+	// over xDS. This is mostly useful for self-managed proxies, which are not deployed by the control plane.
+	GatewayConditionProxyConnected gwv1.GatewayConditionType = "ProxyConnected"
+
+	GatewayReasonProxyConnected    gwv1.GatewayConditionReason = "Connected"
+	GatewayReasonProxyNotConnected gwv1.GatewayConditionReason = "NotConnected"
+
+	// GatewayConditionAttachedListenerSets reports whether XListenerSets are attached to the Gateway.
+	// It is only set when XListenerSets target the Gateway, and its message has the number of
+	// attached XListenerSets.
+	GatewayConditionAttachedListenerSets gwv1.GatewayConditionType = "AttachedListenerSets"
+
+	GatewayReasonListenerSetsAttached   gwv1.GatewayConditionReason = "ListenerSetsAttached"
+	GatewayReasonNoListenerSetsAttached gwv1.GatewayConditionReason = "NoListenerSetsAttached"
+	GatewayReasonListenerSetsNotAllowed gwv1.GatewayConditionReason = "ListenerSetsNotAllowed"
+
+	// RouteReasonConflicted is the reason of the Accepted condition of a route that is not accepted
+	// because an older route attached to the same listener already claims its hostnames, for route
+	// kinds where only one route can handle a hostname, e.g. TLSRoute, or a listener, e.g. UDPRoute.
+	RouteReasonConflicted gwv1.RouteConditionReason = "Conflicted"
 )
 
 // TODO: refactor this struct + methods to better reflect the usage now in proxy_syncer
@@ -65,13 +91,100 @@ func (r *ReportMap) BuildGWStatus(ctx context.Context, gw gwv1.Gateway) *gwv1.Ga
 	return &finalGwStatus
 }
 
+// BuildListenerSetStatus returns a newly constructed ListenerSetStatus for the supplied XListenerSet
+// according to the state of the ReportMap. The status of its listeners is taken from the report of
+// the parent Gateway. If the ReportMap does not have a ListenerSetReport for the XListenerSet, e.g.
+// because its parent is not one of our Gateways, nil is returned.
+func (r *ReportMap) BuildListenerSetStatus(ctx context.Context, ls apixv1alpha1.XListenerSet) *apixv1alpha1.ListenerSetStatus {
+	lsReport := r.ListenerSet(&ls)
+	if lsReport == nil {
+		contextutils.LoggerFrom(ctx).Debugf("missing listener set report for %s/%s", ls.Namespace, ls.Name)
+		return nil
+	}
+
+	addMissingListenerSetConditions(lsReport)
+
+	finalConditions := make([]metav1.Condition, 0)
+	for _, lsCondition := range lsReport.GetConditions() {
+		lsCondition.ObservedGeneration = lsReport.observedGeneration
+
+		// copy old condition from the listener set so LastTransitionTime is set correctly below by SetStatusCondition()
+		if cond := meta.FindStatusCondition(ls.Status.Conditions, lsCondition.Type); cond != nil {
+			finalConditions = append(finalConditions, *cond)
+		}
+		meta.SetStatusCondition(&finalConditions, lsCondition)
+	}
+
+	finalStatus := apixv1alpha1.ListenerSetStatus{Conditions: finalConditions}
+	gwReport := r.Gateways[lsReport.gateway]
+	if gwReport == nil || !meta.IsStatusConditionTrue(finalConditions, string(apixv1alpha1.ListenerSetConditionAccepted)) {
+		// the listeners of a listener set which is not accepted are not translated
+		return &finalStatus
+	}
+
+	finalListeners := make([]apixv1alpha1.ListenerEntryStatus, 0, len(ls.Spec.Listeners))
+	for _, lis := range ls.Spec.Listeners {
+		lisReport := gwReport.listener(ListenerSetListenerName(&ls, lis.Name))
+		addMissingListenerConditions(lisReport)
+
+		finalLisConditions := make([]metav1.Condition, 0, len(lisReport.Status.Conditions))
+		oldLisStatusIndex := slices.IndexFunc(ls.Status.Listeners, func(l apixv1alpha1.ListenerEntryStatus) bool {
+			return l.Name == lis.Name
+		})
+		for _, lisCondition := range lisReport.Status.Conditions {
+			lisCondition.ObservedGeneration = lsReport.observedGeneration
+
+			if oldLisStatusIndex != -1 {
+				if cond := meta.FindStatusCondition(ls.Status.Listeners[oldLisStatusIndex].Conditions, lisCondition.Type); cond != nil {
+					finalLisConditions = append(finalLisConditions, *cond)
+				}
+			}
+			meta.SetStatusCondition(&finalLisConditions, lisCondition)
+		}
+		finalListeners = append(finalListeners, apixv1alpha1.ListenerEntryStatus{
+			Name:           lis.Name,
+			Port:           lis.Port,
+			SupportedKinds: lisReport.Status.SupportedKinds,
+			AttachedRoutes: lisReport.Status.AttachedRoutes,
+			Conditions:     finalLisConditions,
+		})
+	}
+	finalStatus.Listeners = finalListeners
+	return &finalStatus
+}
+
+// SetProxyConnectedCondition sets the ProxyConnected condition on the status built for the Gateway,
+// keeping the LastTransitionTime of its existing condition if unchanged.
+func SetProxyConnectedCondition(gw gwv1.Gateway, status *gwv1.GatewayStatus, connected bool) {
+	cond := metav1.Condition{
+		Type:               string(GatewayConditionProxyConnected),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(GatewayReasonProxyNotConnected),
+		Message:            "No proxy is connected to the control plane",
+		ObservedGeneration: gw.Generation,
+	}
+	if connected {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = string(GatewayReasonProxyConnected)
+		cond.Message = "A proxy is connected to the control plane"
+	}
+
+	if old := meta.FindStatusCondition(gw.Status.Conditions, cond.Type); old != nil {
+		meta.SetStatusCondition(&status.Conditions, *old)
+	}
+	meta.SetStatusCondition(&status.Conditions, cond)
+}
+
 // BuildRouteStatus returns a newly constructed and fully defined RouteStatus for the supplied route object
 // according to the state of the ReportMap. If the ReportMap does not have a RouteReport for the given route,
 // e.g. because it did not encounter the route during translation, or the object is an unsupported route kind,
 // nil is returned. Supported object types are:
 //
 // * HTTPRoute
+// * GRPCRoute
 // * TCPRoute
+// * TLSRoute
+// * UDPRoute
 func (r *ReportMap) BuildRouteStatus(ctx context.Context, obj client.Object, cName string) *gwv1.RouteStatus {
 	routeReport := r.route(obj)
 	if routeReport == nil {
@@ -96,12 +209,30 @@ func (r *ReportMap) BuildRouteStatus(ctx context.Context, obj client.Object, cNa
 		if len(parentRefs) == 0 {
 			parentRefs = append(parentRefs, routeReport.parentRefs()...)
 		}
+	case *gwv1.GRPCRoute:
+		existingStatus = route.Status.RouteStatus
+		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
+		if len(parentRefs) == 0 {
+			parentRefs = append(parentRefs, routeReport.parentRefs()...)
+		}
 	case *gwv1a2.TCPRoute:
 		existingStatus = route.Status.RouteStatus
 		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
 		if len(parentRefs) == 0 {
 			parentRefs = append(parentRefs, routeReport.parentRefs()...)
 		}
+	case *gwv1a2.TLSRoute:
+		existingStatus = route.Status.RouteStatus
+		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
+		if len(parentRefs) == 0 {
+			parentRefs = append(parentRefs, routeReport.parentRefs()...)
+		}
+	case *gwv1a2.UDPRoute:
+		existingStatus = route.Status.RouteStatus
+		parentRefs = append(parentRefs, route.Spec.ParentRefs...)
+		if len(parentRefs) == 0 {
+			parentRefs = append(parentRefs, routeReport.parentRefs()...)
+		}
 	default:
 		contextutils.LoggerFrom(ctx).Error(fmt.Errorf("unsupported route type %T", obj), "failed to build route status")
 		return nil
@@ -144,6 +275,66 @@ func (r *ReportMap) BuildRouteStatus(ctx context.Context, obj client.Object, cNa
 	return &routeStatus
 }
 
+// BuildPolicyStatus returns a newly constructed PolicyStatus for the policy identified by key,
+// according to the state of the ReportMap. Ancestors written by other controllers in the current
+// status are preserved. If the ReportMap does not have a PolicyReport for the policy, e.g. because
+// it is no longer attached to any of our Gateways, the ancestors written by this controller are cleared.
+func (r *ReportMap) BuildPolicyStatus(ctx context.Context, key PolicyKey, cName string, currentStatus v1alpha1.PolicyStatus) *v1alpha1.PolicyStatus {
+	policyReport := r.policy(key)
+	if policyReport == nil {
+		contextutils.LoggerFrom(ctx).Debugf("missing policy report for %s, clearing its ancestors", key.String())
+		policyReport = &PolicyReport{}
+	}
+
+	policyStatus := v1alpha1.PolicyStatus{
+		Conditions: currentStatus.Conditions,
+	}
+	for _, ancestorRef := range policyReport.ancestorRefs() {
+		ancestorReport := policyReport.ancestorRef(&ancestorRef)
+		addMissingAncestorRefConditions(ancestorReport)
+
+		var currentAncestorConditions []metav1.Condition
+		currentAncestorIdx := slices.IndexFunc(currentStatus.Ancestors, func(s v1alpha1.PolicyAncestorStatus) bool {
+			return s.ControllerName == cName && reflect.DeepEqual(s.AncestorRef, ancestorRef)
+		})
+		if currentAncestorIdx != -1 {
+			currentAncestorConditions = currentStatus.Ancestors[currentAncestorIdx].Conditions
+		}
+
+		finalConditions := make([]metav1.Condition, 0, len(ancestorReport.Conditions))
+		for _, aCondition := range ancestorReport.Conditions {
+			// a positive condition, e.g. accepted with a warning, never overrides a negative one
+			if aCondition.Status == metav1.ConditionTrue && slices.ContainsFunc(ancestorReport.Conditions, func(c metav1.Condition) bool {
+				return c.Type == aCondition.Type && c.Status == metav1.ConditionFalse
+			}) {
+				continue
+			}
+			aCondition.ObservedGeneration = policyReport.observedGeneration
+
+			// Copy old condition to preserve LastTransitionTime, if it exists
+			if cond := meta.FindStatusCondition(currentAncestorConditions, aCondition.Type); cond != nil {
+				finalConditions = append(finalConditions, *cond)
+			}
+			meta.SetStatusCondition(&finalConditions, aCondition)
+		}
+
+		policyStatus.Ancestors = append(policyStatus.Ancestors, v1alpha1.PolicyAncestorStatus{
+			AncestorRef:    ancestorRef,
+			ControllerName: cName,
+			Conditions:     finalConditions,
+		})
+	}
+
+	// keep ancestors written by other controllers
+	for _, ancestor := range currentStatus.Ancestors {
+		if ancestor.ControllerName != cName {
+			policyStatus.Ancestors = append(policyStatus.Ancestors, ancestor)
+		}
+	}
+
+	return &policyStatus
+}
+
 // Reports will initially only contain negative conditions found during translation,
 // so all missing conditions are assumed to be positive. Here we will add all missing conditions
 // to a given report, i.e. set healthy conditions
@@ -164,6 +355,32 @@ func addMissingGatewayConditions(gwReport *GatewayReport) {
 	}
 }
 
+func addMissingListenerSetConditions(lsReport *ListenerSetReport) {
+	accepted := meta.FindStatusCondition(lsReport.GetConditions(), string(apixv1alpha1.ListenerSetConditionAccepted))
+	if accepted == nil {
+		lsReport.SetCondition(ListenerSetCondition{
+			Type:   apixv1alpha1.ListenerSetConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: apixv1alpha1.ListenerSetReasonAccepted,
+		})
+	}
+	if cond := meta.FindStatusCondition(lsReport.GetConditions(), string(apixv1alpha1.ListenerSetConditionProgrammed)); cond == nil {
+		if accepted != nil && accepted.Status == metav1.ConditionFalse {
+			lsReport.SetCondition(ListenerSetCondition{
+				Type:   apixv1alpha1.ListenerSetConditionProgrammed,
+				Status: metav1.ConditionFalse,
+				Reason: apixv1alpha1.ListenerSetReasonInvalid,
+			})
+			return
+		}
+		lsReport.SetCondition(ListenerSetCondition{
+			Type:   apixv1alpha1.ListenerSetConditionProgrammed,
+			Status: metav1.ConditionTrue,
+			Reason: apixv1alpha1.ListenerSetReasonProgrammed,
+		})
+	}
+}
+
 // Reports will initially only contain negative conditions found during translation,
 // so all missing conditions are assumed to be positive. Here we will add all missing conditions
 // to a given report, i.e. set healthy conditions
@@ -218,3 +435,16 @@ func addMissingParentRefConditions(report *ParentRefReport) {
 		})
 	}
 }
+
+// Reports will initially only contain negative conditions found during translation,
+// so all missing conditions are assumed to be positive. Here we will add all missing conditions
+// to a given report, i.e. set healthy conditions
+func addMissingAncestorRefConditions(report *AncestorRefReport) {
+	if cond := meta.FindStatusCondition(report.Conditions, string(gwv1a2.PolicyConditionAccepted)); cond == nil {
+		report.SetCondition(PolicyCondition{
+			Type:   gwv1a2.PolicyConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1a2.PolicyReasonAccepted,
+		})
+	}
+}
@@ -60,7 +60,7 @@ func StartGGv2(
 ) error {
 	restConfig := ctrl.GetConfigOrDie()
 
-	uniqueClientCallbacks, uccBuilder := krtcollections.NewUniquelyConnectedClients()
+	uniqueClientCallbacks, uccBuilder, connectedClients := krtcollections.NewUniquelyConnectedClients()
 	cache, err := startControlPlane(ctx, uniqueClientCallbacks)
 	if err != nil {
 		return err
@@ -69,6 +69,7 @@ func StartGGv2(
 	setupOpts := &controller.SetupOpts{
 		Cache:               cache,
 		KrtDebugger:         new(krt.DebugHandler),
+		ConnectedClients:    connectedClients,
 		ExtraGatewayClasses: extraGwClasses,
 		XdsHost:             GetControlPlaneXdsHost(),
 		XdsPort:             9977,
@@ -118,7 +119,8 @@ func StartGGv2WithConfig(
 		augmentedPodsForUcc = nil
 	}
 
-	ucc := uccBuilder(ctx, krtOpts, augmentedPodsForUcc)
+	selfManagedGateways := krtcollections.NewSelfManagedGateways(ctx, kubeClient, krtOpts)
+	ucc := uccBuilder(ctx, krtOpts, augmentedPodsForUcc, selfManagedGateways)
 
 	logger.Info("initializing controller")
 	c, err := controller.NewControllerBuilder(ctx, controller.StartConfig{
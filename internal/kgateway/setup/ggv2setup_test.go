@@ -166,7 +166,7 @@ func TestScenarios(t *testing.T) {
 	}
 
 	// setup xDS server:
-	uniqueClientCallbacks, builder := krtcollections.NewUniquelyConnectedClients()
+	uniqueClientCallbacks, builder, connectedClients := krtcollections.NewUniquelyConnectedClients()
 
 	lis, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
@@ -179,10 +179,11 @@ func TestScenarios(t *testing.T) {
 	}
 
 	setupOpts := &controller.SetupOpts{
-		Cache:       snapCache,
-		KrtDebugger: new(krt.DebugHandler),
-		XdsHost:     "localhost",
-		XdsPort:     9977,
+		Cache:            snapCache,
+		KrtDebugger:      new(krt.DebugHandler),
+		ConnectedClients: connectedClients,
+		XdsHost:          "localhost",
+		XdsPort:          9977,
 	}
 
 	// start ggv2
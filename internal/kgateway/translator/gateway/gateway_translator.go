@@ -2,19 +2,26 @@ package gateway
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
 
 	"github.com/solo-io/go-utils/contextutils"
 	"istio.io/istio/pkg/kube/krt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/query"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/listener"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
 
 func NewTranslator(queries query.GatewayQueries) extensionsplug.KGwTranslator {
@@ -47,6 +54,26 @@ func (t *translator) Translate(
 		return nil
 	}
 
+	// the routes of the listener sets are keyed by the names of their listeners, which are unique
+	// across the gateway.
+	gwListeners := gateway.Listeners
+	for _, ls := range gateway.ListenerSets {
+		if !ls.Allowed {
+			continue
+		}
+		routesForLs, err := t.queries.GetRoutesForListenerSet(kctx, ctx, ls.Obj)
+		if err != nil {
+			logger.Errorf("failed to get routes for listener set %s/%s: %v", ls.Namespace, ls.Name, err)
+			continue
+		}
+		maps.Copy(routesForGw.ListenerResults, routesForLs.ListenerResults)
+		routesForGw.RouteErrors = append(routesForGw.RouteErrors, routesForLs.RouteErrors...)
+		gwListeners = append(slices.Clip(gwListeners), ls.Listeners...)
+	}
+
+	reportInfrastructure(gateway.Obj, reporter.Gateway(gateway.Obj))
+	reportAddresses(gateway, reporter.Gateway(gateway.Obj))
+
 	for _, rErr := range routesForGw.RouteErrors {
 		reporter.Route(rErr.Route.GetSourceObject()).ParentRef(&rErr.ParentRef).SetCondition(reports.RouteCondition{
 			Type:   gwv1.RouteConditionAccepted,
@@ -56,7 +83,7 @@ func (t *translator) Translate(
 		})
 	}
 
-	for _, listener := range gateway.Listeners {
+	for _, listener := range gwListeners {
 		availRoutes := 0
 		if res, ok := routesForGw.ListenerResults[string(listener.Name)]; ok {
 			// TODO we've never checked if the ListenerResult has an error.. is it already on RouteErrors?
@@ -81,3 +108,82 @@ func (t *translator) Translate(
 		AttachedHttpPolicies: gateway.AttachedHttpPolicies,
 	}
 }
+
+// reportInfrastructure reports the GatewayParameters reference of the Gateway that the deployer
+// can't use: parametersRef of another kind, or the gateway parameters annotation, which is ignored
+// when parametersRef is set.
+func reportInfrastructure(gw *gwv1.Gateway, reporter reports.GatewayReporter) {
+	if gw.Spec.Infrastructure == nil || gw.Spec.Infrastructure.ParametersRef == nil {
+		return
+	}
+	ref := gw.Spec.Infrastructure.ParametersRef
+	if string(ref.Group) != v1alpha1.GroupName || string(ref.Kind) != v1alpha1.GatewayParametersKind {
+		reporter.SetCondition(reports.GatewayCondition{
+			Type:    gwv1.GatewayConditionAccepted,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.GatewayReasonInvalidParameters,
+			Message: fmt.Sprintf("unsupported parametersRef %s/%s", ref.Group, ref.Kind),
+		})
+		return
+	}
+	if name := gw.GetAnnotations()[wellknown.GatewayParametersAnnotationName]; name != "" {
+		reporter.SetCondition(reports.GatewayCondition{
+			Type:   gwv1.GatewayConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.GatewayReasonAccepted,
+			Message: fmt.Sprintf("annotation %s=%s is ignored, as spec.infrastructure.parametersRef references GatewayParameters %s",
+				wellknown.GatewayParametersAnnotationName, name, ref.Name),
+		})
+	}
+}
+
+// reportAddresses sets the Programmed condition of the Gateway to false when its requested
+// addresses can't be used by the deployer, or aren't assigned to its Service yet.
+func reportAddresses(gateway *ir.Gateway, reporter reports.GatewayReporter) {
+	if len(gateway.Obj.Spec.Addresses) == 0 {
+		return
+	}
+	// the deployer doesn't create a Service for a self-managed Gateway
+	if gateway.SelfManaged {
+		reporter.SetCondition(reports.GatewayCondition{
+			Type:    gwv1.GatewayConditionProgrammed,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.GatewayReasonAddressNotUsable,
+			Message: "addresses can't be requested for a self-managed gateway",
+		})
+		return
+	}
+
+	assigned := sets.New(gateway.AssignedAddresses...)
+	for i, addr := range gateway.Obj.Spec.Addresses {
+		// the deployer only sets IP addresses on the Service
+		if (addr.Type != nil && *addr.Type != gwv1.IPAddressType) || net.ParseIP(addr.Value) == nil {
+			reporter.SetCondition(reports.GatewayCondition{
+				Type:    gwv1.GatewayConditionProgrammed,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: fmt.Sprintf("requested address %s can't be used", addr.Value),
+			})
+			return
+		}
+		// a LoadBalancer Service only requests the first address as its loadBalancerIP
+		if gateway.LoadBalancer && i > 0 {
+			reporter.SetCondition(reports.GatewayCondition{
+				Type:    gwv1.GatewayConditionProgrammed,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.GatewayReasonAddressNotUsable,
+				Message: fmt.Sprintf("requested address %s can't be used, as a LoadBalancer Service can only request one address", addr.Value),
+			})
+			return
+		}
+		if !assigned.Has(addr.Value) {
+			reporter.SetCondition(reports.GatewayCondition{
+				Type:    gwv1.GatewayConditionProgrammed,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.GatewayReasonAddressNotAssigned,
+				Message: fmt.Sprintf("requested address %s is not assigned yet", addr.Value),
+			})
+			return
+		}
+	}
+}
@@ -191,6 +191,43 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "gw",
 			},
 		}),
+	Entry(
+		"grpc gateway with basic routing",
+		translatorTestCase{
+			inputFile:  "grpc-routing/basic.yaml",
+			outputFile: "grpc-routing/basic-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				route := &gwv1.GRPCRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "example-grpc-route",
+						Namespace: "default",
+					},
+				}
+				routeStatus := reportsMap.BuildRouteStatus(context.TODO(), route, "")
+				Expect(routeStatus).NotTo(BeNil())
+				Expect(routeStatus.Parents).To(HaveLen(1))
+				resolvedRefs := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionResolvedRefs))
+				Expect(resolvedRefs).NotTo(BeNil())
+				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionTrue))
+
+				gwStatus := reportsMap.BuildGWStatus(context.TODO(), gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      gwNN.Name,
+						Namespace: gwNN.Namespace,
+					},
+					Spec: gwv1.GatewaySpec{
+						Listeners: []gwv1.Listener{{Name: "http"}},
+					},
+				})
+				Expect(gwStatus).NotTo(BeNil())
+				Expect(gwStatus.Listeners).To(HaveLen(1))
+				Expect(gwStatus.Listeners[0].AttachedRoutes).To(Equal(int32(1)))
+			},
+		}),
 	Entry(
 		"tcp gateway with basic routing",
 		translatorTestCase{
@@ -216,6 +253,74 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Expect(resolvedRefs.Reason).To(Equal(string(gwv1.RouteReasonResolvedRefs)))
 			},
 		}),
+	Entry(
+		"tls gateway with passthrough routing",
+		translatorTestCase{
+			inputFile:  "tls-routing/passthrough.yaml",
+			outputFile: "tls-routing/passthrough-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				route := &gwv1a2.TLSRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "example-tls-route",
+						Namespace: "default",
+					},
+				}
+				routeStatus := reportsMap.BuildRouteStatus(context.TODO(), route, "")
+				Expect(routeStatus).NotTo(BeNil())
+				Expect(routeStatus.Parents).To(HaveLen(1))
+				accepted := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+			},
+		}),
+	Entry(
+		"tls gateway with routes claiming the same hostnames",
+		translatorTestCase{
+			inputFile:  "tls-routing/conflict.yaml",
+			outputFile: "tls-routing/conflict-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				accepted := func(name string) *metav1.Condition {
+					route := &gwv1a2.TLSRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+					}
+					routeStatus := reportsMap.BuildRouteStatus(context.TODO(), route, "")
+					Expect(routeStatus).NotTo(BeNil())
+					Expect(routeStatus.Parents).To(HaveLen(1))
+					return meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				}
+
+				Expect(accepted("example-tls-route").Status).To(Equal(metav1.ConditionTrue))
+
+				conflicting := accepted("conflicting-tls-route")
+				Expect(conflicting.Status).To(Equal(metav1.ConditionFalse))
+				Expect(conflicting.Reason).To(Equal(string(reports.RouteReasonConflicted)))
+
+				partial := accepted("partial-tls-route")
+				Expect(partial.Status).To(Equal(metav1.ConditionTrue))
+				Expect(partial.Message).To(ContainSubstring("a.example.com"))
+			},
+		}),
+	Entry(
+		"tls gateway with terminate routing",
+		translatorTestCase{
+			inputFile:  "tls-routing/terminate.yaml",
+			outputFile: "tls-routing/terminate-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
 	Entry(
 		"tcproute with missing backend reports correctly",
 		translatorTestCase{
@@ -276,6 +381,92 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 				Name:      "example-tcp-gateway",
 			},
 		}),
+	Entry(
+		"udp gateway with basic routing",
+		translatorTestCase{
+			inputFile:  "udp-routing/basic.yaml",
+			outputFile: "udp-routing/basic-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				route := &gwv1a2.UDPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "example-udp-route",
+						Namespace: "default",
+					},
+				}
+				routeStatus := reportsMap.BuildRouteStatus(context.TODO(), route, "")
+				Expect(routeStatus).NotTo(BeNil())
+				Expect(routeStatus.Parents).To(HaveLen(1))
+				accepted := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				Expect(accepted).NotTo(BeNil())
+				Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+				resolvedRefs := meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionResolvedRefs))
+				Expect(resolvedRefs).NotTo(BeNil())
+				Expect(resolvedRefs.Status).To(Equal(metav1.ConditionTrue))
+			},
+		}),
+	Entry(
+		"udp gateway with multiple routes",
+		translatorTestCase{
+			inputFile:  "udp-routing/conflict.yaml",
+			outputFile: "udp-routing/basic-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				accepted := func(name string) *metav1.Condition {
+					route := &gwv1a2.UDPRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+					}
+					routeStatus := reportsMap.BuildRouteStatus(context.TODO(), route, "")
+					Expect(routeStatus).NotTo(BeNil())
+					Expect(routeStatus.Parents).To(HaveLen(1))
+					return meta.FindStatusCondition(routeStatus.Parents[0].Conditions, string(gwv1.RouteConditionAccepted))
+				}
+
+				Expect(accepted("example-udp-route").Status).To(Equal(metav1.ConditionTrue))
+				conflicting := accepted("conflicting-udp-route")
+				Expect(conflicting.Status).To(Equal(metav1.ConditionFalse))
+				Expect(conflicting.Reason).To(Equal(string(reports.RouteReasonConflicted)))
+			},
+		}),
+	Entry(
+		"udp and tcp listeners on the same port",
+		translatorTestCase{
+			inputFile:  "udp-routing/tcp-same-port.yaml",
+			outputFile: "udp-routing/tcp-same-port-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"udp gateway with multiple weighted backend services",
+		translatorTestCase{
+			inputFile:  "udp-routing/multi-backend.yaml",
+			outputFile: "udp-routing/multi-backend-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
+	Entry(
+		"udp gateway with a backend service of weight 0",
+		translatorTestCase{
+			inputFile:  "udp-routing/zero-weight.yaml",
+			outputFile: "udp-routing/multi-backend-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		}),
 	Entry("Plugin Backend", translatorTestCase{
 		inputFile:  "backend-plugin/gateway.yaml",
 		outputFile: "backend-plugin-proxy.yaml",
@@ -292,6 +483,54 @@ var _ = DescribeTable("Basic GatewayTranslator Tests",
 			Name:      "example-gateway",
 		},
 	}),
+	Entry("Proxy with gateway infrastructure and addresses", translatorTestCase{
+		inputFile:  "edge-cases/infrastructure.yaml",
+		outputFile: "no_route.yaml",
+		gwNN: types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+		assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+			gwStatus := reportsMap.BuildGWStatus(context.TODO(), gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      gwNN.Name,
+					Namespace: gwNN.Namespace,
+				},
+			})
+			Expect(gwStatus).NotTo(BeNil())
+			accepted := meta.FindStatusCondition(gwStatus.Conditions, string(gwv1.GatewayConditionAccepted))
+			Expect(accepted).NotTo(BeNil())
+			Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+			Expect(accepted.Message).To(Equal("annotation gateway.kgateway.dev/gateway-parameters-name=annotated-params is ignored, as spec.infrastructure.parametersRef references GatewayParameters referenced-params"))
+			programmed := meta.FindStatusCondition(gwStatus.Conditions, string(gwv1.GatewayConditionProgrammed))
+			Expect(programmed).NotTo(BeNil())
+			Expect(programmed.Status).To(Equal(metav1.ConditionFalse))
+			Expect(programmed.Reason).To(Equal(string(gwv1.GatewayReasonAddressNotAssigned)))
+			Expect(programmed.Message).To(Equal("requested address 10.0.0.2 is not assigned yet"))
+		},
+	}),
+	Entry("Proxy with a LoadBalancer service and several addresses", translatorTestCase{
+		inputFile:  "edge-cases/load-balancer-addresses.yaml",
+		outputFile: "no_route.yaml",
+		gwNN: types.NamespacedName{
+			Namespace: "default",
+			Name:      "example-gateway",
+		},
+		assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+			gwStatus := reportsMap.BuildGWStatus(context.TODO(), gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      gwNN.Name,
+					Namespace: gwNN.Namespace,
+				},
+			})
+			Expect(gwStatus).NotTo(BeNil())
+			programmed := meta.FindStatusCondition(gwStatus.Conditions, string(gwv1.GatewayConditionProgrammed))
+			Expect(programmed).NotTo(BeNil())
+			Expect(programmed.Status).To(Equal(metav1.ConditionFalse))
+			Expect(programmed.Reason).To(Equal(string(gwv1.GatewayReasonAddressNotUsable)))
+			Expect(programmed.Message).To(Equal("requested address 10.0.0.2 can't be used, as a LoadBalancer Service can only request one address"))
+		},
+	}),
 	Entry("Direct response", translatorTestCase{
 		inputFile:  "directresponse/manifest.yaml",
 		outputFile: "directresponse.yaml",
@@ -76,6 +76,18 @@ func AreReportsSuccess(gwNN types.NamespacedName, reportsMap reports.ReportMap)
 			}
 		}
 	}
+	for nns, routeReport := range reportsMap.GRPCRoutes {
+		for ref, parentRefReport := range routeReport.Parents {
+			for _, c := range parentRefReport.Conditions {
+				// most route conditions true is good, except RouteConditionPartiallyInvalid
+				if c.Type == string(gwv1.RouteConditionPartiallyInvalid) && c.Status != metav1.ConditionFalse {
+					return fmt.Errorf("condition error for grpcroute: %v ref: %v condition: %v", nns, ref, c)
+				} else if c.Status != metav1.ConditionTrue {
+					return fmt.Errorf("condition error for grpcroute: %v ref: %v condition: %v", nns, ref, c)
+				}
+			}
+		}
+	}
 	for nns, routeReport := range reportsMap.TCPRoutes {
 		for ref, parentRefReport := range routeReport.Parents {
 			for _, c := range parentRefReport.Conditions {
@@ -88,6 +100,30 @@ func AreReportsSuccess(gwNN types.NamespacedName, reportsMap reports.ReportMap)
 			}
 		}
 	}
+	for nns, routeReport := range reportsMap.TLSRoutes {
+		for ref, parentRefReport := range routeReport.Parents {
+			for _, c := range parentRefReport.Conditions {
+				// most route conditions true is good, except RouteConditionPartiallyInvalid
+				if c.Type == string(gwv1.RouteConditionPartiallyInvalid) && c.Status != metav1.ConditionFalse {
+					return fmt.Errorf("condition error for tlsroute: %v ref: %v condition: %v", nns, ref, c)
+				} else if c.Status != metav1.ConditionTrue {
+					return fmt.Errorf("condition error for tlsroute: %v ref: %v condition: %v", nns, ref, c)
+				}
+			}
+		}
+	}
+	for nns, routeReport := range reportsMap.UDPRoutes {
+		for ref, parentRefReport := range routeReport.Parents {
+			for _, c := range parentRefReport.Conditions {
+				// most route conditions true is good, except RouteConditionPartiallyInvalid
+				if c.Type == string(gwv1.RouteConditionPartiallyInvalid) && c.Status != metav1.ConditionFalse {
+					return fmt.Errorf("condition error for udproute: %v ref: %v condition: %v", nns, ref, c)
+				} else if c.Status != metav1.ConditionTrue {
+					return fmt.Errorf("condition error for udproute: %v ref: %v condition: %v", nns, ref, c)
+				}
+			}
+		}
+	}
 
 	for nns, gwReport := range reportsMap.Gateways {
 		for _, c := range gwReport.GetConditions() {
@@ -97,6 +133,16 @@ func AreReportsSuccess(gwNN types.NamespacedName, reportsMap reports.ReportMap)
 		}
 	}
 
+	for key, policyReport := range reportsMap.Policies {
+		for ref, ancestorReport := range policyReport.Ancestors {
+			for _, c := range ancestorReport.Conditions {
+				if c.Status != metav1.ConditionTrue {
+					return fmt.Errorf("condition error for policy: %v ancestor: %v condition: %v", key, ref, c)
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -154,9 +200,12 @@ func (tc TestCase) Run(t test.Failer, ctx context.Context) (map[types.Namespaced
 		gvr.KubernetesGateway_v1,
 		gvr.GatewayClass,
 		gvr.HTTPRoute_v1,
+		gvr.GRPCRoute,
 		gvr.Service,
 		gvr.Pod,
 		gvr.TCPRoute,
+		gvr.TLSRoute,
+		gvr.UDPRoute,
 	} {
 		clienttest.MakeCRD(t, cli, crd)
 	}
@@ -190,7 +239,7 @@ func (tc TestCase) Run(t test.Failer, ctx context.Context) (map[types.Namespaced
 		return true
 	}
 
-	gi, ri, ui, ei := krtcollections.InitCollections(ctx, extensions, cli, isOurGw, commoncol.RefGrants, krtOpts)
+	gi, ri, ui, ei, _ := krtcollections.InitCollections(ctx, extensions, cli, isOurGw, commoncol.RefGrants, commoncol.Settings.EnableExperimentalListenerSets, krtOpts)
 
 	translator := translator.NewCombinedTranslator(ctx, extensions, commoncol)
 	translator.Init(ctx, ri)
@@ -42,7 +42,7 @@ type filterChainTranslator struct {
 	PluginPass TranslationPassPlugins
 }
 
-func computeListenerAddress(bindAddress string, port uint32, reporter reports.GatewayReporter) *envoy_config_core_v3.Address {
+func computeListenerAddress(bindAddress string, port uint32, protocol envoy_config_core_v3.SocketAddress_Protocol, reporter reports.GatewayReporter) *envoy_config_core_v3.Address {
 	_, isIpv4Address, err := utils.IsIpv4Address(bindAddress)
 	if err != nil {
 		// TODO: return error ????
@@ -57,7 +57,7 @@ func computeListenerAddress(bindAddress string, port uint32, reporter reports.Ga
 	return &envoy_config_core_v3.Address{
 		Address: &envoy_config_core_v3.Address_SocketAddress{
 			SocketAddress: &envoy_config_core_v3.SocketAddress{
-				Protocol: envoy_config_core_v3.SocketAddress_TCP,
+				Protocol: protocol,
 				Address:  bindAddress,
 				PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
 					PortValue: port,
@@ -213,7 +213,8 @@ func (h *hcmNetworkFilterTranslator) computeNetworkFilters(ctx context.Context,
 			}
 			for _, pol := range pols {
 				pctx := &ir.HcmContext{
-					Policy: pol.PolicyIr,
+					Policy:  pol.PolicyIr,
+					Gateway: h.gateway.SourceObject,
 				}
 				if err := pass.ApplyHCM(ctx, pctx, httpConnectionManager); err != nil {
 					h.reporter.SetCondition(reports.ListenerCondition{
@@ -427,6 +428,27 @@ func (h *filterChainTranslator) computeTcpFilters(ctx context.Context, l ir.TcpI
 		}
 	}
 
+	// allow any tcp proxy plugins to make their changes, with the policies attached to the gateway
+	for gk, pols := range h.gateway.AttachedHttpPolicies.Policies {
+		pass := h.PluginPass[gk]
+		if pass == nil {
+			continue
+		}
+		for _, pol := range pols {
+			pctx := &ir.TcpProxyContext{
+				Policy: pol.PolicyIr,
+			}
+			if err := pass.ApplyTcpProxy(ctx, pctx, cfg); err != nil {
+				reporter.SetCondition(reports.ListenerCondition{
+					Type:    gwv1.ListenerConditionProgrammed,
+					Reason:  gwv1.ListenerReasonInvalid,
+					Status:  metav1.ConditionFalse,
+					Message: "Error processing tcp proxy plugin: " + err.Error(),
+				})
+			}
+		}
+	}
+
 	tcpFilter, _ := NewFilterWithTypedConfig(wellknown.TCPProxy, cfg)
 
 	return append(networkFilters, tcpFilter)
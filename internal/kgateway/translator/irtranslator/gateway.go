@@ -1,14 +1,21 @@
 package irtranslator
 
 import (
+	"time"
+
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"golang.org/x/net/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 )
 
@@ -26,9 +33,15 @@ type TranslationResult struct {
 
 // Translate IR to gateway. IR is self contained, so no need for krt context
 func (t *Translator) Translate(gw ir.GatewayIR, reporter reports.Reporter) TranslationResult {
+	if gw.SourceObject != nil {
+		gwNN := types.NamespacedName{Namespace: gw.SourceObject.Namespace, Name: gw.SourceObject.Name}
+		defer metrics.ObserveTranslationDuration("IRTranslator", gwNN, time.Now())
+	}
 	pass := t.newPass()
 	var res TranslationResult
 
+	reportPolicyAttachments(gw, reporter)
+
 	for _, l := range gw.Listeners {
 		// TODO: propagate errors so we can allow the retain last config mode
 		l, routes := t.ComputeListener(context.TODO(), pass, gw, l, reporter)
@@ -43,12 +56,32 @@ func (t *Translator) ComputeListener(ctx context.Context, pass TranslationPassPl
 	hasTls := false
 	gwreporter := reporter.Gateway(gw.SourceObject)
 	var routes []*envoy_config_route_v3.RouteConfiguration
+	protocol := envoy_config_core_v3.SocketAddress_TCP
+	if l.UdpProxy != nil {
+		protocol = envoy_config_core_v3.SocketAddress_UDP
+	}
 	ret := &envoy_config_listener_v3.Listener{
 		Name:    l.Name,
-		Address: computeListenerAddress(l.BindAddress, l.BindPort, gwreporter),
+		Address: computeListenerAddress(l.BindAddress, l.BindPort, protocol, gwreporter),
 	}
 	t.runListenerPlugins(ctx, pass, gw, l, ret)
 
+	// udp listeners have no filter chains, the udp proxy is a listener filter
+	if l.UdpProxy != nil {
+		udpFilter, err := udpProxyListenerFilter(l.UdpProxy)
+		if err != nil {
+			gwreporter.ListenerName(l.Name).SetCondition(reports.ListenerCondition{
+				Type:    gwv1.ListenerConditionProgrammed,
+				Reason:  gwv1.ListenerReasonInvalid,
+				Status:  metav1.ConditionFalse,
+				Message: "failed to compute udp proxy: " + err.Error(),
+			})
+			return ret, nil
+		}
+		ret.ListenerFilters = append(ret.GetListenerFilters(), udpFilter)
+		return ret, nil
+	}
+
 	for _, hfc := range l.HttpFilterChain {
 		fct := filterChainTranslator{
 			listener:        l,
@@ -0,0 +1,204 @@
+package irtranslator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+// reportPolicyAttachments initializes a policy report, with the gateway as the ancestor, for every
+// policy attached somewhere in the gateway IR. Like other reports, only negative conditions are
+// recorded here; policies without any are reported as accepted when building the status.
+func reportPolicyAttachments(gw ir.GatewayIR, reporter reports.Reporter) {
+	ancestorRef := gatewayAncestorRef(gw.SourceObject)
+	report := func(attachedPolicies ir.AttachedPolicies) {
+		reportAttachedPolicies(reporter, &ancestorRef, attachedPolicies)
+	}
+
+	// policies attached to an upstream, e.g. BackendTLSPolicy, have the gateways using it as ancestors
+	reportBackend := func(backend ir.Backend) {
+		if backend.Upstream != nil {
+			report(backend.Upstream.AttachedPolicies)
+		}
+	}
+
+	report(gw.AttachedPolicies)
+	report(gw.AttachedHttpPolicies)
+	for _, l := range gw.Listeners {
+		report(l.AttachedPolicies)
+		for _, hfc := range l.HttpFilterChain {
+			report(hfc.AttachedPolicies)
+			report(hfc.AttachedNetworkPolicies)
+			for _, vh := range hfc.Vhosts {
+				report(vh.AttachedPolicies)
+				for _, rule := range vh.Rules {
+					if rule.Parent != nil {
+						report(rule.Parent.AttachedPolicies)
+					}
+					report(rule.AttachedPolicies)
+					if rule.DelegateParent != nil {
+						report(rule.DelegateParent.AttachedPolicies)
+					}
+					for _, backend := range rule.Backends {
+						report(backend.AttachedPolicies)
+						reportBackend(backend.Backend)
+					}
+				}
+			}
+		}
+		for _, tfc := range l.TcpFilterChain {
+			for _, backend := range tfc.BackendRefs {
+				reportBackend(backend)
+			}
+		}
+		if l.UdpProxy != nil {
+			for _, backend := range l.UdpProxy.BackendRefs {
+				reportBackend(backend)
+			}
+		}
+	}
+}
+
+func reportAttachedPolicies(reporter reports.Reporter, ancestorRef *gwv1.ParentReference, attachedPolicies ir.AttachedPolicies) {
+	for _, pols := range attachedPolicies.Policies {
+		for i, pol := range pols {
+			if pol.PolicyRef == nil {
+				continue
+			}
+			ar := policyReporter(reporter, pol).AncestorRef(ancestorRef)
+			if len(pol.Errors) > 0 {
+				ar.SetCondition(reports.PolicyCondition{
+					Type:    gwv1a2.PolicyConditionAccepted,
+					Status:  metav1.ConditionFalse,
+					Reason:  gwv1a2.PolicyReasonInvalid,
+					Message: errors.Join(pol.Errors...).Error(),
+				})
+			}
+			// the policy is overridden by the newer policies, or by the older ones for kinds where the
+			// oldest policy wins
+			overriding, order := pols[i+1:], "newer"
+			if _, ok := pol.PolicyIr.(ir.OldestWinsPolicyIR); ok {
+				overriding, order = pols[:i], "older"
+			}
+			if msg := policyConflict(pol, overriding, order); msg != "" {
+				ar.SetCondition(reports.PolicyCondition{
+					Type:    gwv1a2.PolicyConditionAccepted,
+					Status:  metav1.ConditionFalse,
+					Reason:  gwv1a2.PolicyReasonConflicted,
+					Message: msg,
+				})
+			}
+		}
+	}
+}
+
+// policyConflict returns why the policy is overridden by other policies of the same kind targeting
+// the same object, or an empty string if it isn't. Policies are sorted by creation time, and applied
+// in that order, so the newest one overrides the fields also set by the older ones, unless the
+// oldest one wins; order describes the overriding policies in the message.
+func policyConflict(pol ir.PolicyAtt, overriding []ir.PolicyAtt, order string) string {
+	var msg string
+	for _, other := range overriding {
+		if other.PolicyRef == nil || *other.PolicyRef == *pol.PolicyRef || sectionName(other) != sectionName(pol) {
+			continue
+		}
+		fields, conflict := conflictingFields(pol, other)
+		switch {
+		case !conflict:
+			continue
+		case len(fields) > 0:
+			msg = fmt.Sprintf("fields %s are overridden by %s policy %s/%s targeting the same object",
+				strings.Join(fields, ", "), order, other.PolicyRef.Namespace, other.PolicyRef.Name)
+		default:
+			msg = fmt.Sprintf("conflicts with %s policy %s/%s targeting the same object", order, other.PolicyRef.Namespace, other.PolicyRef.Name)
+		}
+	}
+	return msg
+}
+
+// conflictingFields returns the fields set by both policies, besides the ones appended together.
+// Policies setting unknown fields always conflict entirely, in which case no fields are returned.
+func conflictingFields(a, b ir.PolicyAtt) ([]string, bool) {
+	if a.SetFields == nil || b.SetFields == nil {
+		return nil, true
+	}
+	bFields := sets.New(b.SetFields...)
+	if appended, ok := a.PolicyIr.(ir.AppendedFieldsPolicyIR); ok {
+		bFields.Delete(appended.AppendedFields()...)
+	}
+	var fields []string
+	for _, f := range a.SetFields {
+		if bFields.Has(f) {
+			fields = append(fields, f)
+		}
+	}
+	return fields, len(fields) > 0
+}
+
+// reportPolicyError marks the policy as not accepted by the gateway because a plugin rejected it during translation.
+func reportPolicyError(reporter reports.Reporter, gw *gwv1.Gateway, pol ir.PolicyAtt, err error) {
+	if pol.PolicyRef == nil {
+		return
+	}
+	ancestorRef := gatewayAncestorRef(gw)
+	policyReporter(reporter, pol).AncestorRef(&ancestorRef).SetCondition(reports.PolicyCondition{
+		Type:    gwv1a2.PolicyConditionAccepted,
+		Status:  metav1.ConditionFalse,
+		Reason:  gwv1a2.PolicyReasonInvalid,
+		Message: err.Error(),
+	})
+}
+
+// reportPolicyWarning marks the policy as accepted by the gateway, with the warning of a plugin
+// finding it has no effect in some context. A negative condition reported for the same gateway wins.
+func reportPolicyWarning(reporter reports.Reporter, gw *gwv1.Gateway, pol ir.PolicyAtt, warning *ir.PolicyWarning) {
+	if pol.PolicyRef == nil {
+		return
+	}
+	ancestorRef := gatewayAncestorRef(gw)
+	policyReporter(reporter, pol).AncestorRef(&ancestorRef).SetCondition(reports.PolicyCondition{
+		Type:    gwv1a2.PolicyConditionAccepted,
+		Status:  metav1.ConditionTrue,
+		Reason:  gwv1a2.PolicyReasonAccepted,
+		Message: "accepted with warning: " + warning.Error(),
+	})
+}
+
+func policyReporter(reporter reports.Reporter, pol ir.PolicyAtt) reports.PolicyReporter {
+	key := reports.PolicyKey{
+		Group: pol.PolicyRef.Group,
+		Kind:  pol.PolicyRef.Kind,
+		NamespacedName: types.NamespacedName{
+			Namespace: pol.PolicyRef.Namespace,
+			Name:      pol.PolicyRef.Name,
+		},
+	}
+	return reporter.Policy(key, pol.Generation)
+}
+
+func sectionName(pol ir.PolicyAtt) string {
+	if pol.PolicyTargetRef == nil {
+		return ""
+	}
+	return pol.PolicyTargetRef.SectionName
+}
+
+func gatewayAncestorRef(gw *gwv1.Gateway) gwv1.ParentReference {
+	return gwv1.ParentReference{
+		Group:     ptr.To(gwv1.Group(gwv1.GroupName)),
+		Kind:      ptr.To(gwv1.Kind(wellknown.GatewayKind)),
+		Namespace: ptr.To(gwv1.Namespace(gw.Namespace)),
+		Name:      gwv1.ObjectName(gw.Name),
+	}
+}
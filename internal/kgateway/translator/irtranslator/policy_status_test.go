@@ -0,0 +1,213 @@
+package irtranslator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
+)
+
+type testPolicy struct {
+	ct time.Time
+}
+
+func (p *testPolicy) CreationTime() time.Time { return p.ct }
+func (p *testPolicy) Equals(in any) bool      { return p == in }
+
+// testOldestWinsPolicy is only overridden by older policies
+type testOldestWinsPolicy struct {
+	testPolicy
+}
+
+func (p *testOldestWinsPolicy) OldestWins() {}
+
+// testAppendedPolicy has its access logs appended to the ones of the other policies
+type testAppendedPolicy struct {
+	testPolicy
+}
+
+func (p *testAppendedPolicy) AppendedFields() []string { return []string{"accessLog"} }
+
+func TestReportAttachedPolicies(t *testing.T) {
+	gk := schema.GroupKind{Group: "gateway.kgateway.dev", Kind: "RoutePolicy"}
+	att := func(name string, pol ir.PolicyIR, fields []string) ir.PolicyAtt {
+		return ir.PolicyAtt{
+			GroupKind: gk,
+			PolicyIr:  pol,
+			PolicyRef: &ir.ObjectSource{Group: gk.Group, Kind: gk.Kind, Namespace: "default", Name: name},
+			SetFields: fields,
+		}
+	}
+	accepted := func(rm reports.ReportMap, name string) *metav1.Condition {
+		key := reports.PolicyKey{Group: gk.Group, Kind: gk.Kind, NamespacedName: types.NamespacedName{Namespace: "default", Name: name}}
+		status := rm.BuildPolicyStatus(context.Background(), key, "kgateway", v1alpha1.PolicyStatus{})
+		require.Len(t, status.Ancestors, 1)
+		return meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1a2.PolicyConditionAccepted))
+	}
+	ancestorRef := &gwv1.ParentReference{Name: "gw"}
+
+	t.Run("policies setting different fields are both accepted", func(t *testing.T) {
+		rm := reports.NewReportMap()
+		reportAttachedPolicies(reports.NewReporter(&rm), ancestorRef, ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+			gk: {
+				att("older", &testPolicy{}, []string{"timeout"}),
+				att("newer", &testPolicy{}, []string{"retry"}),
+			},
+		}})
+
+		assert.Equal(t, metav1.ConditionTrue, accepted(rm, "older").Status)
+		assert.Equal(t, metav1.ConditionTrue, accepted(rm, "newer").Status)
+	})
+
+	t.Run("older policy setting the same fields is conflicted", func(t *testing.T) {
+		rm := reports.NewReportMap()
+		reportAttachedPolicies(reports.NewReporter(&rm), ancestorRef, ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+			gk: {
+				att("older", &testPolicy{}, []string{"retry", "timeout"}),
+				att("newer", &testPolicy{}, []string{"retry"}),
+			},
+		}})
+
+		cond := accepted(rm, "older")
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(gwv1a2.PolicyReasonConflicted), cond.Reason)
+		assert.Equal(t, "fields retry are overridden by newer policy default/newer targeting the same object", cond.Message)
+		assert.Equal(t, metav1.ConditionTrue, accepted(rm, "newer").Status)
+	})
+
+	t.Run("policies setting unknown fields always conflict", func(t *testing.T) {
+		rm := reports.NewReportMap()
+		reportAttachedPolicies(reports.NewReporter(&rm), ancestorRef, ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+			gk: {
+				att("older", &testPolicy{}, nil),
+				att("newer", &testPolicy{}, nil),
+			},
+		}})
+
+		assert.Equal(t, string(gwv1a2.PolicyReasonConflicted), accepted(rm, "older").Reason)
+		assert.Equal(t, metav1.ConditionTrue, accepted(rm, "newer").Status)
+	})
+
+	t.Run("policies setting appended fields are both accepted", func(t *testing.T) {
+		rm := reports.NewReportMap()
+		reportAttachedPolicies(reports.NewReporter(&rm), ancestorRef, ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+			gk: {
+				att("older", &testAppendedPolicy{}, []string{"accessLog", "tracing"}),
+				att("newer", &testAppendedPolicy{}, []string{"accessLog"}),
+			},
+		}})
+
+		assert.Equal(t, metav1.ConditionTrue, accepted(rm, "older").Status)
+		assert.Equal(t, metav1.ConditionTrue, accepted(rm, "newer").Status)
+	})
+
+	t.Run("invalid policy is not accepted", func(t *testing.T) {
+		rm := reports.NewReportMap()
+		pol := att("invalid", &testPolicy{}, nil)
+		pol.Errors = []error{errors.New("provider idp: no JWKS")}
+		reportAttachedPolicies(reports.NewReporter(&rm), ancestorRef, ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+			gk: {pol},
+		}})
+
+		cond := accepted(rm, "invalid")
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(gwv1a2.PolicyReasonInvalid), cond.Reason)
+		assert.Equal(t, "provider idp: no JWKS", cond.Message)
+	})
+
+	t.Run("newer policy of an oldest-wins kind is conflicted", func(t *testing.T) {
+		rm := reports.NewReportMap()
+		reportAttachedPolicies(reports.NewReporter(&rm), ancestorRef, ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+			gk: {
+				att("older", &testOldestWinsPolicy{}, []string{"timeout"}),
+				att("newer", &testOldestWinsPolicy{}, []string{"retry", "timeout"}),
+			},
+		}})
+
+		assert.Equal(t, metav1.ConditionTrue, accepted(rm, "older").Status)
+		cond := accepted(rm, "newer")
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(gwv1a2.PolicyReasonConflicted), cond.Reason)
+		assert.Equal(t, "fields timeout are overridden by older policy default/older targeting the same object", cond.Message)
+	})
+}
+
+// routePass applies policies to routes, returning the error of the policy
+type routePass struct {
+	ir.ProxyTranslationPass
+	errs map[ir.PolicyIR]error
+}
+
+func (p *routePass) ApplyForRoute(_ context.Context, pCtx *ir.RouteContext, _ *envoy_config_route_v3.Route) error {
+	return p.errs[pCtx.Policy]
+}
+
+func TestRoutePluginStatus(t *testing.T) {
+	gk := schema.GroupKind{Group: "gateway.kgateway.dev", Kind: "RoutePolicy"}
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw"}}
+	route := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "route"}}
+	att := func(name string, pol ir.PolicyIR) ir.PolicyAtt {
+		return ir.PolicyAtt{
+			GroupKind: gk,
+			PolicyIr:  pol,
+			PolicyRef: &ir.ObjectSource{Group: gk.Group, Kind: gk.Kind, Namespace: "default", Name: name},
+		}
+	}
+	policyAccepted := func(rm reports.ReportMap, name string) *metav1.Condition {
+		key := reports.PolicyKey{Group: gk.Group, Kind: gk.Kind, NamespacedName: types.NamespacedName{Namespace: "default", Name: name}}
+		status := rm.BuildPolicyStatus(context.Background(), key, "kgateway", v1alpha1.PolicyStatus{})
+		require.Len(t, status.Ancestors, 1)
+		return meta.FindStatusCondition(status.Ancestors[0].Conditions, string(gwv1a2.PolicyConditionAccepted))
+	}
+	run := func(rm *reports.ReportMap, errs map[ir.PolicyIR]error, pols ...ir.PolicyAtt) error {
+		reporter := reports.NewReporter(rm)
+		h := &httpRouteConfigurationTranslator{
+			gw:         ir.GatewayIR{SourceObject: gw},
+			reporter:   reporter,
+			PluginPass: TranslationPassPlugins{gk: &TranslationPass{ProxyTranslationPass: &routePass{errs: errs}}},
+		}
+		in := ir.HttpRouteRuleMatchIR{
+			Parent:           &ir.HttpRouteIR{},
+			AttachedPolicies: ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{gk: pols}},
+		}
+		parentRef := &gwv1.ParentReference{Name: "gw"}
+		return h.runRoutePlugins(context.Background(), reporter.Route(route).ParentRef(parentRef), in, &envoy_config_route_v3.Route{})
+	}
+
+	t.Run("policy with a warning is accepted and keeps the route", func(t *testing.T) {
+		rm := reports.NewReportMap()
+		pol := &testPolicy{}
+		err := run(&rm, map[ir.PolicyIR]error{pol: &ir.PolicyWarning{Message: "hash policies have no effect"}}, att("warned", pol))
+		require.NoError(t, err)
+
+		cond := policyAccepted(rm, "warned")
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, string(gwv1a2.PolicyReasonAccepted), cond.Reason)
+		assert.Equal(t, "accepted with warning: hash policies have no effect", cond.Message)
+	})
+
+	t.Run("error wins over a warning of the same policy", func(t *testing.T) {
+		rm := reports.NewReportMap()
+		pol := &testPolicy{}
+		require.NoError(t, run(&rm, map[ir.PolicyIR]error{pol: &ir.PolicyWarning{Message: "no effect"}}, att("policy", pol)))
+		require.Error(t, run(&rm, map[ir.PolicyIR]error{pol: errors.New("invalid")}, att("policy", pol)))
+
+		cond := policyAccepted(rm, "policy")
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, "invalid", cond.Message)
+	})
+}
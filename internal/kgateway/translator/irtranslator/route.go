@@ -102,7 +102,8 @@ func (h *httpRouteConfigurationTranslator) computeVirtualHost(
 		}
 		for _, pol := range pols {
 			pctx := &ir.VirtualHostContext{
-				Policy: pol.PolicyIr,
+				FilterChainName: h.fc.FilterChainName,
+				Policy:          pol.PolicyIr,
 			}
 			pass.ApplyVhostPlugin(ctx, pctx, out)
 			// TODO: check return value, if error returned, log error and report condition
@@ -172,7 +173,8 @@ func (h *httpRouteConfigurationTranslator) runVhostPlugins(ctx context.Context,
 			}
 			for _, pol := range pols {
 				pctx := &ir.VirtualHostContext{
-					Policy: pol.PolicyIr,
+					FilterChainName: h.fc.FilterChainName,
+					Policy:          pol.PolicyIr,
 				}
 				pass.ApplyVhostPlugin(ctx, pctx, out)
 				// TODO: check return value, if error returned, log error and report condition
@@ -207,14 +209,19 @@ func (h *httpRouteConfigurationTranslator) runRoutePlugins(ctx context.Context,
 			}
 			for _, pol := range pols {
 				pctx := &ir.RouteContext{
-					Policy: pol.PolicyIr,
-					In:     in,
+					FilterChainName: h.fc.FilterChainName,
+					Policy:          pol.PolicyIr,
+					In:              in,
 				}
 				err := pass.ApplyForRoute(ctx, pctx, out)
-				if err != nil {
+				var warning *ir.PolicyWarning
+				switch {
+				case errors.As(err, &warning):
+					reportPolicyWarning(h.reporter, h.gw.SourceObject, pol, warning)
+				case err != nil:
+					reportPolicyError(h.reporter, h.gw.SourceObject, pol, err)
 					errs = append(errs, err)
 				}
-				// TODO: check return value, if error returned, log error and report condition
 			}
 		}
 	}
@@ -243,8 +250,8 @@ func (h *httpRouteConfigurationTranslator) runBackendPolicies(ctx context.Contex
 			err := pass.ApplyForRouteBackend(ctx, pol.PolicyIr, pCtx)
 			if err != nil {
 				errs = append(errs, err)
+				reportPolicyError(h.reporter, h.gw.SourceObject, pol, err)
 			}
-			// TODO: check return value, if error returned, log error and report condition
 		}
 	}
 	return errors.Join(errs...)
@@ -0,0 +1,165 @@
+package irtranslator
+
+import (
+	"errors"
+	"slices"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	udpproxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	networkinputs "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	runtimefraction "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/runtime_fraction/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const (
+	UdpProxyFilterName = "envoy.filters.udp_listener.udp_proxy"
+
+	udpRouteActionName       = "route"
+	sourceIpInputName        = "envoy.matching.inputs.source_ip"
+	runtimeFractionMatchName = "envoy.matching.matchers.runtime_fraction"
+
+	// denominator used when splitting traffic between weighted backends
+	udpWeightDenominator = 10000
+)
+
+// udpProxyListenerFilter computes the udp_proxy listener filter for a UDP listener.
+func udpProxyListenerFilter(u *ir.UdpIR) (*envoy_config_listener_v3.ListenerFilter, error) {
+	matcher, err := udpRouteMatcher(u.BackendRefs)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &udpproxy.UdpProxyConfig{
+		StatPrefix: u.Name,
+		RouteSpecifier: &udpproxy.UdpProxyConfig_Matcher{
+			Matcher: matcher,
+		},
+	}
+	msg, err := anypb.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_listener_v3.ListenerFilter{
+		Name: UdpProxyFilterName,
+		ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
+			TypedConfig: msg,
+		},
+	}, nil
+}
+
+// udpRouteMatcher builds the udp_proxy route matcher. The udp_proxy can only route a session to a
+// single cluster, so weighted backends are selected by hashing the downstream source ip: every
+// predicate hashes with the same seed, and matches if the hash falls below the cumulative weight of
+// its backend. The last backend takes the remainder as the no-match action. This also keeps all
+// datagrams of a client on the same backend. Backends with a weight of 0 receive no traffic.
+func udpRouteMatcher(backends []ir.Backend) (*xdsmatcherv3.Matcher, error) {
+	if len(backends) == 0 {
+		return nil, nil
+	}
+
+	// the weight of a backendRef defaults to 1 when the IR is built, so 0 is always explicit
+	backends = slices.DeleteFunc(slices.Clone(backends), func(b ir.Backend) bool {
+		return b.Weight == 0
+	})
+	if len(backends) == 0 {
+		return nil, errors.New("all backends have a weight of 0")
+	}
+
+	var total uint64
+	for _, b := range backends {
+		total += uint64(b.Weight)
+	}
+
+	var (
+		matchers   []*xdsmatcherv3.Matcher_MatcherList_FieldMatcher
+		cumulative uint64
+	)
+	for _, b := range backends[:len(backends)-1] {
+		cumulative += uint64(b.Weight)
+		predicate, err := sourceIpFractionPredicate(uint32(cumulative * udpWeightDenominator / total))
+		if err != nil {
+			return nil, err
+		}
+		action, err := udpRouteAction(b.ClusterName)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, &xdsmatcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: predicate,
+			OnMatch:   action,
+		})
+	}
+
+	onNoMatch, err := udpRouteAction(backends[len(backends)-1].ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	matcher := &xdsmatcherv3.Matcher{
+		OnNoMatch: onNoMatch,
+	}
+	if len(matchers) > 0 {
+		matcher.MatcherType = &xdsmatcherv3.Matcher_MatcherList_{
+			MatcherList: &xdsmatcherv3.Matcher_MatcherList{
+				Matchers: matchers,
+			},
+		}
+	}
+	return matcher, nil
+}
+
+func sourceIpFractionPredicate(numerator uint32) (*xdsmatcherv3.Matcher_MatcherList_Predicate, error) {
+	input, err := typedExtensionConfig(sourceIpInputName, &networkinputs.SourceIPInput{})
+	if err != nil {
+		return nil, err
+	}
+	fraction, err := typedExtensionConfig(runtimeFractionMatchName, &runtimefraction.RuntimeFraction{
+		RuntimeFraction: &envoy_config_core_v3.RuntimeFractionalPercent{
+			DefaultValue: &envoytype.FractionalPercent{
+				Numerator:   numerator,
+				Denominator: envoytype.FractionalPercent_TEN_THOUSAND,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &xdsmatcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: input,
+				Matcher: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+					CustomMatch: fraction,
+				},
+			},
+		},
+	}, nil
+}
+
+func udpRouteAction(cluster string) (*xdsmatcherv3.Matcher_OnMatch, error) {
+	action, err := typedExtensionConfig(udpRouteActionName, &udpproxy.Route{Cluster: cluster})
+	if err != nil {
+		return nil, err
+	}
+	return &xdsmatcherv3.Matcher_OnMatch{
+		OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+			Action: action,
+		},
+	}, nil
+}
+
+func typedExtensionConfig(name string, config proto.Message) (*xdscorev3.TypedExtensionConfig, error) {
+	msg, err := anypb.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &xdscorev3.TypedExtensionConfig{
+		Name:        name,
+		TypedConfig: msg,
+	}, nil
+}
@@ -3,6 +3,7 @@ package irtranslator
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
@@ -14,6 +15,7 @@ import (
 
 	extensionsplug "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugin"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
 )
 
 var (
@@ -45,14 +47,27 @@ func (t *UpstreamTranslator) TranslateUpstream(
 
 	out := initializeCluster(u)
 
-	process.InitUpstream(context.TODO(), u, out)
+	// errors of the upstream are returned along with its cluster, which is then not sent to the proxies
+	errs := slices.Clone(u.Errors)
+	if err := process.InitUpstream(context.TODO(), u, out); err != nil {
+		errs = append(errs, err)
+	}
+
+	if u.AppProtocol == ir.HTTP2AppProtocol {
+		if err := utils.SetHttp2options(out); err != nil {
+			return nil, err
+		}
+	}
 
 	// now process upstream policies:
-	t.runPlugins(kctx, context.TODO(), ucc, u, out)
-	return out, nil
+	if err := t.runPlugins(kctx, context.TODO(), ucc, u, out); err != nil {
+		errs = append(errs, err)
+	}
+	return out, errors.Join(errs...)
 }
 
-func (t *UpstreamTranslator) runPlugins(kctx krt.HandlerContext, ctx context.Context, ucc ir.UniqlyConnectedClient, u ir.Upstream, out *envoy_config_cluster_v3.Cluster) {
+func (t *UpstreamTranslator) runPlugins(kctx krt.HandlerContext, ctx context.Context, ucc ir.UniqlyConnectedClient, u ir.Upstream, out *envoy_config_cluster_v3.Cluster) error {
+	var errs []error
 	for gk, polImpl := range t.ContributedPolicies {
 		// TODO: in theory it would be nice to do `ProcessUpstream` once, and only do
 		// the the per-client processing for each client.
@@ -67,9 +82,12 @@ func (t *UpstreamTranslator) runPlugins(kctx krt.HandlerContext, ctx context.Con
 			continue
 		}
 		for _, pol := range u.AttachedPolicies.Policies[gk] {
-			polImpl.ProcessUpstream(ctx, pol.PolicyIr, u, out)
+			if err := polImpl.ProcessUpstream(ctx, pol.PolicyIr, u, out); err != nil {
+				errs = append(errs, err)
+			}
 		}
 	}
+	return errors.Join(errs...)
 }
 
 func initializeCluster(u ir.Upstream) *envoy_config_cluster_v3.Cluster {
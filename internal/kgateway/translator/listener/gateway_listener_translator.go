@@ -38,6 +38,7 @@ func TranslateListeners(
 	reporter reports.Reporter,
 ) []ir.ListenerIR {
 	validatedListeners := validateListeners(gateway, reporter.Gateway(gateway.Obj))
+	validatedListeners = append(validatedListeners, validateListenerSets(gateway, validatedListeners, reporter)...)
 
 	mergedListeners := mergeGWListeners(queries, gateway.Namespace, validatedListeners, *gateway, routesForGw, reporter.Gateway(gateway.Obj))
 	translatedListeners := mergedListeners.translateListeners(kctx, ctx, queries, reporter)
@@ -94,6 +95,10 @@ func (ml *MergedListeners) AppendListener(
 	// TODO default handling
 	case gwv1.TCPProtocolType:
 		ml.AppendTcpListener(listener, routes, reporter)
+	case gwv1.TLSProtocolType:
+		ml.appendTlsListener(listener, routes, reporter)
+	case gwv1.UDPProtocolType:
+		ml.appendUdpListener(listener, routes, reporter)
 	default:
 		return eris.Errorf("unsupported protocol: %v", listener.Protocol)
 	}
@@ -120,7 +125,7 @@ func (ml *MergedListeners) appendHttpListener(
 	finalPort := gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port)))
 
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpFilterChain == nil {
 			// concatenate the names on the parent output listener/filterchain
 			// TODO is this valid listener name?
 			// TODO: listener name should include the bind address and port (otherwise envoy goes crazy if they change)
@@ -154,6 +159,7 @@ func (ml *MergedListeners) appendHttpsListener(
 	// protocol:            listener.Protocol,
 	mfc := httpsFilterChain{
 		gatewayListenerName: string(listener.Name),
+		listenerSet:         listener.ListenerSet,
 		sniDomain:           listener.Hostname,
 		tls:                 listener.TLS,
 		routesWithHosts:     routesWithHosts,
@@ -166,7 +172,7 @@ func (ml *MergedListeners) appendHttpsListener(
 
 	listenerName := string(listener.Name)
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpFilterChain == nil {
 			// concatenate the names on the parent output listener
 			// TODO is this valid listener name?
 			lis.name += "~" + listenerName
@@ -227,7 +233,7 @@ func (ml *MergedListeners) AppendTcpListener(
 	finalPort := gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port)))
 
 	for _, lis := range ml.Listeners {
-		if lis.port == finalPort {
+		if lis.port == finalPort && lis.udpFilterChain == nil {
 			// concatenate the names on the parent output listener
 			lis.name += "~" + listenerName
 			lis.TcpFilterChains = append(lis.TcpFilterChains, fc)
@@ -246,6 +252,107 @@ func (ml *MergedListeners) AppendTcpListener(
 	})
 }
 
+func (ml *MergedListeners) appendTlsListener(
+	listener ir.Listener,
+	routeInfos []*query.RouteInfo,
+	reporter reports.ListenerReporter,
+) {
+	var validRouteInfos []*query.RouteInfo
+	for _, routeInfo := range routeInfos {
+		if _, ok := routeInfo.Object.(*ir.TlsRouteIR); !ok {
+			continue
+		}
+		validRouteInfos = append(validRouteInfos, routeInfo)
+	}
+
+	// If no valid routes are found, do not create a listener
+	if len(validRouteInfos) == 0 {
+		contextutils.LoggerFrom(context.Background()).Errorf(
+			"No valid routes found for listener %s", listener.Name,
+		)
+		return
+	}
+
+	fc := tlsFilterChain{
+		gatewayListenerName: string(listener.Name),
+		listenerSet:         listener.ListenerSet,
+		sniDomain:           listener.Hostname,
+		tls:                 listener.TLS,
+		routesWithHosts:     validRouteInfos,
+		listenerReporter:    reporter,
+	}
+
+	// Perform the port transformation away from privileged ports only once to use
+	// during both lookup and when appending the listener.
+	finalPort := gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port)))
+
+	listenerName := string(listener.Name)
+	for _, lis := range ml.Listeners {
+		if lis.port == finalPort && lis.udpFilterChain == nil {
+			// concatenate the names on the parent output listener
+			lis.name += "~" + listenerName
+			lis.tlsFilterChains = append(lis.tlsFilterChains, fc)
+			return
+		}
+	}
+	ml.Listeners = append(ml.Listeners, &MergedListener{
+		name:             listenerName,
+		gatewayNamespace: ml.GatewayNamespace,
+		port:             finalPort,
+		tlsFilterChains:  []tlsFilterChain{fc},
+		listenerReporter: reporter,
+		listener:         listener,
+	})
+}
+
+func (ml *MergedListeners) appendUdpListener(
+	listener ir.Listener,
+	routeInfos []*query.RouteInfo,
+	reporter reports.ListenerReporter,
+) {
+	var validRouteInfos []*query.RouteInfo
+	for _, routeInfo := range routeInfos {
+		if _, ok := routeInfo.Object.(*ir.UdpRouteIR); !ok {
+			continue
+		}
+		validRouteInfos = append(validRouteInfos, routeInfo)
+	}
+
+	// If no valid routes are found, do not create a listener
+	if len(validRouteInfos) == 0 {
+		contextutils.LoggerFrom(context.Background()).Errorf(
+			"No valid routes found for listener %s", listener.Name,
+		)
+		return
+	}
+
+	listenerName := string(listener.Name)
+	finalPort := gwv1.PortNumber(ports.TranslatePort(uint16(listener.Port)))
+
+	// UDP listeners have no filter chains, so they can't be merged with other UDP listeners on the same
+	// port. Listener validation already reports a conflict for these, so this should not happen.
+	for _, lis := range ml.Listeners {
+		if lis.port == finalPort && lis.udpFilterChain != nil {
+			contextutils.LoggerFrom(context.Background()).Errorf(
+				"UDP listener %s can't share port %d with listener %s", listenerName, finalPort, lis.name,
+			)
+			return
+		}
+	}
+
+	ml.Listeners = append(ml.Listeners, &MergedListener{
+		name:             listenerName,
+		gatewayNamespace: ml.GatewayNamespace,
+		port:             finalPort,
+		udpFilterChain: &udpFilterChain{
+			gatewayListenerName: listenerName,
+			routesWithHosts:     validRouteInfos,
+		},
+		listenerReporter: reporter,
+		listener:         listener,
+	})
+}
+
 func (ml *MergedListeners) translateListeners(
 	kctx krt.HandlerContext,
 	ctx context.Context,
@@ -255,6 +362,11 @@ func (ml *MergedListeners) translateListeners(
 	var listeners []ir.ListenerIR
 	for _, mergedListener := range ml.Listeners {
 		listener := mergedListener.TranslateListener(kctx, ctx, queries, reporter)
+		if mergedListener.udpFilterChain != nil && listener.UdpProxy == nil {
+			// the route of the udp listener was not accepted; without a udp proxy the listener would
+			// be translated to an empty tcp listener, conflicting with tcp listeners on the same port
+			continue
+		}
 
 		// run listener plugins
 		//		panic("TODO: handle listener policy attachment")
@@ -280,6 +392,8 @@ type MergedListener struct {
 	httpFilterChain   *httpFilterChain
 	httpsFilterChains []httpsFilterChain
 	TcpFilterChains   []tcpFilterChain
+	tlsFilterChains   []tlsFilterChain
+	udpFilterChain    *udpFilterChain
 	listenerReporter  reports.ListenerReporter
 	listener          ir.Listener
 
@@ -358,6 +472,23 @@ func (ml *MergedListener) TranslateListener(
 		}
 	}
 
+	// Translate TLS filter chains, one TCP filter chain per SNI route
+	for _, tfc := range ml.tlsFilterChains {
+		matchedTcpListeners = append(matchedTcpListeners, tfc.translateTlsFilterChains(
+			kctx,
+			ctx,
+			ml.gatewayNamespace,
+			queries,
+			reporter,
+		)...)
+	}
+
+	// Translate the UDP proxy (if this is a UDP listener)
+	var udpProxy *ir.UdpIR
+	if ml.udpFilterChain != nil {
+		udpProxy = ml.udpFilterChain.translateUdpFilterChain(reporter)
+	}
+
 	// Create and return the listener with all filter chains and TCP listeners
 	//	panic("TODO: handle listener policy attachment")
 	return ir.ListenerIR{
@@ -367,6 +498,7 @@ func (ml *MergedListener) TranslateListener(
 		AttachedPolicies: ir.AttachedPolicies{}, // TODO: find policies attached to listener and attach them <- this might not be possilbe due to listener merging. also a gw listener ~= envoy filter chain; and i don't believe we need policies there
 		HttpFilterChain:  httpFilterChains,
 		TcpFilterChain:   matchedTcpListeners,
+		UdpProxy:         udpProxy,
 	}
 }
 
@@ -466,6 +598,242 @@ func (tc *tcpFilterChain) translateTcpFilterChain(_ ir.Listener, reporter report
 	}
 }
 
+// udpFilterChain represents a Gateway listener with the UDP protocol. UDP listeners don't have
+// filter chains in envoy; the udp proxy is configured as a listener filter.
+type udpFilterChain struct {
+	gatewayListenerName string
+	routesWithHosts     []*query.RouteInfo
+}
+
+func (uc *udpFilterChain) translateUdpFilterChain(reporter reports.Reporter) *ir.UdpIR {
+	if len(uc.routesWithHosts) == 0 {
+		return nil
+	}
+
+	// Only one route per listener is supported, the oldest route wins, then the first by namespace and name
+	r := slices.MinFunc(uc.routesWithHosts, func(a, b *query.RouteInfo) int {
+		aObj, bObj := a.Object.GetSourceObject(), b.Object.GetSourceObject()
+		if c := aObj.GetCreationTimestamp().Compare(bObj.GetCreationTimestamp().Time); c != 0 {
+			return c
+		}
+		if c := strings.Compare(aObj.GetNamespace(), bObj.GetNamespace()); c != 0 {
+			return c
+		}
+		return strings.Compare(aObj.GetName(), bObj.GetName())
+	})
+	for _, other := range uc.routesWithHosts {
+		if other == r {
+			continue
+		}
+		reporter.Route(other.Object.GetSourceObject()).ParentRef(&other.ParentRef).SetCondition(reports.RouteCondition{
+			Type:    gwv1.RouteConditionAccepted,
+			Status:  metav1.ConditionFalse,
+			Reason:  reports.RouteReasonConflicted,
+			Message: fmt.Sprintf("listener %s only supports one route, and the older route %s/%s is already attached", uc.gatewayListenerName, r.Object.GetNamespace(), r.Object.GetName()),
+		})
+	}
+
+	uRoute, ok := r.Object.(*ir.UdpRouteIR)
+	if !ok {
+		return nil
+	}
+
+	parentRefReporter := reporter.Route(uRoute.SourceObject).ParentRef(&r.ParentRef)
+	if len(uRoute.SourceObject.Spec.Rules) != 1 {
+		parentRefReporter.SetCondition(reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionFalse,
+			Reason: gwv1.RouteReasonUnsupportedValue,
+		})
+		return nil
+	}
+	parentRefReporter.SetCondition(reports.RouteCondition{
+		Type:   gwv1.RouteConditionAccepted,
+		Status: metav1.ConditionTrue,
+		Reason: gwv1.RouteReasonAccepted,
+	})
+
+	for _, backend := range uRoute.Backends {
+		// validate that we don't have an error:
+		if backend.Err != nil || backend.Upstream == nil {
+			err := backend.Err
+			if err == nil {
+				err = errors.New("not found")
+			}
+			query.ProcessBackendError(err, parentRefReporter)
+		}
+	}
+	if len(uRoute.Backends) == 0 {
+		return nil
+	}
+
+	return &ir.UdpIR{
+		Name: fmt.Sprintf("%s.%s-rule-%d", uRoute.Namespace, uRoute.Name, 0),
+		// add backends even if we have errors, as according to spec, with multiple destinations,
+		// they should fail based of the weights.
+		BackendRefs: uRoute.Backends,
+	}
+}
+
+// tlsFilterChain represents a Gateway listener with the TLS protocol. Each attached TLSRoute is
+// translated into its own TCP filter chain, matched on the SNI hostnames of the route.
+type tlsFilterChain struct {
+	gatewayListenerName string
+	listenerSet         *ir.ObjectSource
+	sniDomain           *gwv1.Hostname
+	tls                 *gwv1.GatewayTLSConfig
+	routesWithHosts     []*query.RouteInfo
+	listenerReporter    reports.ListenerReporter
+}
+
+func (tc *tlsFilterChain) translateTlsFilterChains(
+	kctx krt.HandlerContext,
+	ctx context.Context,
+	gatewayNamespace string,
+	queries query.GatewayQueries,
+	reporter reports.Reporter,
+) []ir.TcpIR {
+	// In Terminate mode the listener holds the certificate; in Passthrough mode (the default
+	// for TLS listeners) the connection is proxied as is and sslConfig stays nil.
+	sslConfig, err := translateSslConfig(
+		kctx,
+		ctx,
+		gatewayNamespace,
+		tc.listenerSet,
+		tc.tls,
+		queries,
+	)
+	if err != nil {
+		reason := gwv1.ListenerReasonRefNotPermitted
+		if !errors.Is(err, krtcollections.ErrMissingReferenceGrant) {
+			reason = gwv1.ListenerReasonInvalidCertificateRef
+		}
+		tc.listenerReporter.SetCondition(reports.ListenerCondition{
+			Type:   gwv1.ListenerConditionResolvedRefs,
+			Status: metav1.ConditionFalse,
+			Reason: reason,
+		})
+		// listener with no ssl is invalid. We return nil so set programmed to false
+		tc.listenerReporter.SetCondition(reports.ListenerCondition{
+			Type:   gwv1.ListenerConditionProgrammed,
+			Status: metav1.ConditionFalse,
+			Reason: gwv1.ListenerReasonInvalid,
+		})
+		return nil
+	}
+
+	// The oldest route wins when multiple routes claim the same SNI hostname.
+	routes := slices.Clone(tc.routesWithHosts)
+	slices.SortStableFunc(routes, func(a, b *query.RouteInfo) int {
+		aObj, bObj := a.Object.GetSourceObject(), b.Object.GetSourceObject()
+		if c := aObj.GetCreationTimestamp().Compare(bObj.GetCreationTimestamp().Time); c != 0 {
+			return c
+		}
+		if c := strings.Compare(aObj.GetNamespace(), bObj.GetNamespace()); c != 0 {
+			return c
+		}
+		return strings.Compare(aObj.GetName(), bObj.GetName())
+	})
+
+	var (
+		claimedSniDomains = map[string]bool{}
+		filterChains      []ir.TcpIR
+	)
+	for _, routeInfo := range routes {
+		tRoute, ok := routeInfo.Object.(*ir.TlsRouteIR)
+		if !ok {
+			continue
+		}
+		parentRefReporter := reporter.Route(tRoute.SourceObject).ParentRef(&routeInfo.ParentRef)
+
+		if len(tRoute.SourceObject.Spec.Rules) != 1 {
+			// Only a single rule per TLSRoute is supported
+			parentRefReporter.SetCondition(reports.RouteCondition{
+				Type:   gwv1.RouteConditionAccepted,
+				Status: metav1.ConditionFalse,
+				Reason: gwv1.RouteReasonUnsupportedValue,
+			})
+			continue
+		}
+		hostnames := routeInfo.Hostnames()
+		if len(hostnames) == 0 && tc.sniDomain != nil {
+			hostnames = []string{string(*tc.sniDomain)}
+		}
+		var (
+			sniDomains []string
+			conflicts  []string
+		)
+		if len(hostnames) == 0 {
+			// a route without hostnames on a listener without hostname matches any SNI
+			if claimedSniDomains["*"] {
+				parentRefReporter.SetCondition(reports.RouteCondition{
+					Type:    gwv1.RouteConditionAccepted,
+					Status:  metav1.ConditionFalse,
+					Reason:  reports.RouteReasonConflicted,
+					Message: fmt.Sprintf("an older route without hostnames is already attached to listener %s", tc.gatewayListenerName),
+				})
+				continue
+			}
+			claimedSniDomains["*"] = true
+		}
+		for _, host := range hostnames {
+			if claimedSniDomains[host] {
+				conflicts = append(conflicts, host)
+				continue
+			}
+			claimedSniDomains[host] = true
+			sniDomains = append(sniDomains, host)
+		}
+		if len(hostnames) > 0 && len(sniDomains) == 0 {
+			parentRefReporter.SetCondition(reports.RouteCondition{
+				Type:    gwv1.RouteConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  reports.RouteReasonConflicted,
+				Message: fmt.Sprintf("hostnames %s are already claimed by older routes on listener %s", strings.Join(conflicts, ", "), tc.gatewayListenerName),
+			})
+			continue
+		}
+
+		accepted := reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.RouteReasonAccepted,
+		}
+		if len(conflicts) > 0 {
+			accepted.Message = fmt.Sprintf("hostnames %s are ignored, as they are already claimed by older routes on listener %s", strings.Join(conflicts, ", "), tc.gatewayListenerName)
+		}
+		parentRefReporter.SetCondition(accepted)
+
+		for _, backend := range tRoute.Backends {
+			// validate that we don't have an error:
+			if backend.Err != nil || backend.Upstream == nil {
+				err := backend.Err
+				if err == nil {
+					err = errors.New("not found")
+				}
+				query.ProcessBackendError(err, parentRefReporter)
+			}
+		}
+		if len(tRoute.Backends) == 0 {
+			continue
+		}
+
+		filterChains = append(filterChains, ir.TcpIR{
+			FilterChainCommon: ir.FilterChainCommon{
+				FilterChainName: fmt.Sprintf("%s~%s.%s", tc.gatewayListenerName, tRoute.Namespace, tRoute.Name),
+				Matcher: ir.FilterChainMatch{
+					SniDomains: sniDomains,
+				},
+				TLS: sslConfig,
+			},
+			// add backends even if we have errors, as according to spec, with multiple destinations,
+			// they should fail based of the weights.
+			BackendRefs: tRoute.Backends,
+		})
+	}
+	return filterChains
+}
+
 // httpFilterChain each one represents a GW Listener that has been merged into a single Gloo Listener (with distinct filter chains).
 // In the case where no GW Listener merging takes place, every listener will use a Gloo AggregatedListeener with 1 HTTP filter chain.
 type httpFilterChain struct {
@@ -559,6 +927,7 @@ func (httpFilterChain *httpFilterChain) translateHttpFilterChain(
 
 type httpsFilterChain struct {
 	gatewayListenerName string
+	listenerSet         *ir.ObjectSource
 	sniDomain           *gwv1.Hostname
 	tls                 *gwv1.GatewayTLSConfig
 	routesWithHosts     []*query.RouteInfo
@@ -613,6 +982,7 @@ func (httpsFilterChain *httpsFilterChain) translateHttpsFilterChain(
 		kctx,
 		ctx,
 		gatewayNamespace,
+		httpsFilterChain.listenerSet,
 		httpsFilterChain.tls,
 		queries,
 	)
@@ -685,6 +1055,7 @@ func translateSslConfig(
 	kctx krt.HandlerContext,
 	ctx context.Context,
 	parentNamespace string,
+	listenerSet *ir.ObjectSource,
 	tls *gwv1.GatewayTLSConfig,
 	queries query.GatewayQueries,
 ) (*ir.TlsBundle, error) {
@@ -692,20 +1063,26 @@ func translateSslConfig(
 		return nil, nil
 	}
 
-	// TODO support passthrough mode
+	// nothing to terminate in passthrough mode
 	if tls.Mode == nil ||
 		*tls.Mode != gwv1.TLSModeTerminate {
 		return nil, nil
 	}
 
+	// the certificates of the listeners of an XListenerSet are referenced from the XListenerSet
+	fromGk := schema.GroupKind{
+		Group: gwv1.GroupName,
+		Kind:  "Gateway",
+	}
+	fromNs := parentNamespace
+	if listenerSet != nil {
+		fromGk = listenerSet.GetGroupKind()
+		fromNs = listenerSet.Namespace
+	}
+
 	for _, certRef := range tls.CertificateRefs {
 		// validate via query
-		secret, err := queries.GetSecretForRef(kctx, ctx, schema.GroupKind{
-			Group: gwv1.GroupName,
-			Kind:  "Gateway",
-		},
-			parentNamespace,
-			certRef)
+		secret, err := queries.GetSecretForRef(kctx, ctx, fromGk, fromNs, certRef)
 		if err != nil {
 			return nil, err
 		}
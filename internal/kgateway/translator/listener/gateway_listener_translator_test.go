@@ -567,3 +567,86 @@ func tcpRoute(name, ns string) *gwv1a2.TCPRoute {
 		},
 	}
 }
+
+func udpRoute(name string, creationTimestamp metav1.Time) *ir.UdpRouteIR {
+	route := &gwv1a2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: creationTimestamp,
+		},
+		Spec: gwv1a2.UDPRouteSpec{
+			Rules: []gwv1a2.UDPRouteRule{{}},
+		},
+	}
+	return &ir.UdpRouteIR{
+		ObjectSource: ir.ObjectSource{
+			Namespace: route.Namespace,
+			Name:      route.Name,
+			Kind:      wellknown.UDPRouteKind,
+			Group:     gwv1.GroupVersion.Group,
+		},
+		SourceObject: route,
+		Backends: []ir.Backend{{
+			ClusterName: "backend-svc",
+			Upstream:    &ir.Upstream{},
+			Weight:      1,
+		}},
+	}
+}
+
+var _ = Describe("Translator UDPRoute Listener", func() {
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		gwListener = gwv1.Listener{
+			Name:     "foo-udp",
+			Protocol: gwv1.UDPProtocolType,
+			Port:     5353,
+		}
+
+		gateway = &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-gateway", Namespace: "default"},
+		}
+
+		rm := reports.NewReportMap()
+		reporter = reports.NewReporter(&rm)
+		listenerReporter = reporter.Gateway(gateway).Listener(&gwListener)
+		ml = &listener.MergedListeners{
+			Listeners:        []*listener.MergedListener{},
+			GatewayNamespace: "default",
+		}
+	})
+
+	It("should attach the first route by name when the routes have the same age", func() {
+		created := metav1.Now()
+		routes := []*query.RouteInfo{
+			{Object: udpRoute("b-route", created)},
+			{Object: udpRoute("a-route", created)},
+		}
+
+		ml.AppendListener(lisToIr(gwListener), routes, listenerReporter)
+		Expect(ml.Listeners).To(HaveLen(1))
+
+		translatedListener := ml.Listeners[0].TranslateListener(krt.TestingDummyContext{}, ctx, nil, reporter)
+		Expect(translatedListener.UdpProxy).NotTo(BeNil())
+		Expect(translatedListener.UdpProxy.Name).To(Equal("default.a-route-rule-0"))
+	})
+
+	It("should not merge a TCP listener into a UDP listener on the same port", func() {
+		ml.AppendListener(lisToIr(gwListener), []*query.RouteInfo{{Object: udpRoute("udp-route", metav1.Now())}}, listenerReporter)
+
+		tcpListener := gwv1.Listener{
+			Name:     "foo-tcp",
+			Protocol: gwv1.TCPProtocolType,
+			Port:     5353,
+		}
+		tcpRoute := tcpRoute("tcp-route", "default")
+		tcpRoute.Spec.ParentRefs = []gwv1.ParentReference{{Name: "test-gateway"}}
+		ml.AppendListener(lisToIr(tcpListener), []*query.RouteInfo{{Object: tcpToIr(tcpRoute)}}, reporter.Gateway(gateway).Listener(&tcpListener))
+
+		Expect(ml.Listeners).To(HaveLen(2))
+		Expect(ml.Listeners[0].TcpFilterChains).To(BeEmpty())
+		Expect(ml.Listeners[1].TcpFilterChains).To(HaveLen(1))
+	})
+})
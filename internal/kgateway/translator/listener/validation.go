@@ -1,11 +1,13 @@
 package listener
 
 import (
+	"fmt"
 	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
@@ -21,21 +23,35 @@ type portProtocol struct {
 	listeners []ir.Listener
 }
 
+// listenerPort is the port bound by a listener. The transport protocol is part of the port, so
+// TCP and UDP listeners can share a port number.
+type listenerPort struct {
+	port gwv1.PortNumber
+	udp  bool
+}
+
+func portOf(listener ir.Listener) listenerPort {
+	return listenerPort{port: listener.Port, udp: listener.Protocol == gwv1.UDPProtocolType}
+}
+
 type protocol = string
 type groupName = string
 type routeKind = string
 
 func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
-	// we currently only support HTTPRoute on HTTP and HTTPS protocols
+	// we currently support HTTPRoute and GRPCRoute on HTTP and HTTPS protocols, TCPRoute on TCP,
+	// TLSRoute on TLS and UDPRoute on UDP
 	supportedProtocolToKinds := map[protocol]map[groupName][]routeKind{
 		string(gwv1.HTTPProtocolType): {
 			gwv1.GroupName: []string{
 				wellknown.HTTPRouteKind,
+				wellknown.GRPCRouteKind,
 			},
 		},
 		string(gwv1.HTTPSProtocolType): {
 			gwv1.GroupName: []string{
 				wellknown.HTTPRouteKind,
+				wellknown.GRPCRouteKind,
 			},
 		},
 		string(gwv1.TCPProtocolType): {
@@ -43,6 +59,16 @@ func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
 				wellknown.TCPRouteKind,
 			},
 		},
+		string(gwv1.TLSProtocolType): {
+			gwv1.GroupName: []string{
+				wellknown.TLSRouteKind,
+			},
+		},
+		string(gwv1.UDPProtocolType): {
+			gwv1.GroupName: []string{
+				wellknown.UDPRouteKind,
+			},
+		},
 	}
 	return supportedProtocolToKinds
 }
@@ -122,45 +148,36 @@ func validateListeners(gw *ir.Gateway, reporter reports.GatewayReporter) []ir.Li
 
 	validListeners := validateSupportedRoutes(gw.Listeners, reporter)
 
-	portListeners := map[gwv1.PortNumber]*portProtocol{}
+	portListeners := map[listenerPort]*portProtocol{}
+	// ports in the order of their listeners, so the valid listeners keep their order
+	var ports []listenerPort
 	for _, listener := range validListeners {
-		protocol := listener.Protocol
-		if protocol == gwv1.HTTPSProtocolType || protocol == gwv1.TLSProtocolType {
-			protocol = NormalizedHTTPSTLSType
-		}
+		protocol := normalizedProtocol(listener.Protocol)
 
-		if existingListener, ok := portListeners[listener.Port]; ok {
+		if existingListener, ok := portListeners[portOf(listener)]; ok {
 			existingListener.protocol[protocol] = true
 			existingListener.listeners = append(existingListener.listeners, listener)
 			//TODO(Law): handle validation that hostname empty for udp/tcp
-			if listener.Hostname != nil {
-				existingListener.hostnames[*listener.Hostname]++
-			} else {
-				existingListener.hostnames[DefaultHostname]++
-			}
+			existingListener.hostnames[listenerHostname(listener)]++
 		} else {
-			var hostname gwv1.Hostname
-			if listener.Hostname == nil {
-				hostname = DefaultHostname
-			} else {
-				hostname = *listener.Hostname
-			}
 			pp := portProtocol{
 				hostnames: map[gwv1.Hostname]int{
-					hostname: 1,
+					listenerHostname(listener): 1,
 				},
 				protocol: map[gwv1.ProtocolType]bool{
 					protocol: true,
 				},
 				listeners: []ir.Listener{listener},
 			}
-			portListeners[listener.Port] = &pp
+			portListeners[portOf(listener)] = &pp
+			ports = append(ports, portOf(listener))
 		}
 	}
 
 	// reset valid listeners
 	validListeners = []ir.Listener{}
-	for _, pp := range portListeners {
+	for _, port := range ports {
+		pp := portListeners[port]
 		protocolConflict := false
 		if len(pp.protocol) > 1 {
 			protocolConflict = true
@@ -179,13 +196,7 @@ func validateListeners(gw *ir.Gateway, reporter reports.GatewayReporter) []ir.Li
 				continue
 			}
 
-			var hostname gwv1.Hostname
-			if listener.Hostname == nil {
-				hostname = DefaultHostname
-			} else {
-				hostname = *listener.Hostname
-			}
-			if count := pp.hostnames[hostname]; count > 1 {
+			if count := pp.hostnames[listenerHostname(listener)]; count > 1 {
 				reporter.ListenerName(string(listener.Name)).SetCondition(reports.ListenerCondition{
 					Type:    gwv1.ListenerConditionConflicted,
 					Status:  metav1.ConditionTrue,
@@ -215,6 +226,122 @@ func validateListeners(gw *ir.Gateway, reporter reports.GatewayReporter) []ir.Li
 	return validListeners
 }
 
+// validateListenerSets validates the listeners of the XListenerSets attached to the Gateway, and
+// returns the valid ones. The listeners of the Gateway take precedence, then the listeners of the
+// XListenerSets in order, so a listener conflicting with a previously accepted one is rejected.
+func validateListenerSets(gw *ir.Gateway, gwListeners []ir.Listener, reporter reports.Reporter) []ir.Listener {
+	if len(gw.ListenerSets) == 0 {
+		return nil
+	}
+
+	gwReporter := reporter.Gateway(gw.Obj)
+	accepted := slices.Clone(gwListeners)
+	var validListeners []ir.Listener
+	attached, notAllowed := 0, 0
+	for _, ls := range gw.ListenerSets {
+		lsReporter := reporter.ListenerSet(ls.Obj)
+		if !ls.Allowed {
+			notAllowed++
+			lsReporter.SetCondition(reports.ListenerSetCondition{
+				Type:    apixv1alpha1.ListenerSetConditionAccepted,
+				Status:  metav1.ConditionFalse,
+				Reason:  apixv1alpha1.ListenerSetReasonNotAllowed,
+				Message: "The parent Gateway does not allow listener sets from this namespace",
+			})
+			continue
+		}
+		if len(gwListeners) == 0 {
+			lsReporter.SetCondition(reports.ListenerSetCondition{
+				Type:   apixv1alpha1.ListenerSetConditionAccepted,
+				Status: metav1.ConditionFalse,
+				Reason: apixv1alpha1.ListenerSetReasonParentNotAccepted,
+			})
+			continue
+		}
+
+		var lsListeners []ir.Listener
+		for _, listener := range validateSupportedRoutes(ls.Listeners, gwReporter) {
+			if reason, ok := listenerConflict(accepted, listener); ok {
+				gwReporter.ListenerName(string(listener.Name)).SetCondition(reports.ListenerCondition{
+					Type:    gwv1.ListenerConditionConflicted,
+					Status:  metav1.ConditionTrue,
+					Reason:  reason,
+					Message: "Found a conflicting listener on the Gateway or on a listener set with higher precedence",
+				})
+				continue
+			}
+			accepted = append(accepted, listener)
+			lsListeners = append(lsListeners, listener)
+		}
+		if len(lsListeners) == 0 {
+			lsReporter.SetCondition(reports.ListenerSetCondition{
+				Type:   apixv1alpha1.ListenerSetConditionAccepted,
+				Status: metav1.ConditionFalse,
+				Reason: apixv1alpha1.ListenerSetReasonListenersNotValid,
+			})
+			continue
+		}
+		attached++
+		validListeners = append(validListeners, lsListeners...)
+	}
+
+	condition := reports.GatewayCondition{
+		Type:    reports.GatewayConditionAttachedListenerSets,
+		Status:  metav1.ConditionTrue,
+		Reason:  reports.GatewayReasonListenerSetsAttached,
+		Message: fmt.Sprintf("%d of %d listener sets attached", attached, len(gw.ListenerSets)),
+	}
+	if attached == 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reports.GatewayReasonNoListenerSetsAttached
+		if notAllowed == len(gw.ListenerSets) {
+			condition.Reason = reports.GatewayReasonListenerSetsNotAllowed
+		}
+	}
+	gwReporter.SetCondition(condition)
+
+	return validListeners
+}
+
+// listenerConflict returns the reason of the conflict of the listener with the accepted listeners
+// on the same port, if any. A protocol conflict takes precedence over a hostname conflict.
+func listenerConflict(accepted []ir.Listener, listener ir.Listener) (gwv1.ListenerConditionReason, bool) {
+	hostnameConflict := false
+	for _, l := range accepted {
+		if portOf(l) != portOf(listener) {
+			continue
+		}
+		if normalizedProtocol(l.Protocol) != normalizedProtocol(listener.Protocol) {
+			return gwv1.ListenerReasonProtocolConflict, true
+		}
+		if listenerHostname(l) == listenerHostname(listener) {
+			hostnameConflict = true
+		}
+	}
+	if hostnameConflict {
+		return gwv1.ListenerReasonHostnameConflict, true
+	}
+	return "", false
+}
+
+// normalizedProtocol returns the protocol of the listener, where HTTPS and TLS listeners are
+// compatible on the same port.
+func normalizedProtocol(protocol gwv1.ProtocolType) gwv1.ProtocolType {
+	if protocol == gwv1.HTTPSProtocolType || protocol == gwv1.TLSProtocolType {
+		return NormalizedHTTPSTLSType
+	}
+	return protocol
+}
+
+// listenerHostname returns the hostname used to detect conflicting listeners on the same port.
+// Hostnames don't apply to UDP, so UDP listeners on the same port always conflict.
+func listenerHostname(listener ir.Listener) gwv1.Hostname {
+	if listener.Hostname == nil || listener.Protocol == gwv1.UDPProtocolType {
+		return DefaultHostname
+	}
+	return *listener.Hostname
+}
+
 func getGroupName() *gwv1.Group {
 	g := gwv1.Group(gwv1.GroupName)
 	return &g
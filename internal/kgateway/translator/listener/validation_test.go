@@ -5,6 +5,7 @@ import (
 
 	. "github.com/onsi/gomega"
 
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/reports"
 
@@ -30,6 +31,26 @@ func gwToIr(gw *gwv1.Gateway) *ir.Gateway {
 	return out
 }
 
+func lsToIr(ls *apixv1alpha1.XListenerSet, allowed bool) ir.ListenerSet {
+	out := ir.ListenerSet{
+		ObjectSource: ir.ObjectSource{Namespace: ls.Namespace, Name: ls.Name},
+		Obj:          ls,
+		Allowed:      allowed,
+	}
+	for _, l := range ls.Spec.Listeners {
+		out.Listeners = append(out.Listeners, ir.Listener{
+			Listener: gwv1.Listener{
+				Name:     gwv1.SectionName(reports.ListenerSetListenerName(ls, l.Name)),
+				Hostname: l.Hostname,
+				Port:     l.Port,
+				Protocol: l.Protocol,
+			},
+			ListenerSet: &out.ObjectSource,
+		})
+	}
+	return out
+}
+
 func TestValidate(t *testing.T) {
 	gateway := simpleGw()
 	listeners := gateway.Spec.Listeners
@@ -49,6 +70,10 @@ func TestValidate(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 		},
 	}
@@ -74,6 +99,10 @@ func TestSimpleGWNoHostname(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 		},
 	}
@@ -99,6 +128,10 @@ func TestSimpleGWDuplicateNoHostname(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -115,6 +148,10 @@ func TestSimpleGWDuplicateNoHostname(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -199,6 +236,10 @@ func TestMultiListener(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 		},
 		"http2": {
@@ -208,6 +249,10 @@ func TestMultiListener(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 		},
 	}
@@ -242,6 +287,10 @@ func TestMultiListenerExplicitRoutes(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 		},
 	}
@@ -303,6 +352,10 @@ func TestProtocolConflict(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -319,6 +372,10 @@ func TestProtocolConflict(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -362,6 +419,10 @@ func TestProtocolConflictInvalidRoutes(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 		},
 	}
@@ -414,6 +475,10 @@ func TestActualProtocolConflictInvalidRoutes(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -446,6 +511,10 @@ func TestHostnameConflict(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -462,6 +531,10 @@ func TestHostnameConflict(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -505,6 +578,10 @@ func TestHostnameConflictWithInvalidRoute(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 		},
 	}
@@ -557,6 +634,10 @@ func TestActualHostnameConflictWithInvalidRoute(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -589,6 +670,10 @@ func TestHostnameConflictWithExtraGoodListener(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -605,6 +690,10 @@ func TestHostnameConflictWithExtraGoodListener(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -621,6 +710,10 @@ func TestHostnameConflictWithExtraGoodListener(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 		},
 	}
@@ -714,6 +807,10 @@ func TestTCPProtocolConflict(t *testing.T) {
 					Group: GroupNameHelper(),
 					Kind:  "HTTPRoute",
 				},
+				{
+					Group: GroupNameHelper(),
+					Kind:  "GRPCRoute",
+				},
 			},
 			Conditions: []metav1.Condition{
 				{
@@ -775,6 +872,54 @@ func TestTCPHostnameConflict(t *testing.T) {
 	assertExpectedListenerStatuses(t, g, gateway, listeners, report, expectedStatuses)
 }
 
+func TestUDPListenersOnSamePortConflict(t *testing.T) {
+	gateway := udpHostnameConflictGw()
+	listeners := gateway.Spec.Listeners
+	report := reports.NewReportMap()
+	reporter := reports.NewReporter(&report)
+	gatewayReporter := reporter.Gateway(gateway)
+
+	validListeners := validateListeners(gwToIr(gateway), gatewayReporter)
+	g := NewWithT(t)
+	g.Expect(validListeners).To(BeEmpty())
+
+	expectedStatuses := map[string]gwv1.ListenerStatus{
+		"udp": {
+			Name: "udp",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "UDPRoute",
+				},
+			},
+			Conditions: []metav1.Condition{
+				{
+					Type:   string(gwv1.ListenerConditionConflicted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gwv1.ListenerReasonHostnameConflict),
+				},
+			},
+		},
+		"udp2": {
+			Name: "udp2",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "UDPRoute",
+				},
+			},
+			Conditions: []metav1.Condition{
+				{
+					Type:   string(gwv1.ListenerConditionConflicted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gwv1.ListenerReasonHostnameConflict),
+				},
+			},
+		},
+	}
+	assertExpectedListenerStatuses(t, g, gateway, listeners, report, expectedStatuses)
+}
+
 func simpleGwTCPRoute() *gwv1.Gateway {
 	return &gwv1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
@@ -878,6 +1023,94 @@ func tcpHostnameConflictGw() *gwv1.Gateway {
 	}
 }
 
+// udpHostnameConflictGw has two UDP listeners on the same port. Hostnames don't apply to UDP,
+// so the listeners conflict even though their hostnames differ.
+func TestTCPAndUDPListenersOnSamePort(t *testing.T) {
+	gateway := tcpAndUdpSamePortGw()
+	listeners := gateway.Spec.Listeners
+	report := reports.NewReportMap()
+	reporter := reports.NewReporter(&report)
+	gatewayReporter := reporter.Gateway(gateway)
+
+	validListeners := validateListeners(gwToIr(gateway), gatewayReporter)
+	g := NewWithT(t)
+	g.Expect(validListeners).To(HaveLen(2))
+
+	expectedStatuses := map[string]gwv1.ListenerStatus{
+		"dns-tcp": {
+			Name: "dns-tcp",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "TCPRoute",
+				},
+			},
+		},
+		"dns-udp": {
+			Name: "dns-udp",
+			SupportedKinds: []gwv1.RouteGroupKind{
+				{
+					Group: GroupNameHelper(),
+					Kind:  "UDPRoute",
+				},
+			},
+		},
+	}
+	assertExpectedListenerStatuses(t, g, gateway, listeners, report, expectedStatuses)
+}
+
+func tcpAndUdpSamePortGw() *gwv1.Gateway {
+	return &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "dns-gateway",
+		},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "solo",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "dns-tcp",
+					Port:     53,
+					Protocol: gwv1.TCPProtocolType,
+				},
+				{
+					Name:     "dns-udp",
+					Port:     53,
+					Protocol: gwv1.UDPProtocolType,
+				},
+			},
+		},
+	}
+}
+
+func udpHostnameConflictGw() *gwv1.Gateway {
+	hostname := gwv1.Hostname("solo.io")
+	hostname2 := gwv1.Hostname("kgateway.dev")
+	return &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "udp-hostname-conflict-gateway",
+		},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "solo",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "udp",
+					Port:     5353,
+					Protocol: gwv1.UDPProtocolType,
+					Hostname: &hostname,
+				},
+				{
+					Name:     "udp2",
+					Port:     5353,
+					Protocol: gwv1.UDPProtocolType,
+					Hostname: &hostname2,
+				},
+			},
+		},
+	}
+}
+
 // func TestRouteValidation(t *testing.T) {
 // 	scheme := scheme.NewScheme()
 // 	builder := fake.NewClientBuilder().WithScheme(scheme)
@@ -991,6 +1224,70 @@ func tcpHostnameConflictGw() *gwv1.Gateway {
 // 	}
 // }
 
+func TestValidateListenerSets(t *testing.T) {
+	g := NewWithT(t)
+	gateway := simpleGw()
+	bar := gwv1.Hostname("bar.solo.io")
+	listenerSet := func(name string, entries ...apixv1alpha1.ListenerEntry) *apixv1alpha1.XListenerSet {
+		return &apixv1alpha1.XListenerSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: name},
+			Spec: apixv1alpha1.ListenerSetSpec{
+				ParentRef: apixv1alpha1.ParentGatewayReference{Name: "test"},
+				Listeners: entries,
+			},
+		}
+	}
+	// valid listener on the port of the gateway listener, and a listener with a conflicting protocol
+	first := listenerSet("first",
+		apixv1alpha1.ListenerEntry{Name: "bar", Hostname: &bar, Port: 8080, Protocol: gwv1.HTTPProtocolType},
+		apixv1alpha1.ListenerEntry{Name: "tcp", Port: 8080, Protocol: gwv1.TCPProtocolType},
+	)
+	// the same hostname as the listener of the first listener set
+	second := listenerSet("second",
+		apixv1alpha1.ListenerEntry{Name: "bar", Hostname: &bar, Port: 8080, Protocol: gwv1.HTTPProtocolType},
+	)
+	notAllowed := listenerSet("not-allowed",
+		apixv1alpha1.ListenerEntry{Name: "other", Port: 9090, Protocol: gwv1.HTTPProtocolType},
+	)
+
+	gw := gwToIr(gateway)
+	gw.ListenerSets = []ir.ListenerSet{lsToIr(first, true), lsToIr(second, true), lsToIr(notAllowed, false)}
+	report := reports.NewReportMap()
+	reporter := reports.NewReporter(&report)
+
+	gwListeners := validateListeners(gw, reporter.Gateway(gateway))
+	validListeners := validateListenerSets(gw, gwListeners, reporter)
+	g.Expect(validListeners).To(HaveLen(1))
+	g.Expect(validListeners[0].Name).To(BeEquivalentTo("team/first/bar"))
+
+	gatewayReport := report.Gateway(gateway)
+	conflictReason := func(name string) string {
+		lr := gatewayReport.ListenerName(name).(*reports.ListenerReport)
+		for _, c := range lr.Status.Conditions {
+			if c.Type == string(gwv1.ListenerConditionConflicted) {
+				return c.Reason
+			}
+		}
+		return ""
+	}
+	g.Expect(conflictReason("team/first/bar")).To(BeEmpty())
+	g.Expect(conflictReason("team/first/tcp")).To(Equal(string(gwv1.ListenerReasonProtocolConflict)))
+	g.Expect(conflictReason("team/second/bar")).To(Equal(string(gwv1.ListenerReasonHostnameConflict)))
+
+	g.Expect(report.ListenerSet(first).GetConditions()).To(BeEmpty())
+	g.Expect(report.ListenerSet(second).GetConditions()).To(ConsistOf(
+		HaveField("Reason", string(apixv1alpha1.ListenerSetReasonListenersNotValid)),
+	))
+	g.Expect(report.ListenerSet(notAllowed).GetConditions()).To(ConsistOf(
+		HaveField("Reason", string(apixv1alpha1.ListenerSetReasonNotAllowed)),
+	))
+	g.Expect(gatewayReport.GetConditions()).To(ConsistOf(And(
+		HaveField("Type", string(reports.GatewayConditionAttachedListenerSets)),
+		HaveField("Status", metav1.ConditionTrue),
+		HaveField("Message", "1 of 3 listener sets attached"),
+	)))
+}
+
 func assertExpectedListenerStatuses(
 	t *testing.T,
 	g Gomega,
@@ -14,6 +14,12 @@ const (
 	// as the Gateway.
 	GatewayParametersAnnotationName = "gateway.kgateway.dev/gateway-parameters-name"
 
+	// AllowedListenersAnnotationName is the name of the Gateway annotation that specifies which
+	// XListenerSets may attach listeners to the Gateway: "None" (the default), "Same" for the
+	// XListenerSets in the namespace of the Gateway, or "All". It is experimental, like the
+	// XListenerSet API, and only honored when KGW_ENABLEEXPERIMENTALLISTENERSETS is true.
+	AllowedListenersAnnotationName = "gateway.kgateway.dev/allowed-listeners"
+
 	// DefaultGatewayParametersName is the name of the GatewayParameters which is attached by
 	// parametersRef to the GatewayClass.
 	DefaultGatewayParametersName = "kgateway"
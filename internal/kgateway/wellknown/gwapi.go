@@ -7,7 +7,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	apiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	apiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	apiv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	apiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	apixv1alpha1 "github.com/kgateway-dev/kgateway/v2/internal/kgateway/apisx/v1alpha1"
 )
 
 const (
@@ -20,9 +23,18 @@ const (
 	// Kind string for HTTPRoute resource
 	HTTPRouteKind = "HTTPRoute"
 
+	// Kind string for GRPCRoute resource
+	GRPCRouteKind = "GRPCRoute"
+
 	// Kind string for TCPRoute resource
 	TCPRouteKind = "TCPRoute"
 
+	// Kind string for TLSRoute resource
+	TLSRouteKind = "TLSRoute"
+
+	// Kind string for UDPRoute resource
+	UDPRouteKind = "UDPRoute"
+
 	// Kind string for Gateway resource
 	GatewayKind = "Gateway"
 
@@ -32,6 +44,12 @@ const (
 	// Kind string for ReferenceGrant resource
 	ReferenceGrantKind = "ReferenceGrant"
 
+	// Kind string for BackendTLSPolicy resource
+	BackendTLSPolicyKind = "BackendTLSPolicy"
+
+	// Kind string for XListenerSet resource
+	XListenerSetKind = "XListenerSet"
+
 	// Kind strings for Gateway API list types
 	HTTPRouteListKind      = "HTTPRouteList"
 	GatewayListKind        = "GatewayList"
@@ -58,11 +76,26 @@ var (
 		Version: apiv1.GroupVersion.Version,
 		Kind:    HTTPRouteKind,
 	}
+	GRPCRouteGVK = schema.GroupVersionKind{
+		Group:   GatewayGroup,
+		Version: apiv1.GroupVersion.Version,
+		Kind:    GRPCRouteKind,
+	}
 	ReferenceGrantGVK = schema.GroupVersionKind{
 		Group:   GatewayGroup,
 		Version: apiv1beta1.GroupVersion.Version,
 		Kind:    ReferenceGrantKind,
 	}
+	BackendTLSPolicyGVK = schema.GroupVersionKind{
+		Group:   GatewayGroup,
+		Version: apiv1alpha3.GroupVersion.Version,
+		Kind:    BackendTLSPolicyKind,
+	}
+	XListenerSetGVK = schema.GroupVersionKind{
+		Group:   apixv1alpha1.GroupName,
+		Version: apixv1alpha1.GroupVersion.Version,
+		Kind:    XListenerSetKind,
+	}
 
 	GatewayListGVK = schema.GroupVersionKind{
 		Group:   GatewayGroup,
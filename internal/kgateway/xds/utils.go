@@ -20,6 +20,10 @@ const (
 	// RoleKey is the name of the ket in the node.metadata used to store the role
 	RoleKey = "role"
 
+	// SelfManagedKey is the name of the key in the node.metadata marking the proxies of
+	// self-managed Gateways, which may run outside of the cluster and have no pod
+	SelfManagedKey = "self_managed"
+
 	// FallbackNodeCacheKey is used to let nodes know they have a bad config
 	// we assign a "fix me" snapshot for bad nodes
 	FallbackNodeCacheKey = "misconfigured-node"
@@ -13,12 +13,17 @@ import (
 
 type GatewayV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	CORSPoliciesGetter
 	DirectResponsesGetter
+	ExtAuthPoliciesGetter
 	GatewayParametersesGetter
 	HTTPListenerPoliciesGetter
+	JWTPoliciesGetter
 	ListenerPoliciesGetter
+	RateLimitPoliciesGetter
 	RoutePoliciesGetter
 	UpstreamsGetter
+	UpstreamPoliciesGetter
 }
 
 // GatewayV1alpha1Client is used to interact with features provided by the gateway.kgateway.dev group.
@@ -26,10 +31,18 @@ type GatewayV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *GatewayV1alpha1Client) CORSPolicies(namespace string) CORSPolicyInterface {
+	return newCORSPolicies(c, namespace)
+}
+
 func (c *GatewayV1alpha1Client) DirectResponses(namespace string) DirectResponseInterface {
 	return newDirectResponses(c, namespace)
 }
 
+func (c *GatewayV1alpha1Client) ExtAuthPolicies(namespace string) ExtAuthPolicyInterface {
+	return newExtAuthPolicies(c, namespace)
+}
+
 func (c *GatewayV1alpha1Client) GatewayParameterses(namespace string) GatewayParametersInterface {
 	return newGatewayParameterses(c, namespace)
 }
@@ -38,10 +51,18 @@ func (c *GatewayV1alpha1Client) HTTPListenerPolicies(namespace string) HTTPListe
 	return newHTTPListenerPolicies(c, namespace)
 }
 
+func (c *GatewayV1alpha1Client) JWTPolicies(namespace string) JWTPolicyInterface {
+	return newJWTPolicies(c, namespace)
+}
+
 func (c *GatewayV1alpha1Client) ListenerPolicies(namespace string) ListenerPolicyInterface {
 	return newListenerPolicies(c, namespace)
 }
 
+func (c *GatewayV1alpha1Client) RateLimitPolicies(namespace string) RateLimitPolicyInterface {
+	return newRateLimitPolicies(c, namespace)
+}
+
 func (c *GatewayV1alpha1Client) RoutePolicies(namespace string) RoutePolicyInterface {
 	return newRoutePolicies(c, namespace)
 }
@@ -50,6 +71,10 @@ func (c *GatewayV1alpha1Client) Upstreams(namespace string) UpstreamInterface {
 	return newUpstreams(c, namespace)
 }
 
+func (c *GatewayV1alpha1Client) UpstreamPolicies(namespace string) UpstreamPolicyInterface {
+	return newUpstreamPolicies(c, namespace)
+}
+
 // NewForConfig creates a new GatewayV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
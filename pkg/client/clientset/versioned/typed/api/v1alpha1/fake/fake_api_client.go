@@ -13,10 +13,18 @@ type FakeGatewayV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeGatewayV1alpha1) CORSPolicies(namespace string) v1alpha1.CORSPolicyInterface {
+	return newFakeCORSPolicies(c, namespace)
+}
+
 func (c *FakeGatewayV1alpha1) DirectResponses(namespace string) v1alpha1.DirectResponseInterface {
 	return newFakeDirectResponses(c, namespace)
 }
 
+func (c *FakeGatewayV1alpha1) ExtAuthPolicies(namespace string) v1alpha1.ExtAuthPolicyInterface {
+	return newFakeExtAuthPolicies(c, namespace)
+}
+
 func (c *FakeGatewayV1alpha1) GatewayParameterses(namespace string) v1alpha1.GatewayParametersInterface {
 	return newFakeGatewayParameterses(c, namespace)
 }
@@ -25,10 +33,18 @@ func (c *FakeGatewayV1alpha1) HTTPListenerPolicies(namespace string) v1alpha1.HT
 	return newFakeHTTPListenerPolicies(c, namespace)
 }
 
+func (c *FakeGatewayV1alpha1) JWTPolicies(namespace string) v1alpha1.JWTPolicyInterface {
+	return newFakeJWTPolicies(c, namespace)
+}
+
 func (c *FakeGatewayV1alpha1) ListenerPolicies(namespace string) v1alpha1.ListenerPolicyInterface {
 	return newFakeListenerPolicies(c, namespace)
 }
 
+func (c *FakeGatewayV1alpha1) RateLimitPolicies(namespace string) v1alpha1.RateLimitPolicyInterface {
+	return newFakeRateLimitPolicies(c, namespace)
+}
+
 func (c *FakeGatewayV1alpha1) RoutePolicies(namespace string) v1alpha1.RoutePolicyInterface {
 	return newFakeRoutePolicies(c, namespace)
 }
@@ -37,6 +53,10 @@ func (c *FakeGatewayV1alpha1) Upstreams(namespace string) v1alpha1.UpstreamInter
 	return newFakeUpstreams(c, namespace)
 }
 
+func (c *FakeGatewayV1alpha1) UpstreamPolicies(namespace string) v1alpha1.UpstreamPolicyInterface {
+	return newFakeUpstreamPolicies(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeGatewayV1alpha1) RESTClient() rest.Interface {
@@ -2,14 +2,24 @@
 
 package v1alpha1
 
+type CORSPolicyExpansion interface{}
+
 type DirectResponseExpansion interface{}
 
+type ExtAuthPolicyExpansion interface{}
+
 type GatewayParametersExpansion interface{}
 
 type HTTPListenerPolicyExpansion interface{}
 
+type JWTPolicyExpansion interface{}
+
 type ListenerPolicyExpansion interface{}
 
+type RateLimitPolicyExpansion interface{}
+
 type RoutePolicyExpansion interface{}
 
 type UpstreamExpansion interface{}
+
+type UpstreamPolicyExpansion interface{}
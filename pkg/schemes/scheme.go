@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -25,7 +27,9 @@ var SchemeBuilder = runtime.SchemeBuilder{
 	// Kubernetes Core resources
 	corev1.AddToScheme,
 	appsv1.AddToScheme,
+	autoscalingv2.AddToScheme,
 	discoveryv1.AddToScheme,
+	policyv1.AddToScheme,
 
 	// Register the apiextensions API group
 	apiextensionsv1.AddToScheme,
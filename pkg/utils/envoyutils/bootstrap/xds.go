@@ -0,0 +1,202 @@
+package bootstrap
+
+import (
+	"net"
+	"time"
+
+	envoy_config_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_extensions_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/rotisserie/eris"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// XdsClusterName is the name of the cluster of the control plane in the bootstrap.
+	XdsClusterName = "xds_cluster"
+
+	roleKey        = "role"
+	selfManagedKey = "self_managed"
+
+	adminAddress = "127.0.0.1"
+	adminPort    = 19000
+
+	httpProtocolOptionsName = "envoy.extensions.upstreams.http.v3.HttpProtocolOptions"
+)
+
+// XdsOptions configures the bootstrap of a proxy which gets its configuration from the
+// control plane over xDS.
+type XdsOptions struct {
+	// Cluster is the service cluster of the proxy node.
+	Cluster string
+	// Role identifies the proxy to the control plane. It is set in the node metadata and must be
+	// formatted as OWNER~NAMESPACE~NAME.
+	Role string
+	// XdsHost and XdsPort are the address of the control plane.
+	XdsHost string
+	XdsPort uint32
+	// SdsClusterName, SdsHost and SdsPort configure the cluster of the SDS server providing secrets
+	// to the proxy. The cluster is not added when SdsHost is empty.
+	SdsClusterName string
+	SdsHost        string
+	SdsPort        uint32
+	// SelfManaged marks the proxy as the proxy of a self-managed Gateway in the node metadata, so
+	// that the control plane doesn't expect it to run in a pod of the cluster.
+	SelfManaged bool
+}
+
+// FromXds constructs the bootstrap of a proxy which gets its configuration from the control plane
+// over ADS, marshals it to json, and returns the stringified json or any error if it occurred.
+func FromXds(opts XdsOptions) (string, error) {
+	if opts.Role == "" {
+		return "", eris.New("no role for the proxy node")
+	}
+	if opts.XdsHost == "" || opts.XdsPort == 0 {
+		return "", eris.New("no xds address for the proxy")
+	}
+
+	clusters := []*envoy_config_cluster_v3.Cluster{
+		http2Cluster(XdsClusterName, envoy_config_cluster_v3.Cluster_STRICT_DNS, opts.XdsHost, opts.XdsPort),
+	}
+	if opts.SdsHost != "" {
+		if opts.SdsClusterName == "" || opts.SdsPort == 0 {
+			return "", eris.New("no sds cluster name or port for the proxy")
+		}
+		discoveryType := envoy_config_cluster_v3.Cluster_STRICT_DNS
+		if net.ParseIP(opts.SdsHost) != nil {
+			discoveryType = envoy_config_cluster_v3.Cluster_STATIC
+		}
+		clusters = append(clusters, http2Cluster(opts.SdsClusterName, discoveryType, opts.SdsHost, opts.SdsPort))
+	}
+	for _, c := range clusters {
+		if err := setHttp2ProtocolOptions(c); err != nil {
+			return "", err
+		}
+	}
+
+	staticLayer, err := structpb.NewStruct(map[string]any{
+		"envoy.restart_features.use_eds_cache_for_ads": true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	metadata := map[string]*structpb.Value{
+		roleKey: structpb.NewStringValue(opts.Role),
+	}
+	if opts.SelfManaged {
+		metadata[selfManagedKey] = structpb.NewBoolValue(true)
+	}
+
+	ads := &envoy_config_core_v3.ConfigSource{
+		ResourceApiVersion:    envoy_config_core_v3.ApiVersion_V3,
+		ConfigSourceSpecifier: &envoy_config_core_v3.ConfigSource_Ads{Ads: &envoy_config_core_v3.AggregatedConfigSource{}},
+	}
+	bootstrap := &envoy_config_bootstrap_v3.Bootstrap{
+		Node: &envoy_config_core_v3.Node{
+			Cluster:  opts.Cluster,
+			Metadata: &structpb.Struct{Fields: metadata},
+		},
+		Admin: &envoy_config_bootstrap_v3.Admin{
+			Address: socketAddress(adminAddress, adminPort),
+		},
+		LayeredRuntime: &envoy_config_bootstrap_v3.LayeredRuntime{
+			Layers: []*envoy_config_bootstrap_v3.RuntimeLayer{
+				{
+					Name:           "static_layer",
+					LayerSpecifier: &envoy_config_bootstrap_v3.RuntimeLayer_StaticLayer{StaticLayer: staticLayer},
+				},
+				{
+					Name:           "admin_layer",
+					LayerSpecifier: &envoy_config_bootstrap_v3.RuntimeLayer_AdminLayer_{AdminLayer: &envoy_config_bootstrap_v3.RuntimeLayer_AdminLayer{}},
+				},
+			},
+		},
+		StaticResources: &envoy_config_bootstrap_v3.Bootstrap_StaticResources{
+			Clusters: clusters,
+		},
+		DynamicResources: &envoy_config_bootstrap_v3.Bootstrap_DynamicResources{
+			AdsConfig: &envoy_config_core_v3.ApiConfigSource{
+				ApiType:             envoy_config_core_v3.ApiConfigSource_GRPC,
+				TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+				RateLimitSettings:   &envoy_config_core_v3.RateLimitSettings{},
+				GrpcServices: []*envoy_config_core_v3.GrpcService{{
+					TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{ClusterName: XdsClusterName},
+					},
+				}},
+			},
+			CdsConfig: ads,
+			LdsConfig: ads,
+		},
+	}
+
+	marshaler := &protojson.MarshalOptions{
+		UseProtoNames: true,
+	}
+	j, err := marshaler.Marshal(bootstrap)
+	return string(j), err // returns a json, but json is valid yaml
+}
+
+func http2Cluster(name string, discoveryType envoy_config_cluster_v3.Cluster_DiscoveryType, host string, port uint32) *envoy_config_cluster_v3.Cluster {
+	return &envoy_config_cluster_v3.Cluster{
+		Name:                 name,
+		AltStatName:          name,
+		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{Type: discoveryType},
+		RespectDnsTtl:        discoveryType == envoy_config_cluster_v3.Cluster_STRICT_DNS,
+		LoadAssignment: &envoy_config_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: name,
+			Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoy_config_endpoint_v3.LbEndpoint{{
+					HostIdentifier: &envoy_config_endpoint_v3.LbEndpoint_Endpoint{
+						Endpoint: &envoy_config_endpoint_v3.Endpoint{
+							Address: socketAddress(host, port),
+						},
+					},
+				}},
+			}},
+		},
+		UpstreamConnectionOptions: &envoy_config_cluster_v3.UpstreamConnectionOptions{
+			TcpKeepalive: &envoy_config_core_v3.TcpKeepalive{
+				KeepaliveTime: wrapperspb.UInt32(10),
+			},
+		},
+	}
+}
+
+func setHttp2ProtocolOptions(c *envoy_config_cluster_v3.Cluster) error {
+	options, err := anypb.New(&envoy_extensions_upstreams_http_v3.HttpProtocolOptions{
+		UpstreamProtocolOptions: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+					Http2ProtocolOptions: &envoy_config_core_v3.Http2ProtocolOptions{},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	c.TypedExtensionProtocolOptions = map[string]*anypb.Any{
+		httpProtocolOptionsName: options,
+	}
+	return nil
+}
+
+func socketAddress(host string, port uint32) *envoy_config_core_v3.Address {
+	return &envoy_config_core_v3.Address{
+		Address: &envoy_config_core_v3.Address_SocketAddress{
+			SocketAddress: &envoy_config_core_v3.SocketAddress{
+				Address:       host,
+				PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{PortValue: port},
+			},
+		},
+	}
+}
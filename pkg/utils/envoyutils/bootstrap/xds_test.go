@@ -0,0 +1,102 @@
+package bootstrap
+
+import (
+	envoy_config_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ = Describe("Xds bootstrap generation", func() {
+	var opts XdsOptions
+
+	BeforeEach(func() {
+		opts = XdsOptions{
+			Cluster: "gw.default",
+			Role:    "gloo-kube-gateway-api~default~gw",
+			XdsHost: "kgateway.kgateway-system.svc.cluster.local",
+			XdsPort: 9977,
+		}
+	})
+
+	unmarshal := func(out string) *envoy_config_bootstrap_v3.Bootstrap {
+		bootstrap := &envoy_config_bootstrap_v3.Bootstrap{}
+		ExpectWithOffset(1, protojson.Unmarshal([]byte(out), bootstrap)).To(Succeed())
+		return bootstrap
+	}
+
+	It("connects the proxy to the control plane with its role", func() {
+		out, err := FromXds(opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		bootstrap := unmarshal(out)
+		Expect(bootstrap.GetNode().GetCluster()).To(Equal("gw.default"))
+		Expect(bootstrap.GetNode().GetMetadata().GetFields()[roleKey].GetStringValue()).To(Equal(opts.Role))
+
+		clusters := bootstrap.GetStaticResources().GetClusters()
+		Expect(clusters).To(HaveLen(1))
+		Expect(clusters[0].GetName()).To(Equal(XdsClusterName))
+		address := clusters[0].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress()
+		Expect(address.GetAddress()).To(Equal(opts.XdsHost))
+		Expect(address.GetPortValue()).To(Equal(opts.XdsPort))
+		Expect(clusters[0].GetTypedExtensionProtocolOptions()).To(HaveKey(httpProtocolOptionsName))
+
+		ads := bootstrap.GetDynamicResources().GetAdsConfig()
+		Expect(ads.GetGrpcServices()[0].GetEnvoyGrpc().GetClusterName()).To(Equal(XdsClusterName))
+		Expect(bootstrap.GetDynamicResources().GetCdsConfig().GetAds()).NotTo(BeNil())
+		Expect(bootstrap.GetDynamicResources().GetLdsConfig().GetAds()).NotTo(BeNil())
+	})
+
+	It("marks the proxy of a self-managed gateway", func() {
+		out, err := FromXds(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unmarshal(out).GetNode().GetMetadata().GetFields()).NotTo(HaveKey(selfManagedKey))
+
+		opts.SelfManaged = true
+		out, err = FromXds(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unmarshal(out).GetNode().GetMetadata().GetFields()[selfManagedKey].GetBoolValue()).To(BeTrue())
+	})
+
+	It("adds the sds cluster", func() {
+		opts.SdsClusterName = "gateway_proxy_sds"
+		opts.SdsHost = "127.0.0.1"
+		opts.SdsPort = 8234
+		out, err := FromXds(opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		clusters := unmarshal(out).GetStaticResources().GetClusters()
+		Expect(clusters).To(HaveLen(2))
+		Expect(clusters[1].GetName()).To(Equal("gateway_proxy_sds"))
+		Expect(clusters[1].GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STATIC))
+		address := clusters[1].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress()
+		Expect(address.GetAddress()).To(Equal("127.0.0.1"))
+		Expect(address.GetPortValue()).To(Equal(uint32(8234)))
+	})
+
+	It("resolves the sds host name", func() {
+		opts.SdsClusterName = "gateway_proxy_sds"
+		opts.SdsHost = "istio-agent.local"
+		opts.SdsPort = 8234
+		out, err := FromXds(opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		clusters := unmarshal(out).GetStaticResources().GetClusters()
+		Expect(clusters).To(HaveLen(2))
+		Expect(clusters[1].GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STRICT_DNS))
+	})
+
+	It("errors without an sds port", func() {
+		opts.SdsClusterName = "gateway_proxy_sds"
+		opts.SdsHost = "127.0.0.1"
+		_, err := FromXds(opts)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("errors without a role", func() {
+		opts.Role = ""
+		_, err := FromXds(opts)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -126,10 +126,15 @@ func recordKubeState(ctx context.Context, kubectlCli *kubectl.Cli, f *os.File) {
 		"referencegrants.gateway.networking.k8s.io",
 		// kgateway resources
 		"directresponses.gateway.kgateway.dev",
+		"extauthpolicies.gateway.kgateway.dev",
+		"jwtpolicies.gateway.kgateway.dev",
+		"corspolicies.gateway.kgateway.dev",
 		"gatewayparameters.gateway.kgateway.dev",
 		"httplistenerpolicies.gateway.kgateway.dev",
 		"listenerpolicies.gateway.kgateway.dev",
+		"ratelimitpolicies.gateway.kgateway.dev",
 		"routepolicies.gateway.kgateway.dev",
+		"upstreampolicies.gateway.kgateway.dev",
 		"upstreams.gateway.kgateway.dev",
 	}
 